@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
@@ -23,6 +24,10 @@ func init() {
 func main() {
 	cmd := buildRootCmd()
 	if err := cmd.Execute(); err != nil {
+		var silentErr *cli.ErrSilentExit
+		if errors.As(err, &silentErr) {
+			os.Exit(silentErr.Code)
+		}
 		log.Errorln(err.Error())
 		os.Exit(1)
 	}
@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acm provides a client to make API requests to AWS Certificate Manager.
+package acm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+type api interface {
+	DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+}
+
+// ACM wraps an AWS Certificate Manager client.
+type ACM struct {
+	client api
+}
+
+// New returns an ACM client configured against the input session.
+func New(s *session.Session) *ACM {
+	return &ACM{
+		client: acm.New(s),
+	}
+}
+
+// CertificateExpiry returns the time after which the certificate is not valid given its ARN.
+func (a *ACM) CertificateExpiry(certARN string) (time.Time, error) {
+	out, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("describe certificate %s: %w", certARN, err)
+	}
+	if out.Certificate == nil || out.Certificate.NotAfter == nil {
+		return time.Time{}, fmt.Errorf("certificate %s has no expiry date", certARN)
+	}
+	return aws.TimeValue(out.Certificate.NotAfter), nil
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACM_CertificateExpiry(t *testing.T) {
+	const mockARN = "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+	mockErr := errors.New("some error")
+	mockNotAfter := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedExpiry time.Time
+		wantedErr    error
+	}{
+		"errors if fail to describe the certificate": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("describe certificate %s: %w", mockARN, mockErr),
+		},
+		"errors if the certificate has no expiry date": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						Status: aws.String("PENDING_VALIDATION"),
+					},
+				}, nil)
+			},
+			wantedErr: fmt.Errorf("certificate %s has no expiry date", mockARN),
+		},
+		"returns the expiry date of the certificate": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String(mockARN),
+				}).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						NotAfter: aws.Time(mockNotAfter),
+					},
+				}, nil)
+			},
+			wantedExpiry: mockNotAfter,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := ACM{
+				client: m,
+			}
+
+			// WHEN
+			expiry, err := client.CertificateExpiry(mockARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExpiry, expiry)
+		})
+	}
+}
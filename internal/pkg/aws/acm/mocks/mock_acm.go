@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/acm/acm.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	acm "github.com/aws/aws-sdk-go/service/acm"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// DescribeCertificate mocks base method
+func (m *Mockapi) DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCertificate", input)
+	ret0, _ := ret[0].(*acm.DescribeCertificateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCertificate indicates an expected call of DescribeCertificate
+func (mr *MockapiMockRecorder) DescribeCertificate(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCertificate", reflect.TypeOf((*Mockapi)(nil).DescribeCertificate), input)
+}
@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apprunner provides a client to make API requests to AWS App Runner.
+package apprunner
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+)
+
+type api interface {
+	DescribeService(input *apprunner.DescribeServiceInput) (*apprunner.DescribeServiceOutput, error)
+	DescribeCustomDomains(input *apprunner.DescribeCustomDomainsInput) (*apprunner.DescribeCustomDomainsOutput, error)
+}
+
+// AppRunner wraps an AWS App Runner client.
+type AppRunner struct {
+	client api
+}
+
+// ServiceStatusPaused is the status of a service that was paused: it keeps its configuration but doesn't serve any traffic.
+const ServiceStatusPaused = apprunner.ServiceStatusPaused
+
+// Service contains the information of an App Runner service.
+type Service struct {
+	ARN           string
+	Name          string
+	Status        string
+	URL           string
+	CPU           string // Size of the instances, like "1024" or "1 vCPU". Empty if the service has no instance configuration.
+	Memory        string // Memory of the instances, like "2048" or "2 GB".
+	CustomDomains []CustomDomain
+}
+
+// CustomDomain is a domain name associated with an App Runner service.
+type CustomDomain struct {
+	DomainName string
+	Status     string
+}
+
+// New returns an AppRunner client configured against the input session.
+func New(s *session.Session) *AppRunner {
+	return &AppRunner{
+		client: apprunner.New(s),
+	}
+}
+
+// DescribeService returns the App Runner service and its associated custom domains given the service ARN.
+func (a *AppRunner) DescribeService(svcARN string) (*Service, error) {
+	out, err := a.client.DescribeService(&apprunner.DescribeServiceInput{
+		ServiceArn: aws.String(svcARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe service %s: %w", svcARN, err)
+	}
+	domains, err := a.customDomains(svcARN)
+	if err != nil {
+		return nil, err
+	}
+	svc := &Service{
+		ARN:           aws.StringValue(out.Service.ServiceArn),
+		Name:          aws.StringValue(out.Service.ServiceName),
+		Status:        aws.StringValue(out.Service.Status),
+		URL:           aws.StringValue(out.Service.ServiceUrl),
+		CustomDomains: domains,
+	}
+	if instance := out.Service.InstanceConfiguration; instance != nil {
+		svc.CPU = aws.StringValue(instance.Cpu)
+		svc.Memory = aws.StringValue(instance.Memory)
+	}
+	return svc, nil
+}
+
+func (a *AppRunner) customDomains(svcARN string) ([]CustomDomain, error) {
+	var domains []CustomDomain
+	var nextToken *string
+	for {
+		out, err := a.client.DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+			ServiceArn: aws.String(svcARN),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe custom domains for service %s: %w", svcARN, err)
+		}
+		for _, domain := range out.CustomDomains {
+			domains = append(domains, CustomDomain{
+				DomainName: aws.StringValue(domain.DomainName),
+				Status:     aws.StringValue(domain.Status),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return domains, nil
+}
@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package apprunner
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppRunner_DescribeService(t *testing.T) {
+	const mockARN = "arn:aws:apprunner:us-west-2:123456789012:service/my-app-test-api/8fe1e10304f84fd2b0df550fe98a71fa"
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedService *Service
+		wantedErr     error
+	}{
+		"errors if fail to describe the service": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{
+					ServiceArn: aws.String(mockARN),
+				}).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("describe service %s: %w", mockARN, mockErr),
+		},
+		"errors if fail to describe the custom domains": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(gomock.Any()).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{},
+				}, nil)
+				m.EXPECT().DescribeCustomDomains(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("describe custom domains for service %s: %w", mockARN, mockErr),
+		},
+		"returns the service with all pages of custom domains": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(gomock.Any()).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						ServiceArn:  aws.String(mockARN),
+						ServiceName: aws.String("my-app-test-api"),
+						Status:      aws.String("RUNNING"),
+						ServiceUrl:  aws.String("abc123.us-west-2.awsapprunner.com"),
+						InstanceConfiguration: &apprunner.InstanceConfiguration{
+							Cpu:    aws.String("1024"),
+							Memory: aws.String("2048"),
+						},
+					},
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+						ServiceArn: aws.String(mockARN),
+					}).Return(&apprunner.DescribeCustomDomainsOutput{
+						CustomDomains: []*apprunner.CustomDomain{
+							{DomainName: aws.String("api.example.com"), Status: aws.String("ACTIVE")},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+						ServiceArn: aws.String(mockARN),
+						NextToken:  aws.String("token"),
+					}).Return(&apprunner.DescribeCustomDomainsOutput{
+						CustomDomains: []*apprunner.CustomDomain{
+							{DomainName: aws.String("www.example.com"), Status: aws.String("PENDING_CERTIFICATE_DNS_VALIDATION")},
+						},
+					}, nil),
+				)
+			},
+			wantedService: &Service{
+				ARN:    mockARN,
+				Name:   "my-app-test-api",
+				Status: "RUNNING",
+				URL:    "abc123.us-west-2.awsapprunner.com",
+				CPU:    "1024",
+				Memory: "2048",
+				CustomDomains: []CustomDomain{
+					{DomainName: "api.example.com", Status: "ACTIVE"},
+					{DomainName: "www.example.com", Status: "PENDING_CERTIFICATE_DNS_VALIDATION"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := AppRunner{
+				client: m,
+			}
+
+			// WHEN
+			svc, err := client.DescribeService(mockARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedService, svc)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/apprunner/apprunner.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	apprunner "github.com/aws/aws-sdk-go/service/apprunner"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method
+func (m *Mockapi) DescribeService(input *apprunner.DescribeServiceInput) (*apprunner.DescribeServiceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", input)
+	ret0, _ := ret[0].(*apprunner.DescribeServiceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService
+func (mr *MockapiMockRecorder) DescribeService(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*Mockapi)(nil).DescribeService), input)
+}
+
+// DescribeCustomDomains mocks base method
+func (m *Mockapi) DescribeCustomDomains(input *apprunner.DescribeCustomDomainsInput) (*apprunner.DescribeCustomDomainsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomDomains", input)
+	ret0, _ := ret[0].(*apprunner.DescribeCustomDomainsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomDomains indicates an expected call of DescribeCustomDomains
+func (mr *MockapiMockRecorder) DescribeCustomDomains(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomDomains", reflect.TypeOf((*Mockapi)(nil).DescribeCustomDomains), input)
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package budgets provides a client to make API requests to AWS Budgets.
+package budgets
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/budgets"
+)
+
+// costFilterTagKeyValue is the cost filter of a budget on the values of the user-defined cost allocation tags,
+// formatted as "user:<key>$<value>".
+const costFilterTagKeyValue = "TagKeyValue"
+
+type api interface {
+	DescribeBudgets(input *budgets.DescribeBudgetsInput) (*budgets.DescribeBudgetsOutput, error)
+}
+
+// Budgets wraps an AWS Budgets client.
+type Budgets struct {
+	client api
+}
+
+// Budget is a cost budget and the spend that it tracks.
+type Budget struct {
+	Name     string
+	TimeUnit string   // Period that the limit applies to, like "MONTHLY".
+	Unit     string   // Unit of the amounts, like "USD".
+	Limit    float64  // 0 if the budget has no fixed limit, like a budget with a planned limit per period.
+	Actual   float64  // Spend of the current period.
+	Forecast *float64 // Forecasted spend of the current period, nil if AWS Budgets has no forecast yet.
+}
+
+// New returns a Budgets client configured against the input session.
+func New(s *session.Session) *Budgets {
+	return &Budgets{
+		client: budgets.New(s),
+	}
+}
+
+// BudgetsWithTag returns the budgets of the account that are filtered on the cost allocation tag with the key and value.
+func (b *Budgets) BudgetsWithTag(accountID, key, value string) ([]*Budget, error) {
+	filter := fmt.Sprintf("user:%s$%s", key, value)
+	in := &budgets.DescribeBudgetsInput{
+		AccountId: aws.String(accountID),
+	}
+	var tagged []*Budget
+	for {
+		out, err := b.client.DescribeBudgets(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe budgets of account %s: %w", accountID, err)
+		}
+		for _, budget := range out.Budgets {
+			if !hasFilter(budget, filter) {
+				continue
+			}
+			parsed, err := parseBudget(budget)
+			if err != nil {
+				return nil, err
+			}
+			tagged = append(tagged, parsed)
+		}
+		if out.NextToken == nil {
+			return tagged, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func hasFilter(budget *budgets.Budget, filter string) bool {
+	for _, value := range budget.CostFilters[costFilterTagKeyValue] {
+		if aws.StringValue(value) == filter {
+			return true
+		}
+	}
+	return false
+}
+
+func parseBudget(budget *budgets.Budget) (*Budget, error) {
+	name := aws.StringValue(budget.BudgetName)
+	parsed := &Budget{
+		Name:     name,
+		TimeUnit: aws.StringValue(budget.TimeUnit),
+	}
+	amount := func(spend *budgets.Spend) (float64, error) {
+		value, err := strconv.ParseFloat(aws.StringValue(spend.Amount), 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse amount %q of budget %s: %w", aws.StringValue(spend.Amount), name, err)
+		}
+		if parsed.Unit == "" {
+			parsed.Unit = aws.StringValue(spend.Unit)
+		}
+		return value, nil
+	}
+	var err error
+	if budget.BudgetLimit != nil {
+		if parsed.Limit, err = amount(budget.BudgetLimit); err != nil {
+			return nil, err
+		}
+	}
+	if budget.CalculatedSpend == nil {
+		return parsed, nil
+	}
+	if spend := budget.CalculatedSpend.ActualSpend; spend != nil {
+		if parsed.Actual, err = amount(spend); err != nil {
+			return nil, err
+		}
+	}
+	if spend := budget.CalculatedSpend.ForecastedSpend; spend != nil {
+		forecast, err := amount(spend)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Forecast = &forecast
+	}
+	return parsed, nil
+}
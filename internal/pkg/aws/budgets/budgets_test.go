@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package budgets
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgets_BudgetsWithTag(t *testing.T) {
+	tagged := map[string][]*string{
+		costFilterTagKeyValue: aws.StringSlice([]string{"user:copilot-application$other-app", "user:copilot-application$my-app"}),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []*Budget
+		wantedError error
+	}{
+		"returns the budgets filtered on the tag across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudgets(&budgets.DescribeBudgetsInput{
+					AccountId: aws.String("123456789012"),
+				}).Return(&budgets.DescribeBudgetsOutput{
+					Budgets: []*budgets.Budget{
+						{
+							BudgetName:  aws.String("my-app-monthly"),
+							TimeUnit:    aws.String("MONTHLY"),
+							CostFilters: tagged,
+							BudgetLimit: &budgets.Spend{Amount: aws.String("100.0"), Unit: aws.String("USD")},
+							CalculatedSpend: &budgets.CalculatedSpend{
+								ActualSpend:     &budgets.Spend{Amount: aws.String("42.5"), Unit: aws.String("USD")},
+								ForecastedSpend: &budgets.Spend{Amount: aws.String("110"), Unit: aws.String("USD")},
+							},
+						},
+						{
+							BudgetName: aws.String("account"),
+							TimeUnit:   aws.String("MONTHLY"),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeBudgets(&budgets.DescribeBudgetsInput{
+					AccountId: aws.String("123456789012"),
+					NextToken: aws.String("next"),
+				}).Return(&budgets.DescribeBudgetsOutput{
+					Budgets: []*budgets.Budget{
+						{
+							BudgetName:  aws.String("my-app-planned"),
+							TimeUnit:    aws.String("QUARTERLY"),
+							CostFilters: tagged,
+						},
+					},
+				}, nil)
+			},
+			wanted: []*Budget{
+				{Name: "my-app-monthly", TimeUnit: "MONTHLY", Unit: "USD", Limit: 100, Actual: 42.5, Forecast: aws.Float64(110)},
+				{Name: "my-app-planned", TimeUnit: "QUARTERLY"},
+			},
+		},
+		"wraps the error of the API": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudgets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe budgets of account 123456789012: some error"),
+		},
+		"errors if an amount isn't a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBudgets(gomock.Any()).Return(&budgets.DescribeBudgetsOutput{
+					Budgets: []*budgets.Budget{
+						{
+							BudgetName:  aws.String("my-app-monthly"),
+							CostFilters: tagged,
+							BudgetLimit: &budgets.Spend{Amount: aws.String("lots"), Unit: aws.String("USD")},
+						},
+					},
+				}, nil)
+			},
+			wantedError: fmt.Errorf(`parse amount "lots" of budget my-app-monthly: strconv.ParseFloat: parsing "lots": invalid syntax`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockAPI)
+
+			b := Budgets{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := b.BudgetsWithTag("123456789012", "copilot-application", "my-app")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/budgets/budgets.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	budgets "github.com/aws/aws-sdk-go/service/budgets"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// DescribeBudgets mocks base method
+func (m *Mockapi) DescribeBudgets(input *budgets.DescribeBudgetsInput) (*budgets.DescribeBudgetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBudgets", input)
+	ret0, _ := ret[0].(*budgets.DescribeBudgetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBudgets indicates an expected call of DescribeBudgets
+func (mr *MockapiMockRecorder) DescribeBudgets(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBudgets", reflect.TypeOf((*Mockapi)(nil).DescribeBudgets), input)
+}
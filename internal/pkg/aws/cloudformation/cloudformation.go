@@ -187,6 +187,26 @@ func (c *CloudFormation) Describe(name string) (*StackDescription, error) {
 	return &descr, nil
 }
 
+// StackResources returns the resources created by an existing stack.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
+	out, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(name),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: name}
+		}
+		return nil, fmt.Errorf("describe resources for stack %s: %w", name, err)
+	}
+	resources := make([]*StackResource, len(out.StackResources))
+	for i, resource := range out.StackResources {
+		r := StackResource(*resource)
+		resources[i] = &r
+	}
+	return resources, nil
+}
+
 // TemplateBody returns the template body of an existing stack.
 // If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) TemplateBody(name string) (string, error) {
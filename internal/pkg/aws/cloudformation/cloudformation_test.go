@@ -509,6 +509,77 @@ func TestCloudFormation_Describe(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_StackResources(t *testing.T) {
+	testCases := map[string]struct {
+		createMock      func(ctrl *gomock.Controller) client
+		wantedResources []*StackResource
+		wantedErr       error
+	}{
+		"return ErrStackNotFound if stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStack.Name},
+		},
+		"wraps other errors": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe resources for stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"returns the stack resources": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackResourcesOutput{
+					StackResources: []*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("Service"),
+							PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789012:service/my-svc/1234"),
+							ResourceType:       aws.String("AWS::AppRunner::Service"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedResources: []*StackResource{
+				{
+					LogicalResourceId:  aws.String("Service"),
+					PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789012:service/my-svc/1234"),
+					ResourceType:       aws.String("AWS::AppRunner::Service"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			resources, err := c.StackResources(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, resources)
+		})
+	}
+}
+
 func TestCloudFormation_TemplateBody(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
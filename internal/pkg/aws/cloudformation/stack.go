@@ -76,6 +76,9 @@ func WithRoleARN(roleARN string) StackOption {
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
+// StackResource represents a resource created by an existing AWS CloudFormation stack.
+type StackResource cloudformation.StackResource
+
 // StackDescription represents an existing AWS CloudFormation stack.
 type StackDescription cloudformation.Stack
 
@@ -18,6 +18,13 @@ var (
 		cloudformation.StackStatusImportComplete,
 	}
 
+	rolledBackStackStatuses = []string{
+		cloudformation.StackStatusRollbackComplete,
+		cloudformation.StackStatusRollbackFailed,
+		cloudformation.StackStatusUpdateRollbackComplete,
+		cloudformation.StackStatusUpdateRollbackFailed,
+	}
+
 	failureStackStatuses = []string{
 		cloudformation.StackStatusCreateFailed,
 		cloudformation.StackStatusDeleteFailed,
@@ -69,3 +76,13 @@ func (ss StackStatus) Failure() bool {
 	}
 	return false
 }
+
+// RolledBack returns true if the last operation on the stack was rolled back.
+func (ss StackStatus) RolledBack() bool {
+	for _, rolledBack := range rolledBackStackStatuses {
+		if string(ss) == rolledBack {
+			return true
+		}
+	}
+	return false
+}
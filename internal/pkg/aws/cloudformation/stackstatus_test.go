@@ -143,3 +143,39 @@ func TestStackStatus_Failure(t *testing.T) {
 		})
 	}
 }
+
+func TestStackStatus_RolledBack(t *testing.T) {
+	testCases := map[string]struct {
+		status string
+
+		wanted bool
+	}{
+		"should be false if stack is created succesfully": {
+			status: cloudformation.StackStatusCreateComplete,
+			wanted: false,
+		},
+		"should be false if stack rollback is in progress": {
+			status: cloudformation.StackStatusUpdateRollbackInProgress,
+			wanted: false,
+		},
+		"should be true if stack creation rolled back": {
+			status: cloudformation.StackStatusRollbackComplete,
+			wanted: true,
+		},
+		"should be true if stack update rolled back": {
+			status: cloudformation.StackStatusUpdateRollbackComplete,
+			wanted: true,
+		},
+		"should be true if stack update rollback failed": {
+			status: cloudformation.StackStatusUpdateRollbackFailed,
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := StackStatus(tc.status).RolledBack()
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cloudtrail provides a client to make API requests to AWS CloudTrail.
+package cloudtrail
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+// Names of the CloudFormation API calls that deploy a stack.
+var stackDeploymentEvents = map[string]bool{
+	"CreateStack":      true,
+	"UpdateStack":      true,
+	"ExecuteChangeSet": true,
+}
+
+type api interface {
+	LookupEvents(input *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// CloudTrail wraps an AWS CloudTrail client.
+type CloudTrail struct {
+	client api
+}
+
+// StackDeployment is a call that created or updated a CloudFormation stack.
+type StackDeployment struct {
+	Initiator string    // IAM principal that made the call, and the AWS service it was made through if any.
+	Time      time.Time // When the call was made.
+}
+
+// New returns a CloudTrail client configured against the input session.
+func New(s *session.Session) *CloudTrail {
+	return &CloudTrail{
+		client: cloudtrail.New(s),
+	}
+}
+
+// LastStackDeployment returns the most recent call that deployed the stack given its name or ID.
+// It returns nil if there is no such call in the event history, which only covers the last 90 days.
+func (c *CloudTrail) LastStackDeployment(stack string) (*StackDeployment, error) {
+	in := &cloudtrail.LookupEventsInput{
+		LookupAttributes: []*cloudtrail.LookupAttribute{
+			{
+				AttributeKey:   aws.String(cloudtrail.LookupAttributeKeyResourceName),
+				AttributeValue: aws.String(stack),
+			},
+		},
+	}
+	for {
+		out, err := c.client.LookupEvents(in)
+		if err != nil {
+			return nil, fmt.Errorf("look up events of stack %s: %w", stack, err)
+		}
+		// The events are returned from the most recent to the oldest.
+		for _, event := range out.Events {
+			if !stackDeploymentEvents[aws.StringValue(event.EventName)] {
+				continue
+			}
+			return &StackDeployment{
+				Initiator: initiator(event),
+				Time:      aws.TimeValue(event.EventTime),
+			}, nil
+		}
+		if out.NextToken == nil {
+			return nil, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// initiator returns the ARN of the principal that made the call, followed by the service it was made through,
+// like "arn:aws:sts::123456789012:assumed-role/pipeline-role/1234 via codepipeline.amazonaws.com".
+// It falls back to the user name of the event if the record can't be parsed.
+func initiator(event *cloudtrail.Event) string {
+	var record struct {
+		UserIdentity struct {
+			ARN       string `json:"arn"`
+			InvokedBy string `json:"invokedBy"`
+		} `json:"userIdentity"`
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(event.CloudTrailEvent)), &record); err != nil || record.UserIdentity.ARN == "" {
+		return aws.StringValue(event.Username)
+	}
+	if record.UserIdentity.InvokedBy == "" {
+		return record.UserIdentity.ARN
+	}
+	return fmt.Sprintf("%s via %s", record.UserIdentity.ARN, record.UserIdentity.InvokedBy)
+}
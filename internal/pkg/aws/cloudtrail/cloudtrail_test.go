@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudtrail
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudTrail_LastStackDeployment(t *testing.T) {
+	const mockStack = "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test/1234"
+	mockErr := errors.New("some error")
+	mockTime := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	mockInput := &cloudtrail.LookupEventsInput{
+		LookupAttributes: []*cloudtrail.LookupAttribute{
+			{
+				AttributeKey:   aws.String("ResourceName"),
+				AttributeValue: aws.String(mockStack),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedDeployment *StackDeployment
+		wantedErr        error
+	}{
+		"errors if fail to look up the events": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().LookupEvents(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("look up events of stack %s: %w", mockStack, mockErr),
+		},
+		"returns the principal and the service of the most recent deployment": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().LookupEvents(mockInput).Return(&cloudtrail.LookupEventsOutput{
+					Events: []*cloudtrail.Event{
+						{
+							EventName: aws.String("DescribeStacks"),
+							EventTime: aws.Time(mockTime.Add(time.Hour)),
+						},
+						{
+							EventName:       aws.String("ExecuteChangeSet"),
+							EventTime:       aws.Time(mockTime),
+							Username:        aws.String("1234"),
+							CloudTrailEvent: aws.String(`{"userIdentity":{"arn":"arn:aws:sts::123456789012:assumed-role/pipeline-role/1234","invokedBy":"codepipeline.amazonaws.com"}}`),
+						},
+						{
+							EventName: aws.String("CreateStack"),
+							EventTime: aws.Time(mockTime.Add(-time.Hour)),
+							Username:  aws.String("alice"),
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &StackDeployment{
+				Initiator: "arn:aws:sts::123456789012:assumed-role/pipeline-role/1234 via codepipeline.amazonaws.com",
+				Time:      mockTime,
+			},
+		},
+		"falls back to the user name and looks up the next pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().LookupEvents(mockInput).Return(&cloudtrail.LookupEventsOutput{
+					Events: []*cloudtrail.Event{
+						{
+							EventName: aws.String("DescribeStacks"),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().LookupEvents(&cloudtrail.LookupEventsInput{
+					LookupAttributes: mockInput.LookupAttributes,
+					NextToken:        aws.String("next"),
+				}).Return(&cloudtrail.LookupEventsOutput{
+					Events: []*cloudtrail.Event{
+						{
+							EventName:       aws.String("UpdateStack"),
+							EventTime:       aws.Time(mockTime),
+							Username:        aws.String("alice"),
+							CloudTrailEvent: aws.String(`not json`),
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &StackDeployment{
+				Initiator: "alice",
+				Time:      mockTime,
+			},
+		},
+		"returns nil if the stack wasn't deployed within the event history": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().LookupEvents(mockInput).Return(&cloudtrail.LookupEventsOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := CloudTrail{
+				client: m,
+			}
+
+			// WHEN
+			deployment, err := client.LastStackDeployment(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, deployment)
+		})
+	}
+}
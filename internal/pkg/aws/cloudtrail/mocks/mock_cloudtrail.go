@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/cloudtrail/cloudtrail.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// LookupEvents mocks base method
+func (m *Mockapi) LookupEvents(input *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupEvents", input)
+	ret0, _ := ret[0].(*cloudtrail.LookupEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupEvents indicates an expected call of LookupEvents
+func (mr *MockapiMockRecorder) LookupEvents(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupEvents", reflect.TypeOf((*Mockapi)(nil).LookupEvents), input)
+}
@@ -5,6 +5,7 @@
 package cloudwatch
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -22,13 +23,24 @@ const (
 	cloudwatchResourceType = "cloudwatch:alarm"
 	compositeAlarmType     = "Composite"
 	metricAlarmType        = "Metric"
+
+	// The number of objects in the S3 buckets is reported once a day, for all the storage classes together.
+	s3MetricNamespace         = "AWS/S3"
+	s3NumberOfObjectsMetric   = "NumberOfObjects"
+	s3StorageTypeAll          = "AllStorageTypes"
+	s3StorageMetricPeriod     = 24 * time.Hour
+	s3StorageMetricsRetrieved = 3 * s3StorageMetricPeriod // Covers the delay before the daily datapoint is published.
 )
 
+// ErrNoMetricData is returned if a metric has no datapoints in the period it's retrieved for.
+var ErrNoMetricData = errors.New("no datapoints")
+
 // humanizeDuration is overriden in tests so that its output is constant as time passes.
 var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error)
 }
 
 type resourceGetter interface {
@@ -39,6 +51,7 @@ type resourceGetter interface {
 type CloudWatch struct {
 	client   api
 	rgClient resourceGetter
+	now      func() time.Time
 }
 
 // AlarmStatus contains CloudWatch alarm status.
@@ -49,6 +62,10 @@ type AlarmStatus struct {
 	Status       string    `json:"status"`
 	Type         string    `json:"type"`
 	UpdatedTimes time.Time `json:"updatedTimes"`
+	// Metric and Threshold are the metric watched by a static threshold alarm and the value it's compared to.
+	// They're empty for the other alarms, and left out of the status output that already has them in the condition.
+	Metric    string   `json:"-"`
+	Threshold *float64 `json:"-"`
 }
 
 // New returns a CloudWatch struct configured against the input session.
@@ -56,6 +73,7 @@ func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
 		client:   cloudwatch.New(s),
 		rgClient: rg.New(s),
+		now:      time.Now,
 	}
 }
 
@@ -126,18 +144,55 @@ func (cw *CloudWatch) metricAlarmsStatus(alarms []*cloudwatch.MetricAlarm) []Ala
 			continue
 		}
 		metricAlarm := metricAlarm(*alarm)
-		alarmStatusList = append(alarmStatusList, AlarmStatus{
+		status := AlarmStatus{
 			Arn:          aws.StringValue(metricAlarm.AlarmArn),
 			Name:         aws.StringValue(metricAlarm.AlarmName),
 			Condition:    metricAlarm.condition(),
 			Status:       aws.StringValue(metricAlarm.StateValue),
 			Type:         metricAlarmType,
 			UpdatedTimes: *metricAlarm.StateUpdatedTimestamp,
-		})
+		}
+		if metricAlarm.alarmThresholdType() == static {
+			status.Metric = aws.StringValue(metricAlarm.MetricName)
+			status.Threshold = metricAlarm.Threshold
+		}
+		alarmStatusList = append(alarmStatusList, status)
 	}
 	return alarmStatusList
 }
 
+// BucketObjectCount returns the latest daily number of objects in the S3 bucket reported to CloudWatch.
+// It's a cheap approximation that doesn't list the objects, and returns ErrNoMetricData if no count was reported
+// in the last days, like for a new or an empty bucket.
+func (cw *CloudWatch) BucketObjectCount(bucket string) (int64, error) {
+	now := cw.now()
+	out, err := cw.client.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(s3MetricNamespace),
+		MetricName: aws.String(s3NumberOfObjectsMetric),
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("BucketName"), Value: aws.String(bucket)},
+			{Name: aws.String("StorageType"), Value: aws.String(s3StorageTypeAll)},
+		},
+		StartTime:  aws.Time(now.Add(-s3StorageMetricsRetrieved)),
+		EndTime:    aws.Time(now),
+		Period:     aws.Int64(int64(s3StorageMetricPeriod.Seconds())),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticAverage}),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get number of objects in bucket %s: %w", bucket, err)
+	}
+	var latest *cloudwatch.Datapoint
+	for _, datapoint := range out.Datapoints {
+		if latest == nil || aws.TimeValue(datapoint.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = datapoint
+		}
+	}
+	if latest == nil {
+		return 0, ErrNoMetricData
+	}
+	return int64(aws.Float64Value(latest.Average)), nil
+}
+
 // getAlarmName gets the alarm name given a specific alarm ARN.
 // For example: arn:aws:cloudwatch:us-west-2:1234567890:alarm:SDc-ReadCapacityUnitsLimit-BasicAlarm
 // returns SDc-ReadCapacityUnitsLimit-BasicAlarm
@@ -119,6 +119,8 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 					Condition:    "mockMetricName ≥ 70.00 for 300 datapoints within 25 minutes",
 					Status:       "mockState",
 					UpdatedTimes: mockTime,
+					Metric:       "mockMetricName",
+					Threshold:    aws.Float64(70),
 				},
 			},
 		},
@@ -317,6 +319,8 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 					Condition:    "mockMetricName1 < 63.00 for 3 datapoints within 5 minutes",
 					Status:       "mockState",
 					UpdatedTimes: mockTime,
+					Metric:       "mockMetricName1",
+					Threshold:    aws.Float64(63),
 				},
 			},
 		},
@@ -353,3 +357,76 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 
 	}
 }
+
+func TestCloudWatch_BucketObjectCount(t *testing.T) {
+	mockNow := time.Date(2021, time.June, 3, 12, 0, 0, 0, time.UTC)
+	mockInput := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/S3"),
+		MetricName: aws.String("NumberOfObjects"),
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("BucketName"), Value: aws.String("my-bucket")},
+			{Name: aws.String("StorageType"), Value: aws.String("AllStorageTypes")},
+		},
+		StartTime:  aws.Time(time.Date(2021, time.May, 31, 12, 0, 0, 0, time.UTC)),
+		EndTime:    aws.Time(mockNow),
+		Period:     aws.Int64(86400),
+		Statistics: aws.StringSlice([]string{"Average"}),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedCount int64
+		wantedErr   error
+	}{
+		"errors if fail to get the metric": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get number of objects in bucket my-bucket: some error"),
+		},
+		"returns ErrNoMetricData if there are no datapoints": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(mockInput).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+			wantedErr: ErrNoMetricData,
+		},
+		"returns the latest count": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(mockInput).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{Timestamp: aws.Time(time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC)), Average: aws.Float64(42)},
+						{Timestamp: aws.Time(time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)), Average: aws.Float64(40)},
+					},
+				}, nil)
+			},
+			wantedCount: 42,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			count, err := cwSvc.BucketObjectCount("my-bucket")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCount, count)
+		})
+	}
+}
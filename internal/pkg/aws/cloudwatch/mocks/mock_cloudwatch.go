@@ -49,6 +49,21 @@ func (mr *MockapiMockRecorder) DescribeAlarms(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarms", reflect.TypeOf((*Mockapi)(nil).DescribeAlarms), input)
 }
 
+// GetMetricStatistics mocks base method
+func (m *Mockapi) GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricStatistics", input)
+	ret0, _ := ret[0].(*cloudwatch.GetMetricStatisticsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetricStatistics indicates an expected call of GetMetricStatistics
+func (mr *MockapiMockRecorder) GetMetricStatistics(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricStatistics", reflect.TypeOf((*Mockapi)(nil).GetMetricStatistics), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
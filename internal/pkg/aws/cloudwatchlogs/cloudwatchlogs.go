@@ -26,6 +26,7 @@ var (
 )
 
 type api interface {
+	DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
 }
@@ -140,6 +141,29 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// LogGroupRetention returns the number of days that the log group keeps its events, or 0 if they never expire.
+func (c *CloudWatchLogs) LogGroupRetention(logGroup string) (int, error) {
+	in := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroup),
+	}
+	for {
+		out, err := c.client.DescribeLogGroups(in)
+		if err != nil {
+			return 0, fmt.Errorf("describe log group %s: %w", logGroup, err)
+		}
+		// The log groups are matched by prefix, so the ones whose name is longer are skipped.
+		for _, group := range out.LogGroups {
+			if aws.StringValue(group.LogGroupName) == logGroup {
+				return int(aws.Int64Value(group.RetentionInDays)), nil
+			}
+		}
+		if out.NextToken == nil {
+			return 0, fmt.Errorf("log group %s not found", logGroup)
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
@@ -269,3 +269,83 @@ func TestLogEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatchLogs_LogGroupRetention(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantRetention int
+		wantErr       error
+	}{
+		"errors if fail to describe the log groups": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("describe log group /copilot/my-app-test-api: %w", mockError),
+		},
+		"returns the retention of the log group with the same name": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupNamePrefix: aws.String("/copilot/my-app-test-api"),
+				}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: aws.String("/copilot/my-app-test-api-worker"), RetentionInDays: aws.Int64(7)},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupNamePrefix: aws.String("/copilot/my-app-test-api"),
+					NextToken:          aws.String("next"),
+				}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: aws.String("/copilot/my-app-test-api"), RetentionInDays: aws.Int64(30)},
+					},
+				}, nil)
+			},
+			wantRetention: 30,
+		},
+		"returns 0 if the events never expire": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: aws.String("/copilot/my-app-test-api")},
+					},
+				}, nil)
+			},
+			wantRetention: 0,
+		},
+		"errors if the log group doesn't exist": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{}, nil)
+			},
+			wantErr: errors.New("log group /copilot/my-app-test-api not found"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			retention, err := service.LogGroupRetention("/copilot/my-app-test-api")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRetention, retention)
+		})
+	}
+}
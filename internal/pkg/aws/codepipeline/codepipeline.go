@@ -7,6 +7,7 @@ package codepipeline
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -21,6 +22,12 @@ import (
 
 const (
 	pipelineResourceType = "codepipeline:pipeline"
+
+	connectionSourceProvider = "CodeStarSourceConnection"
+	buildProvider            = "CodeBuild"
+
+	// fmtBuildURL is the console link to a build, used if the pipeline state doesn't link to it.
+	fmtBuildURL = "https://%s.console.aws.amazon.com/codesuite/codebuild/%s/projects/%s/build/%s/?region=%s"
 )
 
 type api interface {
@@ -28,6 +35,7 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	ListTagsForResource(input *cp.ListTagsForResourceInput) (*cp.ListTagsForResourceOutput, error)
 }
 
 type resourceGetter interface {
@@ -48,6 +56,56 @@ type Pipeline struct {
 	Stages    []*Stage  `json:"stages"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Connection is the CodeStar connection of the source stage, if the pipeline's source uses one.
+	Connection *SourceConnection `json:"connection,omitempty"`
+	// Build is the CodeBuild project of the build stage, if the pipeline has one.
+	Build *PipelineBuild `json:"build,omitempty"`
+	// ArtifactStore is the bucket that the pipeline stores its artifacts in, only retrieved if asked for.
+	ArtifactStore *ArtifactStore `json:"artifactStore,omitempty"`
+	// DanglingEnvs are the environments that the deploy stages of the pipeline target but that don't exist anymore,
+	// set by the caller that knows the environments of the application.
+	DanglingEnvs []string `json:"danglingEnvs,omitempty"`
+}
+
+// ArtifactStore represents the S3 bucket that a pipeline stores its artifacts in, and how they're encrypted and retained.
+type ArtifactStore struct {
+	Bucket string `json:"bucket"`
+	// EncryptionKey is the ID or ARN of the AWS KMS key that the pipeline encrypts its artifacts with,
+	// empty if the pipeline doesn't set one.
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+	// BucketEncryption is the algorithm of the default encryption of the bucket, like "aws:kms" or "AES256",
+	// empty if the bucket has none. ExpirationDays is the number of days after which the lifecycle rules of the bucket
+	// expire the artifacts, nil if they're kept forever. They're retrieved from the bucket rather than from the pipeline.
+	BucketEncryption string `json:"bucketEncryption,omitempty"`
+	ExpirationDays   *int64 `json:"expirationDays,omitempty"`
+	// Encrypted is false if neither the pipeline nor the bucket encrypt the artifacts, and nil if it's unknown.
+	Encrypted *bool `json:"encrypted,omitempty"`
+}
+
+// PipelineBuild represents the CodeBuild project that a pipeline runs in its build stage.
+type PipelineBuild struct {
+	Project string `json:"project"`
+	// Last is the most recent build of the project run by the pipeline, only retrieved if asked for.
+	Last *BuildExecution `json:"last,omitempty"`
+
+	// StageName and ActionName locate the build action in the state of the pipeline.
+	StageName  string `json:"-"`
+	ActionName string `json:"-"`
+}
+
+// BuildExecution represents a build of a CodeBuild project run by a pipeline.
+type BuildExecution struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	URL    string `json:"url"`
+}
+
+// SourceConnection represents the CodeStar connection to the source repository of a pipeline.
+type SourceConnection struct {
+	ARN          string `json:"arn"`
+	ProviderType string `json:"providerType,omitempty"`
+	Status       string `json:"status,omitempty"`
 }
 
 // Stage wraps the codepipeline pipeline stage.
@@ -56,6 +114,17 @@ type Stage struct {
 	Category string `json:"category"`
 	Provider string `json:"provider"`
 	Details  string `json:"details"`
+	// StackNames are the stacks deployed by the actions of a deploy stage.
+	StackNames []string `json:"-"`
+	// Deploys are the actions of a deploy stage with the stack they deploy, in the order of StackNames.
+	Deploys []*StageDeploy `json:"-"`
+}
+
+// StageDeploy is an action of a deploy stage that deploys a stack.
+type StageDeploy struct {
+	StackName string
+	// RunOrder is the order the action runs in within its stage. Actions with the same order run in parallel.
+	RunOrder int
 }
 
 // PipelineState represents a Pipeline's status.
@@ -142,18 +211,153 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 	}
 
 	return &Pipeline{
-		Name:      aws.StringValue(pipeline.Name),
-		Region:    parsedArn.Region,
-		AccountID: parsedArn.AccountID,
-		Stages:    stages,
-		CreatedAt: *metadata.Created,
-		UpdatedAt: *metadata.Updated,
+		Name:       aws.StringValue(pipeline.Name),
+		Region:     parsedArn.Region,
+		AccountID:  parsedArn.AccountID,
+		Stages:     stages,
+		CreatedAt:  *metadata.Created,
+		UpdatedAt:  *metadata.Updated,
+		Connection: sourceConnection(pipeline.Stages),
+		Build:      build(pipeline.Stages),
 	}, nil
 }
 
+// ArtifactStore returns the bucket that the pipeline stores its artifacts in and the key it encrypts them with.
+// A pipeline with actions in several regions has a store per region, and the one of the region of the pipeline is returned.
+func (c *CodePipeline) ArtifactStore(name string) (*ArtifactStore, error) {
+	resp, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", name, err)
+	}
+	store := resp.Pipeline.ArtifactStore
+	if store == nil {
+		pipelineARN := aws.StringValue(resp.Metadata.PipelineArn)
+		parsedARN, err := arn.Parse(pipelineARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse pipeline ARN: %s", pipelineARN)
+		}
+		store = resp.Pipeline.ArtifactStores[parsedARN.Region]
+	}
+	if store == nil {
+		return nil, fmt.Errorf("pipeline %s has no artifact store", name)
+	}
+	artifactStore := &ArtifactStore{
+		Bucket: aws.StringValue(store.Location),
+	}
+	if store.EncryptionKey != nil {
+		artifactStore.EncryptionKey = aws.StringValue(store.EncryptionKey.Id)
+	}
+	return artifactStore, nil
+}
+
+// PipelineTags returns the tags of the pipeline, like the application it was created for.
+func (c *CodePipeline) PipelineTags(name string) (map[string]string, error) {
+	resp, err := c.client.GetPipeline(&cp.GetPipelineInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %s: %w", name, err)
+	}
+	pipelineARN := aws.StringValue(resp.Metadata.PipelineArn)
+	tags := make(map[string]string)
+	in := &cp.ListTagsForResourceInput{
+		ResourceArn: aws.String(pipelineARN),
+	}
+	for {
+		out, err := c.client.ListTagsForResource(in)
+		if err != nil {
+			return nil, fmt.Errorf("list tags of pipeline %s: %w", name, err)
+		}
+		for _, tag := range out.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			return tags, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// build returns the project of the first CodeBuild action of a build stage, or nil if there's none.
+func build(stages []*cp.StageDeclaration) *PipelineBuild {
+	for _, stage := range stages {
+		for _, action := range stage.Actions {
+			if action.ActionTypeId == nil || aws.StringValue(action.ActionTypeId.Provider) != buildProvider {
+				continue
+			}
+			if project := aws.StringValue(action.Configuration["ProjectName"]); project != "" {
+				return &PipelineBuild{
+					Project:    project,
+					StageName:  aws.StringValue(stage.Name),
+					ActionName: aws.StringValue(action.Name),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// LastBuild returns the most recent build run by the build stage of the pipeline, or nil if the pipeline has no build
+// stage or it never ran.
+func (c *CodePipeline) LastBuild(pipeline *Pipeline) (*BuildExecution, error) {
+	if pipeline.Build == nil {
+		return nil, nil
+	}
+	resp, err := c.client.GetPipelineState(&cp.GetPipelineStateInput{
+		Name: aws.String(pipeline.Name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline state %s: %w", pipeline.Name, err)
+	}
+	for _, stage := range resp.StageStates {
+		if aws.StringValue(stage.StageName) != pipeline.Build.StageName {
+			continue
+		}
+		for _, action := range stage.ActionStates {
+			if aws.StringValue(action.ActionName) != pipeline.Build.ActionName || action.LatestExecution == nil {
+				continue
+			}
+			execution := action.LatestExecution
+			id := aws.StringValue(execution.ExternalExecutionId)
+			if id == "" {
+				return nil, nil
+			}
+			buildURL := aws.StringValue(execution.ExternalExecutionUrl)
+			if buildURL == "" {
+				buildURL = fmt.Sprintf(fmtBuildURL, pipeline.Region, pipeline.AccountID, pipeline.Build.Project, url.PathEscape(id), pipeline.Region)
+			}
+			return &BuildExecution{
+				ID:     id,
+				Status: aws.StringValue(execution.Status),
+				URL:    buildURL,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// sourceConnection returns the connection of the first source action that uses a CodeStar connection, or nil if there's none.
+// Only the ARN is set, the provider type and status are retrieved from CodeStar Connections.
+func sourceConnection(stages []*cp.StageDeclaration) *SourceConnection {
+	for _, stage := range stages {
+		for _, action := range stage.Actions {
+			if action.ActionTypeId == nil || aws.StringValue(action.ActionTypeId.Provider) != connectionSourceProvider {
+				continue
+			}
+			if arn := aws.StringValue(action.Configuration["ConnectionArn"]); arn != "" {
+				return &SourceConnection{ARN: arn}
+			}
+		}
+	}
+	return nil
+}
+
 // HumanString returns the stringified Stage struct with human readable format.
 // Example output:
-//   DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
+//
+//	DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
 func (s *Stage) HumanString() string {
 	return fmt.Sprintf("  %s\t%s\t%s\t%s\n", s.Name, s.Category, s.Provider, s.Details)
 }
@@ -265,7 +469,8 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 
 // HumanString returns the stringified PipelineState struct with human readable format.
 // Example output:
-//   DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
+//
+//	DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
 func (ss *StageState) HumanString() string {
 	status := ss.AggregateStatus()
 	transition := ss.Transition
@@ -280,6 +485,8 @@ func (ss *StageState) HumanString() string {
 func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 	name := aws.StringValue(s.Name)
 	var category, provider, details string
+	var stackNames []string
+	var deploys []*StageDeploy
 
 	if len(s.Actions) > 0 {
 		// Currently, we only support Source, Build and Deploy stages, all of which must contain at least one action.
@@ -307,14 +514,26 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		case "Deploy":
 			// Currently, we use Cloudformation only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CloudFormation.html#action-reference-CloudFormation-config
 			details = fmt.Sprintf("StackName: %s", aws.StringValue(config["StackName"]))
+			// Unlike the details, the stacks are the ones of all the actions, like one per service.
+			for _, deployAction := range s.Actions {
+				if stackName := aws.StringValue(deployAction.Configuration["StackName"]); stackName != "" {
+					stackNames = append(stackNames, stackName)
+					deploys = append(deploys, &StageDeploy{
+						StackName: stackName,
+						RunOrder:  int(aws.Int64Value(deployAction.RunOrder)),
+					})
+				}
+			}
 		}
 	}
 
 	stage := &Stage{
-		Name:     name,
-		Category: category,
-		Provider: provider,
-		Details:  details,
+		Name:       name,
+		Category:   category,
+		Provider:   provider,
+		Details:    details,
+		StackNames: stackNames,
+		Deploys:    deploys,
 	}
 	return stage, nil
 }
@@ -109,6 +109,22 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 				RoleArn:  aws.String("arn:aws:iam::12344567890:role/dinder-test-EnvManagerRole"),
 				RunOrder: aws.Int64(2),
 			},
+			{
+				ActionTypeId: &codepipeline.ActionTypeId{
+					Category: aws.String("Deploy"),
+					Owner:    aws.String("AWS"),
+					Provider: aws.String("CloudFormation"),
+					Version:  aws.String("1"),
+				},
+				Configuration: map[string]*string{
+					"TemplatePath": aws.String("BuildOutput::infrastructure/api-test.stack.yml"),
+					"ActionMode":   aws.String("CREATE_UPDATE"),
+					"StackName":    aws.String("dinder-test-api"),
+				},
+				Name:     aws.String("CreateOrUpdate-api-test"),
+				Region:   aws.String("us-west-2"),
+				RunOrder: aws.Int64(2),
+			},
 		},
 	}
 	mockStages := []*codepipeline.StageDeclaration{mockSourceStage, mockBuildStage, mockTestStage}
@@ -162,14 +178,24 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 						Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
 					},
 					{
-						Name:     "DeployTo-test",
-						Category: "Deploy",
-						Provider: "CloudFormation",
-						Details:  "StackName: dinder-test-test",
+						Name:       "DeployTo-test",
+						Category:   "Deploy",
+						Provider:   "CloudFormation",
+						Details:    "StackName: dinder-test-test",
+						StackNames: []string{"dinder-test-test", "dinder-test-api"},
+						Deploys: []*StageDeploy{
+							{StackName: "dinder-test-test", RunOrder: 2},
+							{StackName: "dinder-test-api", RunOrder: 2},
+						},
 					},
 				},
 				CreatedAt: mockTime,
 				UpdatedAt: mockTime,
+				Build: &PipelineBuild{
+					Project:    "pipeline-dinder-badgoose-repo-BuildProject",
+					StageName:  "Build",
+					ActionName: "Build",
+				},
 			},
 			expectedError: nil,
 		},
@@ -215,6 +241,62 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"should populate the connection of a CodeStar connection source": {
+			inPipelineName: mockPipelineName,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(
+					&codepipeline.GetPipelineOutput{
+						Pipeline: &codepipeline.PipelineDeclaration{
+							Name: aws.String(mockPipelineName),
+							Stages: []*codepipeline.StageDeclaration{
+								{
+									Name: aws.String("Source"),
+									Actions: []*codepipeline.ActionDeclaration{
+										{
+											ActionTypeId: &codepipeline.ActionTypeId{
+												Category: aws.String("Source"),
+												Owner:    aws.String("AWS"),
+												Provider: aws.String("CodeStarSourceConnection"),
+												Version:  aws.String("1"),
+											},
+											Configuration: map[string]*string{
+												"ConnectionArn":    aws.String("arn:aws:codestar-connections:us-west-2:1234567890:connection/abc123"),
+												"FullRepositoryId": aws.String("badgoose/repo"),
+												"BranchName":       aws.String("main"),
+											},
+										},
+									},
+								},
+							},
+						},
+						Metadata: &codepipeline.PipelineMetadata{
+							Created:     &mockTime,
+							Updated:     &mockTime,
+							PipelineArn: aws.String(mockArn),
+						},
+					}, nil)
+			},
+			expectedOut: &Pipeline{
+				Name:      mockPipelineName,
+				Region:    "us-west-2",
+				AccountID: "1234567890",
+				Stages: []*Stage{
+					{
+						Name:     "Source",
+						Category: "Source",
+						Provider: "CodeStarSourceConnection",
+						Details:  "Repository: badgoose/repo",
+					},
+				},
+				CreatedAt: mockTime,
+				UpdatedAt: mockTime,
+				Connection: &SourceConnection{
+					ARN: "arn:aws:codestar-connections:us-west-2:1234567890:connection/abc123",
+				},
+			},
+		},
 		"should wrap error from codepipeline client": {
 			inPipelineName: mockPipelineName,
 			callMocks: func(m codepipelineMocks) {
@@ -506,6 +588,280 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 	}
 }
 
+func TestCodePipeline_LastBuild(t *testing.T) {
+	mockPipeline := &Pipeline{
+		Name:      "pipeline-dinder-badgoose-repo",
+		Region:    "us-west-2",
+		AccountID: "1234567890",
+		Build: &PipelineBuild{
+			Project:    "pipeline-dinder-badgoose-repo-BuildProject",
+			StageName:  "Build",
+			ActionName: "Build",
+		},
+	}
+	mockBuildState := func(execution *codepipeline.ActionExecution) *codepipeline.GetPipelineStateOutput {
+		return &codepipeline.GetPipelineStateOutput{
+			StageStates: []*codepipeline.StageState{
+				{
+					StageName: aws.String("Source"),
+					ActionStates: []*codepipeline.ActionState{
+						{
+							ActionName:      aws.String("SourceCodeFor-dinder"),
+							LatestExecution: &codepipeline.ActionExecution{ExternalExecutionId: aws.String("abc123")},
+						},
+					},
+				},
+				{
+					StageName: aws.String("Build"),
+					ActionStates: []*codepipeline.ActionState{
+						{
+							ActionName:      aws.String("Build"),
+							LatestExecution: execution,
+						},
+					},
+				},
+			},
+		}
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inPipeline *Pipeline
+		callMocks  func(m codepipelineMocks)
+
+		wanted      *BuildExecution
+		wantedError error
+	}{
+		"returns nil without calling the API if the pipeline has no build stage": {
+			inPipeline: &Pipeline{Name: "pipeline-dinder-badgoose-repo"},
+			callMocks:  func(m codepipelineMocks) {},
+		},
+		"returns the latest execution of the build action with its link": {
+			inPipeline: mockPipeline,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String("pipeline-dinder-badgoose-repo"),
+				}).Return(mockBuildState(&codepipeline.ActionExecution{
+					ExternalExecutionId:  aws.String("pipeline-dinder-badgoose-repo-BuildProject:1f2e3d"),
+					ExternalExecutionUrl: aws.String("https://console.aws.amazon.com/codebuild/home?region=us-west-2#/builds/pipeline-dinder-badgoose-repo-BuildProject:1f2e3d/view/new"),
+					Status:               aws.String(codepipeline.ActionExecutionStatusFailed),
+				}), nil)
+			},
+			wanted: &BuildExecution{
+				ID:     "pipeline-dinder-badgoose-repo-BuildProject:1f2e3d",
+				Status: "Failed",
+				URL:    "https://console.aws.amazon.com/codebuild/home?region=us-west-2#/builds/pipeline-dinder-badgoose-repo-BuildProject:1f2e3d/view/new",
+			},
+		},
+		"links to the build in the console if the state doesn't": {
+			inPipeline: mockPipeline,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockBuildState(&codepipeline.ActionExecution{
+					ExternalExecutionId: aws.String("pipeline-dinder-badgoose-repo-BuildProject:1f2e3d"),
+					Status:              aws.String(codepipeline.ActionExecutionStatusSucceeded),
+				}), nil)
+			},
+			wanted: &BuildExecution{
+				ID:     "pipeline-dinder-badgoose-repo-BuildProject:1f2e3d",
+				Status: "Succeeded",
+				URL:    "https://us-west-2.console.aws.amazon.com/codesuite/codebuild/1234567890/projects/pipeline-dinder-badgoose-repo-BuildProject/build/pipeline-dinder-badgoose-repo-BuildProject:1f2e3d/?region=us-west-2",
+			},
+		},
+		"returns nil if the build action never ran": {
+			inPipeline: mockPipeline,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockBuildState(nil), nil)
+			},
+		},
+		"wraps the error from codepipeline client": {
+			inPipeline: mockPipeline,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get pipeline state pipeline-dinder-badgoose-repo: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := cp.LastBuild(tc.inPipeline)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCodePipeline_ArtifactStore(t *testing.T) {
+	const mockPipelineARN = "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-badgoose-repo"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted      *ArtifactStore
+		wantedError error
+	}{
+		"returns the bucket and the key of the artifact store": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String("pipeline-dinder-badgoose-repo"),
+				}).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{
+						ArtifactStore: &codepipeline.ArtifactStore{
+							Location:      aws.String("stackset-dinder-bucket"),
+							EncryptionKey: &codepipeline.EncryptionKey{Id: aws.String("arn:aws:kms:us-west-2:1234567890:key/abcd")},
+						},
+					},
+					Metadata: &codepipeline.PipelineMetadata{PipelineArn: aws.String(mockPipelineARN)},
+				}, nil)
+			},
+			wanted: &ArtifactStore{
+				Bucket:        "stackset-dinder-bucket",
+				EncryptionKey: "arn:aws:kms:us-west-2:1234567890:key/abcd",
+			},
+		},
+		"returns the artifact store of the region of a cross-region pipeline": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{
+						ArtifactStores: map[string]*codepipeline.ArtifactStore{
+							"us-west-2": {Location: aws.String("stackset-dinder-us-west-2-bucket")},
+							"us-east-1": {Location: aws.String("stackset-dinder-us-east-1-bucket")},
+						},
+					},
+					Metadata: &codepipeline.PipelineMetadata{PipelineArn: aws.String(mockPipelineARN)},
+				}, nil)
+			},
+			wanted: &ArtifactStore{
+				Bucket: "stackset-dinder-us-west-2-bucket",
+			},
+		},
+		"errors if the pipeline has no artifact store": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(&codepipeline.GetPipelineOutput{
+					Pipeline: &codepipeline.PipelineDeclaration{},
+					Metadata: &codepipeline.PipelineMetadata{PipelineArn: aws.String(mockPipelineARN)},
+				}, nil)
+			},
+			wantedError: errors.New("pipeline pipeline-dinder-badgoose-repo has no artifact store"),
+		},
+		"wraps the error if the pipeline can't be retrieved": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get pipeline pipeline-dinder-badgoose-repo: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := cp.ArtifactStore("pipeline-dinder-badgoose-repo")
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCodePipeline_PipelineTags(t *testing.T) {
+	const mockPipelineARN = "arn:aws:codepipeline:us-west-2:1234567890:pipeline-dinder-badgoose-repo"
+	mockGetPipelineOutput := &codepipeline.GetPipelineOutput{
+		Pipeline: &codepipeline.PipelineDeclaration{Name: aws.String("pipeline-dinder-badgoose-repo")},
+		Metadata: &codepipeline.PipelineMetadata{PipelineArn: aws.String(mockPipelineARN)},
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted      map[string]string
+		wantedError error
+	}{
+		"returns the tags of all the pages": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(&codepipeline.GetPipelineInput{
+					Name: aws.String("pipeline-dinder-badgoose-repo"),
+				}).Return(mockGetPipelineOutput, nil)
+				m.cp.EXPECT().ListTagsForResource(&codepipeline.ListTagsForResourceInput{
+					ResourceArn: aws.String(mockPipelineARN),
+				}).Return(&codepipeline.ListTagsForResourceOutput{
+					Tags:      []*codepipeline.Tag{{Key: aws.String("copilot-application"), Value: aws.String("dinder")}},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.cp.EXPECT().ListTagsForResource(&codepipeline.ListTagsForResourceInput{
+					ResourceArn: aws.String(mockPipelineARN),
+					NextToken:   aws.String("next"),
+				}).Return(&codepipeline.ListTagsForResourceOutput{
+					Tags: []*codepipeline.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+				}, nil)
+			},
+			wanted: map[string]string{
+				"copilot-application": "dinder",
+				"team":                "platform",
+			},
+		},
+		"wraps the error if the pipeline can't be retrieved": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get pipeline pipeline-dinder-badgoose-repo: %w", mockError),
+		},
+		"wraps the error if the tags can't be listed": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipeline(gomock.Any()).Return(mockGetPipelineOutput, nil)
+				m.cp.EXPECT().ListTagsForResource(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("list tags of pipeline pipeline-dinder-badgoose-repo: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := cp.PipelineTags("pipeline-dinder-badgoose-repo")
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestCodePipeline_RetryStageExecution(t *testing.T) {
 	mockPipelineName := "pipeline-dinder-badgoose-repo"
 	mockStageName := "Source"
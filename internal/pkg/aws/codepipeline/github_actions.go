@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codepipeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+)
+
+const (
+	stackResourceType = "cloudformation:stack"
+
+	// Tags of the deploy records that GitHub Actions workflows add to the stacks they deploy.
+	GitHubWorkflowTagKey   = "copilot-github-workflow"    // Name of the workflow.
+	GitHubRepositoryTagKey = "copilot-github-repository"  // Repository of the workflow, like "owner/repo".
+	GitHubDeployedAtTagKey = "copilot-github-deployed-at" // RFC 3339 time of the last deployment of the stack by the workflow.
+
+	environmentTagKey = "copilot-environment"
+
+	gitHubActionsProvider = "GitHubActions"
+	fmtDeployStageName    = "DeployTo-%s"
+	fmtDeployStageDetails = "StackName: %s"
+	fmtSourceStageDetails = "Repository: %s"
+	sourceStageName       = "Source"
+	sourceStageCategory   = "Source"
+	sourceStageProvider   = "GitHub"
+	deployStageCategory   = "Deploy"
+)
+
+// GitHubActions reads the deploy records of GitHub Actions workflows from the tags of the stacks they deploy,
+// and returns each workflow as a Pipeline.
+type GitHubActions struct {
+	rgClient resourceGetter
+}
+
+// NewGitHubActions returns a GitHubActions client configured against the input session.
+func NewGitHubActions(s *session.Session) *GitHubActions {
+	return &GitHubActions{
+		rgClient: rg.New(s),
+	}
+}
+
+// GetPipeline returns the GitHub Actions workflow with the given name.
+func (g *GitHubActions) GetPipeline(name string) (*Pipeline, error) {
+	pipelines, err := g.GetPipelinesByTags(map[string]string{
+		GitHubWorkflowTagKey: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pipelines) == 0 {
+		return nil, fmt.Errorf("GitHub Actions workflow %s not found", name)
+	}
+	return pipelines[0], nil
+}
+
+// ListPipelineNamesByTags returns the names of the GitHub Actions workflows that deployed stacks with the tags.
+func (g *GitHubActions) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+	pipelines, err := g.GetPipelinesByTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+	return names, nil
+}
+
+// GetPipelinesByTags returns the GitHub Actions workflows that deployed stacks with the tags, sorted by name.
+// Each workflow has a source stage for its repository, followed by a deploy stage for each environment it deployed to,
+// from the least to the most recently deployed.
+func (g *GitHubActions) GetPipelinesByTags(tags map[string]string) ([]*Pipeline, error) {
+	resources, err := g.rgClient.GetResourcesByTags(stackResourceType, tags)
+	if err != nil {
+		return nil, err
+	}
+	type envDeploy struct {
+		env        string
+		stacks     []string
+		deployedAt time.Time
+	}
+	type workflow struct {
+		pipeline *Pipeline
+		repo     string
+		envs     map[string]*envDeploy
+	}
+	workflows := make(map[string]*workflow)
+	for _, resource := range resources {
+		name := resource.Tags[GitHubWorkflowTagKey]
+		if name == "" {
+			// The stack wasn't deployed by a GitHub Actions workflow.
+			continue
+		}
+		parsedARN, err := arn.Parse(resource.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse stack ARN: %s", resource.ARN)
+		}
+		var deployedAt time.Time
+		if value := resource.Tags[GitHubDeployedAtTagKey]; value != "" {
+			deployedAt, err = time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("parse tag %s of stack %s: %w", GitHubDeployedAtTagKey, resource.ARN, err)
+			}
+		}
+		w, ok := workflows[name]
+		if !ok {
+			w = &workflow{
+				pipeline: &Pipeline{
+					Name:      name,
+					Region:    parsedARN.Region,
+					AccountID: parsedARN.AccountID,
+				},
+				envs: make(map[string]*envDeploy),
+			}
+			workflows[name] = w
+		}
+		if repo := resource.Tags[GitHubRepositoryTagKey]; repo != "" {
+			w.repo = repo
+		}
+		if !deployedAt.IsZero() && (w.pipeline.CreatedAt.IsZero() || deployedAt.Before(w.pipeline.CreatedAt)) {
+			w.pipeline.CreatedAt = deployedAt
+		}
+		if deployedAt.After(w.pipeline.UpdatedAt) {
+			w.pipeline.UpdatedAt = deployedAt
+		}
+		env := resource.Tags[environmentTagKey]
+		deploy, ok := w.envs[env]
+		if !ok {
+			deploy = &envDeploy{env: env}
+			w.envs[env] = deploy
+		}
+		deploy.stacks = append(deploy.stacks, stackName(parsedARN.Resource))
+		if deployedAt.After(deploy.deployedAt) {
+			deploy.deployedAt = deployedAt
+		}
+	}
+	var pipelines []*Pipeline
+	for _, w := range workflows {
+		stages := []*Stage{
+			{
+				Name:     sourceStageName,
+				Category: sourceStageCategory,
+				Provider: sourceStageProvider,
+				Details:  fmt.Sprintf(fmtSourceStageDetails, w.repo),
+			},
+		}
+		var deploys []*envDeploy
+		for _, deploy := range w.envs {
+			deploys = append(deploys, deploy)
+		}
+		sort.Slice(deploys, func(i, j int) bool {
+			if !deploys[i].deployedAt.Equal(deploys[j].deployedAt) {
+				return deploys[i].deployedAt.Before(deploys[j].deployedAt)
+			}
+			return deploys[i].env < deploys[j].env
+		})
+		for _, deploy := range deploys {
+			sort.Strings(deploy.stacks)
+			// The order the workflow deploys the stacks of an environment in isn't recorded, so they all run first.
+			var stageDeploys []*StageDeploy
+			for _, stack := range deploy.stacks {
+				stageDeploys = append(stageDeploys, &StageDeploy{StackName: stack, RunOrder: 1})
+			}
+			stages = append(stages, &Stage{
+				Name:       fmt.Sprintf(fmtDeployStageName, deploy.env),
+				Category:   deployStageCategory,
+				Provider:   gitHubActionsProvider,
+				Details:    fmt.Sprintf(fmtDeployStageDetails, strings.Join(deploy.stacks, ", ")),
+				StackNames: deploy.stacks,
+				Deploys:    stageDeploys,
+			})
+		}
+		w.pipeline.Stages = stages
+		pipelines = append(pipelines, w.pipeline)
+	}
+	sort.Slice(pipelines, func(i, j int) bool {
+		return pipelines[i].Name < pipelines[j].Name
+	})
+	return pipelines, nil
+}
+
+// stackName returns the name of the stack from the resource of its ARN, like "stack/my-app-test/1234".
+func stackName(resource string) string {
+	parts := strings.Split(resource, "/")
+	if len(parts) < 2 {
+		return resource
+	}
+	return parts[1]
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codepipeline
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline/mocks"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubActions_GetPipelinesByTags(t *testing.T) {
+	mockTags := map[string]string{"copilot-application": "my-app"}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockresourceGetter)
+
+		wantedPipelines []*Pipeline
+		wantedErr       error
+	}{
+		"errors if fail to get the stacks": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags(stackResourceType, mockTags).Return(nil, mockErr)
+			},
+			wantedErr: mockErr,
+		},
+		"errors if the deployment time of a stack is malformed": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags(stackResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test/1234",
+						Tags: map[string]string{
+							GitHubWorkflowTagKey:   "deploy",
+							GitHubDeployedAtTagKey: "yesterday",
+						},
+					},
+				}, nil)
+			},
+			wantedErr: fmt.Errorf(`parse tag copilot-github-deployed-at of stack arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test/1234: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`),
+		},
+		"returns a pipeline for each workflow with a deploy stage for each environment": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags(stackResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-prod-api/1234",
+						Tags: map[string]string{
+							"copilot-environment":  "prod",
+							GitHubWorkflowTagKey:   "deploy",
+							GitHubRepositoryTagKey: "octo/my-app",
+							GitHubDeployedAtTagKey: "2021-06-02T00:00:00Z",
+						},
+					},
+					{
+						ARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test-web/1234",
+						Tags: map[string]string{
+							"copilot-environment":  "test",
+							GitHubWorkflowTagKey:   "deploy",
+							GitHubDeployedAtTagKey: "2021-06-01T00:00:00Z",
+						},
+					},
+					{
+						ARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test-api/1234",
+						Tags: map[string]string{
+							"copilot-environment": "test",
+							GitHubWorkflowTagKey:  "deploy",
+						},
+					},
+					{
+						ARN: "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test/1234",
+						Tags: map[string]string{
+							"copilot-environment": "test",
+						},
+					},
+				}, nil)
+			},
+			wantedPipelines: []*Pipeline{
+				{
+					Name:      "deploy",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+					Stages: []*Stage{
+						{Name: "Source", Category: "Source", Provider: "GitHub", Details: "Repository: octo/my-app"},
+						{Name: "DeployTo-test", Category: "Deploy", Provider: "GitHubActions", Details: "StackName: my-app-test-api, my-app-test-web", StackNames: []string{"my-app-test-api", "my-app-test-web"},
+							Deploys: []*StageDeploy{{StackName: "my-app-test-api", RunOrder: 1}, {StackName: "my-app-test-web", RunOrder: 1}}},
+						{Name: "DeployTo-prod", Category: "Deploy", Provider: "GitHubActions", Details: "StackName: my-app-prod-api", StackNames: []string{"my-app-prod-api"},
+							Deploys: []*StageDeploy{{StackName: "my-app-prod-api", RunOrder: 1}}},
+					},
+					CreatedAt: time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockresourceGetter(ctrl)
+			tc.setupMocks(m)
+			client := GitHubActions{
+				rgClient: m,
+			}
+
+			// WHEN
+			pipelines, err := client.GetPipelinesByTags(mockTags)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipelines, pipelines)
+		})
+	}
+}
+
+func TestGitHubActions_GetPipeline(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockresourceGetter)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"errors if no stack was deployed by the workflow": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags(stackResourceType, map[string]string{GitHubWorkflowTagKey: "deploy"}).Return(nil, nil)
+			},
+			wantedErr: errors.New("GitHub Actions workflow deploy not found"),
+		},
+		"returns the workflow": {
+			setupMocks: func(m *mocks.MockresourceGetter) {
+				m.EXPECT().GetResourcesByTags(stackResourceType, map[string]string{GitHubWorkflowTagKey: "deploy"}).Return([]*rg.Resource{
+					{
+						ARN:  "arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test-api/1234",
+						Tags: map[string]string{GitHubWorkflowTagKey: "deploy"},
+					},
+				}, nil)
+			},
+			wantedName: "deploy",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockresourceGetter(ctrl)
+			tc.setupMocks(m)
+			client := GitHubActions{
+				rgClient: m,
+			}
+
+			// WHEN
+			pipeline, err := client.GetPipeline("deploy")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, pipeline.Name)
+		})
+	}
+}
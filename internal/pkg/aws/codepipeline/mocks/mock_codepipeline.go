@@ -94,6 +94,21 @@ func (mr *MockapiMockRecorder) RetryStageExecution(input interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*Mockapi)(nil).RetryStageExecution), input)
 }
 
+// ListTagsForResource mocks base method
+func (m *Mockapi) ListTagsForResource(input *codepipeline.ListTagsForResourceInput) (*codepipeline.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResource", input)
+	ret0, _ := ret[0].(*codepipeline.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResource indicates an expected call of ListTagsForResource
+func (mr *MockapiMockRecorder) ListTagsForResource(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*Mockapi)(nil).ListTagsForResource), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
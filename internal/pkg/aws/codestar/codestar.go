@@ -23,6 +23,13 @@ type CodeStar struct {
 	client api
 }
 
+// Connection represents a CodeStar connection to a source repository provider.
+type Connection struct {
+	ARN          string
+	ProviderType string // For example, "GitHub" or "Bitbucket".
+	Status       string // One of "PENDING", "AVAILABLE" or "ERROR".
+}
+
 // New creates a new CloudFormation client.
 func New(s *session.Session) *CodeStar {
 	return &CodeStar{
@@ -30,6 +37,19 @@ func New(s *session.Session) *CodeStar {
 	}
 }
 
+// GetConnection returns the provider type and status of the connection.
+func (c *CodeStar) GetConnection(connectionARN string) (*Connection, error) {
+	output, err := c.client.GetConnection(&codestarconnections.GetConnectionInput{ConnectionArn: aws.String(connectionARN)})
+	if err != nil {
+		return nil, fmt.Errorf("get connection details for %s: %w", connectionARN, err)
+	}
+	return &Connection{
+		ARN:          connectionARN,
+		ProviderType: aws.StringValue(output.Connection.ProviderType),
+		Status:       aws.StringValue(output.Connection.ConnectionStatus),
+	}, nil
+}
+
 // WaitUntilConnectionStatusAvailable blocks until the connection status has been updated from `PENDING` to `AVAILABLE` or until the max attempt window expires.
 func (c *CodeStar) WaitUntilConnectionStatusAvailable(ctx context.Context, connectionARN string) error {
 	var interval time.Duration // Defaults to 0.
@@ -77,3 +77,51 @@ func TestCodestar_WaitUntilStatusAvailable(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCodestar_GetConnection(t *testing.T) {
+	t.Run("returns a wrapped error on GetConnection call failure", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().GetConnection(gomock.Any()).Return(nil, errors.New("some error"))
+		connection := &CodeStar{
+			client: m,
+		}
+
+		// WHEN
+		_, err := connection.GetConnection("mockConnectionARN")
+
+		// THEN
+		require.EqualError(t, err, "get connection details for mockConnectionARN: some error")
+	})
+
+	t.Run("returns the provider type and status of the connection", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().GetConnection(&codestarconnections.GetConnectionInput{
+			ConnectionArn: aws.String("mockConnectionARN"),
+		}).Return(&codestarconnections.GetConnectionOutput{
+			Connection: &codestarconnections.Connection{
+				ProviderType:     aws.String(codestarconnections.ProviderTypeGitHub),
+				ConnectionStatus: aws.String(codestarconnections.ConnectionStatusPending),
+			},
+		}, nil)
+		connection := &CodeStar{
+			client: m,
+		}
+
+		// WHEN
+		conn, err := connection.GetConnection("mockConnectionARN")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, &Connection{
+			ARN:          "mockConnectionARN",
+			ProviderType: "GitHub",
+			Status:       "PENDING",
+		}, conn)
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package costexplorer provides a client to make API requests to AWS Cost Explorer.
+package costexplorer
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+)
+
+type api interface {
+	GetAnomalyMonitors(input *costexplorer.GetAnomalyMonitorsInput) (*costexplorer.GetAnomalyMonitorsOutput, error)
+}
+
+// CostExplorer wraps an AWS Cost Explorer client.
+type CostExplorer struct {
+	client api
+}
+
+// AnomalyMonitor is a cost anomaly monitor of AWS Cost Anomaly Detection.
+type AnomalyMonitor struct {
+	Name          string
+	ARN           string
+	LastEvaluated string // Date of the last evaluation of the monitor, like "2021-06-01", empty if it was never evaluated.
+}
+
+// New returns a CostExplorer client configured against the input session.
+func New(s *session.Session) *CostExplorer {
+	return &CostExplorer{
+		client: costexplorer.New(s),
+	}
+}
+
+// AnomalyMonitorsWithTag returns the custom anomaly monitors whose specification selects the costs of the resources
+// tagged with the key and value, alone or combined with other conditions.
+func (c *CostExplorer) AnomalyMonitorsWithTag(key, value string) ([]*AnomalyMonitor, error) {
+	in := &costexplorer.GetAnomalyMonitorsInput{}
+	var tagged []*AnomalyMonitor
+	for {
+		out, err := c.client.GetAnomalyMonitors(in)
+		if err != nil {
+			return nil, fmt.Errorf("get anomaly monitors: %w", err)
+		}
+		for _, monitor := range out.AnomalyMonitors {
+			if !selectsTag(monitor.MonitorSpecification, key, value) {
+				continue
+			}
+			tagged = append(tagged, &AnomalyMonitor{
+				Name:          aws.StringValue(monitor.MonitorName),
+				ARN:           aws.StringValue(monitor.MonitorArn),
+				LastEvaluated: aws.StringValue(monitor.LastEvaluatedDate),
+			})
+		}
+		if out.NextPageToken == nil {
+			return tagged, nil
+		}
+		in.NextPageToken = out.NextPageToken
+	}
+}
+
+// selectsTag returns true if the expression, or any of the expressions it combines, selects the value of the tag.
+func selectsTag(expr *costexplorer.Expression, key, value string) bool {
+	if expr == nil {
+		return false
+	}
+	if expr.Tags != nil && aws.StringValue(expr.Tags.Key) == key {
+		for _, v := range expr.Tags.Values {
+			if aws.StringValue(v) == value {
+				return true
+			}
+		}
+	}
+	for _, sub := range append(append([]*costexplorer.Expression{}, expr.And...), expr.Or...) {
+		if selectsTag(sub, key, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package costexplorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostExplorer_AnomalyMonitorsWithTag(t *testing.T) {
+	tag := func(key string, values ...string) *costexplorer.Expression {
+		return &costexplorer.Expression{
+			Tags: &costexplorer.TagValues{Key: aws.String(key), Values: aws.StringSlice(values)},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []*AnomalyMonitor
+		wantedError error
+	}{
+		"returns the monitors that select the tag alone or combined across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetAnomalyMonitors(&costexplorer.GetAnomalyMonitorsInput{}).Return(&costexplorer.GetAnomalyMonitorsOutput{
+					AnomalyMonitors: []*costexplorer.AnomalyMonitor{
+						{
+							MonitorName:          aws.String("my-app"),
+							MonitorArn:           aws.String("arn:aws:ce::123456789012:anomalymonitor/abc"),
+							LastEvaluatedDate:    aws.String("2021-06-01"),
+							MonitorSpecification: tag("copilot-application", "my-app"),
+						},
+						{
+							MonitorName: aws.String("services"),
+							MonitorArn:  aws.String("arn:aws:ce::123456789012:anomalymonitor/def"),
+						},
+						{
+							MonitorName:          aws.String("other-app"),
+							MonitorArn:           aws.String("arn:aws:ce::123456789012:anomalymonitor/ghi"),
+							MonitorSpecification: tag("copilot-application", "other-app"),
+						},
+					},
+					NextPageToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetAnomalyMonitors(&costexplorer.GetAnomalyMonitorsInput{
+					NextPageToken: aws.String("next"),
+				}).Return(&costexplorer.GetAnomalyMonitorsOutput{
+					AnomalyMonitors: []*costexplorer.AnomalyMonitor{
+						{
+							MonitorName: aws.String("my-app-prod"),
+							MonitorArn:  aws.String("arn:aws:ce::123456789012:anomalymonitor/jkl"),
+							MonitorSpecification: &costexplorer.Expression{
+								And: []*costexplorer.Expression{
+									tag("copilot-environment", "prod"),
+									{Or: []*costexplorer.Expression{tag("copilot-application", "other-app", "my-app")}},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []*AnomalyMonitor{
+				{Name: "my-app", ARN: "arn:aws:ce::123456789012:anomalymonitor/abc", LastEvaluated: "2021-06-01"},
+				{Name: "my-app-prod", ARN: "arn:aws:ce::123456789012:anomalymonitor/jkl"},
+			},
+		},
+		"wraps the error of the API": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetAnomalyMonitors(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get anomaly monitors: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockAPI)
+
+			c := CostExplorer{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := c.AnomalyMonitorsWithTag("copilot-application", "my-app")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
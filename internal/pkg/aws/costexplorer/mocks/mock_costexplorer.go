@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/costexplorer/costexplorer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	costexplorer "github.com/aws/aws-sdk-go/service/costexplorer"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// GetAnomalyMonitors mocks base method
+func (m *Mockapi) GetAnomalyMonitors(input *costexplorer.GetAnomalyMonitorsInput) (*costexplorer.GetAnomalyMonitorsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnomalyMonitors", input)
+	ret0, _ := ret[0].(*costexplorer.GetAnomalyMonitorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnomalyMonitors indicates an expected call of GetAnomalyMonitors
+func (mr *MockapiMockRecorder) GetAnomalyMonitors(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnomalyMonitors", reflect.TypeOf((*Mockapi)(nil).GetAnomalyMonitors), input)
+}
@@ -37,6 +37,38 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// Types of the deployment controllers of the services.
+const (
+	DeploymentControllerECS        = ecs.DeploymentControllerTypeEcs        // Rolling updates.
+	DeploymentControllerCodeDeploy = ecs.DeploymentControllerTypeCodeDeploy // Blue/green deployments.
+	DeploymentControllerExternal   = ecs.DeploymentControllerTypeExternal
+)
+
+// TaskSetsInProgress is the deployment state of a service whose traffic is being shifted between several task sets.
+const TaskSetsInProgress = "IN_PROGRESS"
+
+// DeploymentControllerType returns the type of the deployment controller of the service.
+// The services without a deployment controller are deployed with rolling updates.
+func (s *Service) DeploymentControllerType() string {
+	if s.DeploymentController == nil || s.DeploymentController.Type == nil {
+		return DeploymentControllerECS
+	}
+	return aws.StringValue(s.DeploymentController.Type)
+}
+
+// TaskSetsState returns the state of the deployment of a service that is deployed with task sets, like with
+// CodeDeploy blue/green deployments. It's TaskSetsInProgress while there are several task sets, and the stability
+// status of the task set otherwise, like "STEADY_STATE". It's empty if the service has no task sets.
+func (s *Service) TaskSetsState() string {
+	switch len(s.TaskSets) {
+	case 0:
+		return ""
+	case 1:
+		return aws.StringValue(s.TaskSets[0].StabilityStatus)
+	}
+	return TaskSetsInProgress
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
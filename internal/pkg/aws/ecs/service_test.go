@@ -0,0 +1,70 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_DeploymentControllerType(t *testing.T) {
+	testCases := map[string]struct {
+		inService *Service
+
+		wanted string
+	}{
+		"rolling updates without a deployment controller": {
+			inService: &Service{},
+			wanted:    DeploymentControllerECS,
+		},
+		"the type of the deployment controller": {
+			inService: &Service{
+				DeploymentController: &ecs.DeploymentController{Type: aws.String("CODE_DEPLOY")},
+			},
+			wanted: DeploymentControllerCodeDeploy,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inService.DeploymentControllerType())
+		})
+	}
+}
+
+func TestService_TaskSetsState(t *testing.T) {
+	testCases := map[string]struct {
+		inService *Service
+
+		wanted string
+	}{
+		"empty without task sets": {
+			inService: &Service{},
+		},
+		"the stability status of the only task set": {
+			inService: &Service{
+				TaskSets: []*ecs.TaskSet{{StabilityStatus: aws.String("STEADY_STATE")}},
+			},
+			wanted: "STEADY_STATE",
+		},
+		"in progress with several task sets": {
+			inService: &Service{
+				TaskSets: []*ecs.TaskSet{
+					{Status: aws.String("PRIMARY"), StabilityStatus: aws.String("STEADY_STATE")},
+					{Status: aws.String("ACTIVE"), StabilityStatus: aws.String("STABILIZING")},
+				},
+			},
+			wanted: TaskSetsInProgress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inService.TaskSetsState())
+		})
+	}
+}
@@ -171,6 +171,50 @@ func (t *TaskDefinition) Secrets() []*ContainerSecret {
 	return secrets
 }
 
+// ContainerImage holds the image of a container.
+type ContainerImage struct {
+	Container string
+	Image     string
+}
+
+// Images returns the images of the containers in the task definition.
+func (t *TaskDefinition) Images() []*ContainerImage {
+	var images []*ContainerImage
+	for _, container := range t.ContainerDefinitions {
+		images = append(images, &ContainerImage{
+			Container: aws.StringValue(container.Name),
+			Image:     aws.StringValue(container.Image),
+		})
+	}
+	return images
+}
+
+// EFSVolume holds the EFS file system of a volume.
+type EFSVolume struct {
+	Name          string
+	FileSystemID  string
+	AccessPointID string
+}
+
+// EFSVolumes returns the volumes of the task definition that are backed by an EFS file system.
+func (t *TaskDefinition) EFSVolumes() []*EFSVolume {
+	var volumes []*EFSVolume
+	for _, volume := range t.Volumes {
+		if volume.EfsVolumeConfiguration == nil {
+			continue
+		}
+		efsVolume := &EFSVolume{
+			Name:         aws.StringValue(volume.Name),
+			FileSystemID: aws.StringValue(volume.EfsVolumeConfiguration.FileSystemId),
+		}
+		if config := volume.EfsVolumeConfiguration.AuthorizationConfig; config != nil {
+			efsVolume.AccessPointID = aws.StringValue(config.AccessPointId)
+		}
+		volumes = append(volumes, efsVolume)
+	}
+	return volumes
+}
+
 // TaskID parses the task ARN and returns the task ID.
 // For example: arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d,
 // arn:aws:ecs:us-west-2:123456789:task/4082490ee6c245e09d2145010aa1ba8d
@@ -350,3 +350,107 @@ func TestTaskDefinition_Secrets(t *testing.T) {
 
 	}
 }
+
+func TestTaskDefinition_Images(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers []*ecs.ContainerDefinition
+
+		wantedImages []*ContainerImage
+	}{
+		"should return the image of each container": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("api"),
+					Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:abc123"),
+				},
+				{
+					Name:  aws.String("nginx"),
+					Image: aws.String("nginx:latest"),
+				},
+			},
+
+			wantedImages: []*ContainerImage{
+				{
+					Container: "api",
+					Image:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:abc123",
+				},
+				{
+					Container: "nginx",
+					Image:     "nginx:latest",
+				},
+			},
+		},
+		"should return nil for a task definition without containers": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: tc.inContainers,
+			}
+
+			gotImages := taskDefinition.Images()
+
+			require.Equal(t, tc.wantedImages, gotImages)
+		})
+	}
+}
+
+func TestTaskDefinition_EFSVolumes(t *testing.T) {
+	testCases := map[string]struct {
+		inVolumes []*ecs.Volume
+
+		wantedVolumes []*EFSVolume
+	}{
+		"should return the volumes backed by EFS": {
+			inVolumes: []*ecs.Volume{
+				{
+					Name: aws.String("scratch"),
+					Host: &ecs.HostVolumeProperties{},
+				},
+				{
+					Name: aws.String("data"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId: aws.String("fs-1234"),
+						AuthorizationConfig: &ecs.EFSAuthorizationConfig{
+							AccessPointId: aws.String("fsap-5678"),
+						},
+					},
+				},
+				{
+					Name: aws.String("shared"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+						FileSystemId: aws.String("fs-9012"),
+					},
+				},
+			},
+
+			wantedVolumes: []*EFSVolume{
+				{
+					Name:          "data",
+					FileSystemID:  "fs-1234",
+					AccessPointID: "fsap-5678",
+				},
+				{
+					Name:         "shared",
+					FileSystemID: "fs-9012",
+				},
+			},
+		},
+		"should return nil for a task definition without volumes": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				Volumes: tc.inVolumes,
+			}
+
+			gotVolumes := taskDefinition.EFSVolumes()
+
+			require.Equal(t, tc.wantedVolumes, gotVolumes)
+		})
+	}
+}
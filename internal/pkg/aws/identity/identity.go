@@ -5,14 +5,19 @@
 package identity
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 type api interface {
 	GetCallerIdentity(input *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error)
+	GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) (*request.Request, *sts.GetCallerIdentityOutput)
 }
 
 // STS wraps the internal sts client.
@@ -29,6 +34,7 @@ func New(s *session.Session) STS {
 
 // Caller holds information about a calling entity.
 type Caller struct {
+	ARN         string
 	RootUserARN string
 	Account     string
 	UserID      string
@@ -43,8 +49,27 @@ func (s STS) Get() (Caller, error) {
 	}
 
 	return Caller{
+		ARN:         *out.Arn,
 		RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", *out.Account),
 		Account:     *out.Account,
 		UserID:      *out.UserId,
 	}, nil
 }
+
+// ServerTime returns the time of AWS Security Token Service when it answered the request for the caller identity,
+// from the Date header of its response, to compare with the local clock. The time has a precision of a second.
+func (s STS) ServerTime() (time.Time, error) {
+	req, _ := s.client.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	if err := req.Send(); err != nil {
+		return time.Time{}, fmt.Errorf("get caller identity: %w", err)
+	}
+	if req.HTTPResponse == nil {
+		return time.Time{}, errors.New("get caller identity: no response")
+	}
+	date := req.HTTPResponse.Header.Get("Date")
+	t, err := http.ParseTime(date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse date %q of the response: %w", date, err)
+	}
+	return t, nil
+}
@@ -6,8 +6,13 @@ package identity
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity/mocks"
 	"github.com/golang/mock/gomock"
@@ -41,6 +46,7 @@ func TestIdentity_Get(t *testing.T) {
 				}, nil)
 			},
 			wantIdentity: Caller{
+				ARN:         mockARN,
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
@@ -68,3 +74,61 @@ func TestIdentity_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentity_ServerTime(t *testing.T) {
+	mockRequest := func(send func(r *request.Request)) *request.Request {
+		handlers := request.Handlers{}
+		handlers.Send.PushBack(send)
+		return request.New(aws.Config{}, metadata.ClientInfo{}, handlers, nil, &request.Operation{Name: "GetCallerIdentity"}, nil, nil)
+	}
+	mockError := errors.New("error")
+
+	testCases := map[string]struct {
+		send func(r *request.Request)
+
+		wantTime time.Time
+		wantErr  error
+	}{
+		"should return wrapped error given error from STS GetCallerIdentity": {
+			send: func(r *request.Request) {
+				r.Error = mockError
+			},
+			wantErr: fmt.Errorf("get caller identity: %w", mockError),
+		},
+		"should return error given an invalid date": {
+			send: func(r *request.Request) {
+				r.HTTPResponse = &http.Response{Header: http.Header{"Date": []string{"yesterday"}}}
+			},
+			wantErr: errors.New(`parse date "yesterday" of the response: parsing time "yesterday" as "Mon Jan _2 15:04:05 2006": cannot parse "yesterday" as "Mon"`),
+		},
+		"should return the date of the response": {
+			send: func(r *request.Request) {
+				r.HTTPResponse = &http.Response{Header: http.Header{"Date": []string{"Tue, 01 Jun 2021 00:00:05 GMT"}}}
+			},
+			wantTime: time.Date(2021, time.June, 1, 0, 0, 5, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mockClient.EXPECT().GetCallerIdentityRequest(gomock.Any()).Return(mockRequest(tc.send), nil)
+
+			sts := STS{
+				client: mockClient,
+			}
+
+			gotTime, gotErr := sts.ServerTime()
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.True(t, tc.wantTime.Equal(gotTime), "expected %s, got %s", tc.wantTime, gotTime)
+		})
+	}
+}
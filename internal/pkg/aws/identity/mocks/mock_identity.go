@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	request "github.com/aws/aws-sdk-go/aws/request"
 	sts "github.com/aws/aws-sdk-go/service/sts"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -47,3 +48,18 @@ func (mr *MockapiMockRecorder) GetCallerIdentity(input interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerIdentity", reflect.TypeOf((*Mockapi)(nil).GetCallerIdentity), input)
 }
+
+// GetCallerIdentityRequest mocks base method
+func (m *Mockapi) GetCallerIdentityRequest(input *sts.GetCallerIdentityInput) (*request.Request, *sts.GetCallerIdentityOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCallerIdentityRequest", input)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*sts.GetCallerIdentityOutput)
+	return ret0, ret1
+}
+
+// GetCallerIdentityRequest indicates an expected call of GetCallerIdentityRequest
+func (mr *MockapiMockRecorder) GetCallerIdentityRequest(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerIdentityRequest", reflect.TypeOf((*Mockapi)(nil).GetCallerIdentityRequest), input)
+}
@@ -106,3 +106,33 @@ func (mr *Mocks3ApiMockRecorder) DeleteObjects(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*Mocks3Api)(nil).DeleteObjects), input)
 }
+
+// GetBucketEncryption mocks base method
+func (m *Mocks3Api) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketEncryption", input)
+	ret0, _ := ret[0].(*s3.GetBucketEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketEncryption indicates an expected call of GetBucketEncryption
+func (mr *Mocks3ApiMockRecorder) GetBucketEncryption(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketEncryption", reflect.TypeOf((*Mocks3Api)(nil).GetBucketEncryption), input)
+}
+
+// GetBucketLifecycleConfiguration mocks base method
+func (m *Mocks3Api) GetBucketLifecycleConfiguration(input *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketLifecycleConfiguration", input)
+	ret0, _ := ret[0].(*s3.GetBucketLifecycleConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketLifecycleConfiguration indicates an expected call of GetBucketLifecycleConfiguration
+func (mr *Mocks3ApiMockRecorder) GetBucketLifecycleConfiguration(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketLifecycleConfiguration", reflect.TypeOf((*Mocks3Api)(nil).GetBucketLifecycleConfiguration), input)
+}
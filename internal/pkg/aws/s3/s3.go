@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -19,6 +20,10 @@ import (
 
 const (
 	artifactDirName = "manual"
+
+	// Error codes of the buckets without a default encryption or lifecycle rules.
+	errCodeNoEncryption = "ServerSideEncryptionConfigurationNotFoundError"
+	errCodeNoLifecycle  = "NoSuchLifecycleConfiguration"
 )
 
 type s3ManagerApi interface {
@@ -28,6 +33,8 @@ type s3ManagerApi interface {
 type s3Api interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+	GetBucketLifecycleConfiguration(input *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error)
 }
 
 // S3 wraps an Amazon Simple Storage Service client.
@@ -108,3 +115,55 @@ func (s *S3) EmptyBucket(bucket string) error {
 		listParams.VersionIdMarker = listResp.NextVersionIdMarker
 	}
 }
+
+// BucketEncryption returns the algorithm of the default encryption of the bucket, like "aws:kms" or "AES256",
+// or an empty string if the bucket has no default encryption.
+func (s *S3) BucketEncryption(bucket string) (string, error) {
+	resp, err := s.s3Client.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+	if isErrCode(err, errCodeNoEncryption) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get encryption of bucket %s: %w", bucket, err)
+	}
+	if resp.ServerSideEncryptionConfiguration == nil {
+		return "", nil
+	}
+	for _, rule := range resp.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault != nil {
+			return aws.StringValue(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm), nil
+		}
+	}
+	return "", nil
+}
+
+// BucketExpirationDays returns the fewest days after their creation that an enabled lifecycle rule of the bucket
+// expires objects after, or nil if none of the rules expires them.
+func (s *S3) BucketExpirationDays(bucket string) (*int64, error) {
+	resp, err := s.s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if isErrCode(err, errCodeNoLifecycle) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get lifecycle configuration of bucket %s: %w", bucket, err)
+	}
+	var days *int64
+	for _, rule := range resp.Rules {
+		if aws.StringValue(rule.Status) != s3.ExpirationStatusEnabled || rule.Expiration == nil || rule.Expiration.Days == nil {
+			continue
+		}
+		if days == nil || aws.Int64Value(rule.Expiration.Days) < *days {
+			days = rule.Expiration.Days
+		}
+	}
+	return days, nil
+}
+
+func isErrCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
@@ -267,3 +268,122 @@ func TestS3_EmptyBucket(t *testing.T) {
 
 	}
 }
+
+func TestS3_BucketEncryption(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wanted  string
+		wantErr error
+	}{
+		"returns the algorithm of the default encryption": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketEncryption(&s3.GetBucketEncryptionInput{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.GetBucketEncryptionOutput{
+					ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+						Rules: []*s3.ServerSideEncryptionRule{
+							{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String("aws:kms")}},
+						},
+					},
+				}, nil)
+			},
+			wanted: "aws:kms",
+		},
+		"returns an empty algorithm if the bucket has no default encryption": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketEncryption(gomock.Any()).Return(nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "not found", nil))
+			},
+		},
+		"should wrap the other errors": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketEncryption(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("get encryption of bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.BucketEncryption("mockBucket")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestS3_BucketExpirationDays(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wanted  *int64
+		wantErr error
+	}{
+		"returns the fewest days of the enabled expiration rules": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.GetBucketLifecycleConfigurationOutput{
+					Rules: []*s3.LifecycleRule{
+						{Status: aws.String("Enabled"), Expiration: &s3.LifecycleExpiration{Days: aws.Int64(90)}},
+						{Status: aws.String("Disabled"), Expiration: &s3.LifecycleExpiration{Days: aws.Int64(7)}},
+						{Status: aws.String("Enabled"), Expiration: &s3.LifecycleExpiration{Days: aws.Int64(30)}},
+						{Status: aws.String("Enabled"), Expiration: &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(true)}},
+					},
+				}, nil)
+			},
+			wanted: aws.Int64(30),
+		},
+		"returns nil if the bucket has no lifecycle rules": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New("NoSuchLifecycleConfiguration", "not found", nil))
+			},
+		},
+		"should wrap the other errors": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("get lifecycle configuration of bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.BucketExpirationDays("mockBucket")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
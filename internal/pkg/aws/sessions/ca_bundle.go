@@ -0,0 +1,28 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// ReadCABundle returns the pool of the PEM certificates in the file at path, like the bundle of the certificate
+// authority of a corporate proxy. The file must have at least one certificate.
+func ReadCABundle(path string) (*x509.CertPool, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle %s: %w", path, err)
+	}
+	return parseCABundle(path, content)
+}
+
+func parseCABundle(path string, content []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(content) {
+		return nil, fmt.Errorf("CA bundle %s has no PEM certificate", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const callRecorderHandlerName = "CallRecorderHandler"
+
+// APICall is an AWS API operation sent to a host. Request and response bodies are never recorded.
+type APICall struct {
+	Host      string
+	Service   string
+	Operation string
+}
+
+// CallRecorder records the AWS API calls sent through sessions, and how many times each was sent.
+// It is safe for concurrent use.
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls map[APICall]int
+}
+
+// NewCallRecorder returns a CallRecorder that hasn't recorded any call yet.
+func NewCallRecorder() *CallRecorder {
+	return &CallRecorder{
+		calls: make(map[APICall]int),
+	}
+}
+
+// Calls returns the distinct calls recorded so far, sorted by host, service and operation.
+func (r *CallRecorder) Calls() []APICall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := make([]APICall, 0, len(r.calls))
+	for call := range r.calls {
+		calls = append(calls, call)
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		if calls[i].Host != calls[j].Host {
+			return calls[i].Host < calls[j].Host
+		}
+		if calls[i].Service != calls[j].Service {
+			return calls[i].Service < calls[j].Service
+		}
+		return calls[i].Operation < calls[j].Operation
+	})
+	return calls
+}
+
+// ServiceCounts returns the number of requests sent so far to each service by service ID, like "SSM".
+// Each attempt of a retried call is a request of its own.
+func (r *CallRecorder) ServiceCounts() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := make(map[string]int)
+	for call, count := range r.calls {
+		counts[call.Service] += count
+	}
+	return counts
+}
+
+// handler returns a request handler that records the host, service and operation of every request right before it's sent.
+func (r *CallRecorder) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: callRecorderHandlerName,
+		Fn: func(req *request.Request) {
+			call := APICall{
+				Service: req.ClientInfo.ServiceID,
+			}
+			if req.Operation != nil {
+				call.Operation = req.Operation.Name
+			}
+			if req.HTTPRequest != nil && req.HTTPRequest.URL != nil {
+				call.Host = req.HTTPRequest.URL.Host
+			}
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.calls[call]++
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// CredentialsFileProviderName is the name of the provider of the credentials read from a credentials file.
+const CredentialsFileProviderName = "CredentialsFileProvider"
+
+// credentialsFile is the content of a credentials file, in the same format as the output of a credential_process.
+type credentialsFile struct {
+	AccessKeyID     string     `json:"AccessKeyId"`
+	SecretAccessKey string     `json:"SecretAccessKey"`
+	SessionToken    string     `json:"SessionToken"`
+	Expiration      *time.Time `json:"Expiration"`
+}
+
+// ReadCredentialsFile returns the credentials in the json file at path, like the temporary credentials written by a
+// credential broker. The access key and secret are required, and the credentials must not have expired.
+func ReadCredentialsFile(path string) (credentials.Value, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("read credentials file %s: %w", path, err)
+	}
+	return parseCredentialsFile(path, content, time.Now())
+}
+
+func parseCredentialsFile(path string, content []byte, now time.Time) (credentials.Value, error) {
+	var file credentialsFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return credentials.Value{}, fmt.Errorf("unmarshal credentials file %s: %w", path, err)
+	}
+	if file.AccessKeyID == "" {
+		return credentials.Value{}, fmt.Errorf("credentials file %s is missing the AccessKeyId field", path)
+	}
+	if file.SecretAccessKey == "" {
+		return credentials.Value{}, fmt.Errorf("credentials file %s is missing the SecretAccessKey field", path)
+	}
+	if file.Expiration != nil && !now.Before(*file.Expiration) {
+		return credentials.Value{}, fmt.Errorf("credentials in file %s expired at %s", path, file.Expiration.Format(time.RFC3339))
+	}
+	return credentials.Value{
+		AccessKeyID:     file.AccessKeyID,
+		SecretAccessKey: file.SecretAccessKey,
+		SessionToken:    file.SessionToken,
+		ProviderName:    CredentialsFileProviderName,
+	}, nil
+}
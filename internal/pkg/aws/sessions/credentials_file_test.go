@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCredentialsFile(t *testing.T) {
+	mockNow := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inContent string
+
+		wantedCreds credentials.Value
+		wantedErr   error
+	}{
+		"errors if the file is not json": {
+			inContent: "AccessKeyId=AKIA",
+			wantedErr: errors.New("unmarshal credentials file creds.json: invalid character 'A' looking for beginning of value"),
+		},
+		"errors if the access key is missing": {
+			inContent: `{"SecretAccessKey": "secret"}`,
+			wantedErr: errors.New("credentials file creds.json is missing the AccessKeyId field"),
+		},
+		"errors if the secret is missing": {
+			inContent: `{"AccessKeyId": "AKIA"}`,
+			wantedErr: errors.New("credentials file creds.json is missing the SecretAccessKey field"),
+		},
+		"errors if the credentials expired": {
+			inContent: `{"AccessKeyId": "AKIA", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "2021-06-01T11:00:00Z"}`,
+			wantedErr: errors.New("credentials in file creds.json expired at 2021-06-01T11:00:00Z"),
+		},
+		"returns the temporary credentials": {
+			inContent: `{"Version": 1, "AccessKeyId": "AKIA", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "2021-06-01T13:00:00Z"}`,
+			wantedCreds: credentials.Value{
+				AccessKeyID:     "AKIA",
+				SecretAccessKey: "secret",
+				SessionToken:    "token",
+				ProviderName:    CredentialsFileProviderName,
+			},
+		},
+		"returns the credentials without a session token": {
+			inContent: `{"AccessKeyId": "AKIA", "SecretAccessKey": "secret"}`,
+			wantedCreds: credentials.Value{
+				AccessKeyID:     "AKIA",
+				SecretAccessKey: "secret",
+				ProviderName:    CredentialsFileProviderName,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			creds, err := parseCredentialsFile("creds.json", []byte(tc.inContent), mockNow)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCreds, creds)
+		})
+	}
+}
+
+func TestProvider_UseCredentials(t *testing.T) {
+	dir, removeDir := tempDir(t)
+	defer removeDir()
+	configFile := filepath.Join(dir, "config")
+	require.NoError(t, ioutil.WriteFile(configFile, []byte("[default]\nregion = us-west-2\n"), 0644))
+	defer setEnv(t, "AWS_CONFIG_FILE", configFile)()
+	defer setEnv(t, "AWS_SDK_LOAD_CONFIG", "1")()
+	defer setEnv(t, "AWS_ACCESS_KEY_ID", "env-key")()
+	defer setEnv(t, "AWS_SECRET_ACCESS_KEY", "env-secret")()
+	p := &Provider{}
+	p.UseCredentials(credentials.Value{AccessKeyID: "AKIA", SecretAccessKey: "secret", SessionToken: "token"})
+
+	sess, err := p.DefaultWithRegion("us-east-1")
+	require.NoError(t, err)
+	creds, err := sess.Config.Credentials.Get()
+
+	require.NoError(t, err)
+	require.Equal(t, "AKIA", creds.AccessKeyID)
+	require.Equal(t, "token", creds.SessionToken)
+}
@@ -6,17 +6,23 @@ package sessions
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"os"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 )
@@ -27,12 +33,42 @@ const (
 	maxRetriesOnRecoverableFailures = 8 // Default provided by SDK is 3 which means requests are retried up to only 2 seconds.
 	credsTimeout                    = 10 * time.Second
 	clientTimeout                   = 30 * time.Second
+
+	// DefaultMaxRetries is the number of times the AWS API calls are retried unless configured otherwise with Retry.
+	DefaultMaxRetries = maxRetriesOnRecoverableFailures
+	// DefaultRetryBaseDelay is the delay before the first retry unless configured otherwise with Retry.
+	DefaultRetryBaseDelay = client.DefaultRetryerMinRetryDelay
+
+	// Environment variables overriding the location of the shared configuration and credentials files.
+	configFileEnvVar      = "AWS_CONFIG_FILE"
+	credentialsFileEnvVar = "AWS_SHARED_CREDENTIALS_FILE"
 )
 
 // Provider provides methods to create sessions.
 // Once a session is created, it's cached locally so that the same session is not re-created.
+// It is safe to get sessions from multiple goroutines.
 type Provider struct {
-	defaultSess *session.Session
+	mu       sync.Mutex
+	sessions map[sessionKey]*session.Session
+
+	sharedConfigFiles []string                 // Files to load the shared configuration from. Nil uses the SDK's defaults.
+	recorder          *CallRecorder            // Records the AWS API calls of the sessions if it's set.
+	retryer           request.Retryer          // Retries the AWS API calls of the sessions if it's set instead of the SDK's default retryer.
+	ctx               context.Context          // Aborts the AWS API calls of the sessions once it's done if it's set.
+	creds             *credentials.Credentials // Credentials of the default sessions instead of the default chain if it's set.
+	profile           string                   // Named profile of the default sessions instead of the default profile if it's set.
+	region            string                   // Region of the default session instead of the region of its profile if it's set.
+	external          *session.Session         // Session that the default sessions are copied from instead of the shared configuration if it's set.
+	caBundle          *x509.CertPool           // Certificate authorities trusted by the sessions instead of the ones of the system if it's set.
+
+	throttled int64 // Number of AWS API calls of the sessions that were throttled, accessed atomically.
+}
+
+// sessionKey identifies a cached session. Empty fields fall back to the shared configuration.
+type sessionKey struct {
+	profile string
+	region  string
+	roleARN string
 }
 
 var instance *Provider
@@ -46,49 +82,100 @@ func NewProvider() *Provider {
 	return instance
 }
 
-// Default returns a session configured against the "default" AWS profile.
-func (p *Provider) Default() (*session.Session, error) {
-	if p.defaultSess != nil {
-		return p.defaultSess, nil
+// NewProviderWithConfigFile returns a session Provider that loads the shared configuration from configFile
+// instead of the default location. An empty configFile falls back to $AWS_CONFIG_FILE, and the credentials
+// are loaded from $AWS_SHARED_CREDENTIALS_FILE if it's set.
+// Unlike the default Provider, an error is returned if any of these files doesn't exist.
+func NewProviderWithConfigFile(configFile string) (*Provider, error) {
+	files, err := sharedConfigFiles(configFile, os.LookupEnv)
+	if err != nil {
+		return nil, err
 	}
+	return &Provider{
+		sharedConfigFiles: files,
+	}, nil
+}
 
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:            *newConfig(),
-		SharedConfigState: session.SharedConfigEnable,
-	})
+// sharedConfigFiles returns the configuration and credentials files to load, in the same order as the SDK
+// so that the credentials file takes precedence.
+func sharedConfigFiles(configFile string, lookupEnv func(string) (string, bool)) ([]string, error) {
+	if configFile != "" {
+		if err := checkFileExists(configFile); err != nil {
+			return nil, fmt.Errorf("shared config file %s does not exist", configFile)
+		}
+	} else if path, ok := lookupEnv(configFileEnvVar); ok && path != "" {
+		if err := checkFileExists(path); err != nil {
+			return nil, fmt.Errorf("shared config file %s set by %s does not exist", path, configFileEnvVar)
+		}
+		configFile = path
+	} else {
+		configFile = defaults.SharedConfigFilename()
+	}
+	credentialsFile := defaults.SharedCredentialsFilename()
+	if path, ok := lookupEnv(credentialsFileEnvVar); ok && path != "" {
+		if err := checkFileExists(path); err != nil {
+			return nil, fmt.Errorf("shared credentials file %s set by %s does not exist", path, credentialsFileEnvVar)
+		}
+		credentialsFile = path
+	}
+	return []string{configFile, credentialsFile}, nil
+}
+
+func checkFileExists(path string) error {
+	info, err := os.Stat(path)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	p.defaultSess = sess
-	return sess, nil
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", path)
+	}
+	return nil
+}
+
+// Default returns a session configured against the "default" AWS profile.
+func (p *Provider) Default() (*session.Session, error) {
+	return p.cached(sessionKey{}, func() (*session.Session, error) {
+		if p.external != nil {
+			return p.external.Copy(), nil
+		}
+		conf := p.defaultConfig()
+		if p.region != "" {
+			conf = conf.WithRegion(p.region)
+		}
+		return session.NewSessionWithOptions(session.Options{
+			Config:            *conf,
+			SharedConfigState: session.SharedConfigEnable,
+			SharedConfigFiles: p.sharedConfigFiles,
+			Profile:           p.profile,
+		})
+	})
 }
 
 // DefaultWithRegion returns a session configured against the "default" AWS profile and the input region.
 func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:            *newConfig().WithRegion(region),
-		SharedConfigState: session.SharedConfigEnable,
+	return p.cached(sessionKey{region: region}, func() (*session.Session, error) {
+		if p.external != nil {
+			return p.external.Copy(aws.NewConfig().WithRegion(region)), nil
+		}
+		return session.NewSessionWithOptions(session.Options{
+			Config:            *p.defaultConfig().WithRegion(region),
+			SharedConfigState: session.SharedConfigEnable,
+			SharedConfigFiles: p.sharedConfigFiles,
+			Profile:           p.profile,
+		})
 	})
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
 }
 
 // FromProfile returns a session configured against the input profile name.
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:            *newConfig(),
-		SharedConfigState: session.SharedConfigEnable,
-		Profile:           name,
+	return p.cached(sessionKey{profile: name}, func() (*session.Session, error) {
+		return session.NewSessionWithOptions(session.Options{
+			Config:            *newConfig(),
+			SharedConfigState: session.SharedConfigEnable,
+			SharedConfigFiles: p.sharedConfigFiles,
+			Profile:           name,
+		})
 	})
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
 }
 
 // FromRole returns a session configured against the input role and region.
@@ -98,17 +185,125 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 		return nil, fmt.Errorf("error creating default session: %w", err)
 	}
 
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
-	sess, err := session.NewSession(
-		newConfig().
-			WithCredentials(creds).
-			WithRegion(region),
-	)
+	return p.cached(sessionKey{region: region, roleARN: roleARN}, func() (*session.Session, error) {
+		creds := stscreds.NewCredentials(defaultSession, roleARN)
+		return session.NewSession(
+			newConfig().
+				WithCredentials(creds).
+				WithRegion(region),
+		)
+	})
+}
+
+// UseCredentials makes the default sessions created by the Provider from now on, and the roles they assume,
+// use the static credentials instead of the default credential chain.
+func (p *Provider) UseCredentials(value credentials.Value) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.creds = credentials.NewStaticCredentialsFromCreds(value)
+}
+
+// UseProfile makes the default sessions created by the Provider from now on, and the roles they assume, use the named
+// profile of the shared configuration instead of the default profile, in region unless it's empty.
+// A session set with UseDefault takes precedence over the profile.
+func (p *Provider) UseProfile(name, region string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.profile = name
+	p.region = region
+}
+
+// UseDefault makes the default sessions created by the Provider from now on, and the roles they assume, copies of sess
+// instead of sessions from the shared configuration, like a session that an embedder already configured.
+// The copies are set up like the other sessions of the Provider, so sess itself isn't modified.
+func (p *Provider) UseDefault(sess *session.Session) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.external = sess
+}
+
+// UseCABundle makes the sessions created by the Provider from now on trust the certificate authorities of pool
+// instead of the ones of the system, like the authority of a corporate proxy. It takes precedence over the bundle
+// of $AWS_CA_BUNDLE. The proxy is still configured with the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+func (p *Provider) UseCABundle(pool *x509.CertPool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.caBundle = pool
+}
+
+// RecordCalls records the AWS API calls sent through the sessions created by the Provider from now on,
+// including the calls made to retrieve their credentials.
+func (p *Provider) RecordCalls(recorder *CallRecorder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.recorder = recorder
+}
+
+// Retry retries the AWS API calls sent through the sessions created by the Provider from now on up to maxRetries times,
+// with an exponential backoff starting at baseDelay. Throttled calls wait at least the SDK's default throttle delay.
+func (p *Provider) Retry(maxRetries int, baseDelay time.Duration) {
+	throttleDelay := baseDelay
+	if throttleDelay < client.DefaultRetryerMinThrottleDelay {
+		throttleDelay = client.DefaultRetryerMinThrottleDelay
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.retryer = client.DefaultRetryer{
+		NumMaxRetries:    maxRetries,
+		MinRetryDelay:    baseDelay,
+		MinThrottleDelay: throttleDelay,
+	}
+}
+
+// Throttled returns the number of AWS API calls sent through the sessions of the Provider that were throttled,
+// counting each throttled attempt of a retried call.
+func (p *Provider) Throttled() int {
+	return int(atomic.LoadInt64(&p.throttled))
+}
+
+// CancelWith aborts the AWS API calls sent through the sessions of the Provider, including the calls in flight,
+// once ctx is done. Calls sent with a context of their own are left untouched.
+func (p *Provider) CancelWith(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ctx = ctx
+}
+
+// cached returns a copy of the session stored under key, or creates it with newSess and stores it.
+// The copies of a session share its credentials and HTTP client so that their credentials cache and connection pool
+// are reused, while a caller that changes the config or the handlers of its copy doesn't change the ones of the others.
+func (p *Provider) cached(key sessionKey, newSess func() (*session.Session, error)) (*session.Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sess, ok := p.sessions[key]; ok {
+		return sess.Copy(), nil
+	}
+	sess, err := newSess()
 	if err != nil {
 		return nil, err
 	}
 	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
+	sess.Handlers.Validate.PushFrontNamed(p.contextHandler())
+	sess.Handlers.Retry.PushFrontNamed(p.throttleHandler())
+	if p.recorder != nil {
+		sess.Handlers.Send.PushFrontNamed(p.recorder.handler())
+	}
+	if p.caBundle != nil {
+		// The client is replaced once the session is created, since the SDK overrides its authorities with $AWS_CA_BUNDLE.
+		sess.Config.HTTPClient = &http.Client{
+			Timeout:   clientTimeout,
+			Transport: caBundleTransport(p.caBundle),
+		}
+	}
+	if p.retryer != nil {
+		sess.Config.Retryer = p.retryer
+		sess.Config.MaxRetries = aws.Int(p.retryer.MaxRetries())
+	}
+	if p.sessions == nil {
+		p.sessions = make(map[sessionKey]*session.Session)
+	}
+	p.sessions[key] = sess
+	return sess.Copy(), nil
 }
 
 // FromStaticCreds returns a session from static credentials.
@@ -158,6 +353,53 @@ func newConfig() *aws.Config {
 		WithMaxRetries(maxRetriesOnRecoverableFailures)
 }
 
+// defaultConfig returns the config of the default sessions, with the credentials of the Provider if they're set.
+func (p *Provider) defaultConfig() *aws.Config {
+	conf := newConfig()
+	if p.creds != nil {
+		conf.Credentials = p.creds
+	}
+	return conf
+}
+
+// caBundleTransport returns a transport like the default transport that only trusts the certificate authorities of pool.
+func caBundleTransport(pool *x509.CertPool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs: pool,
+	}
+	return transport
+}
+
+// contextHandler returns a request handler that sends the requests without a context of their own
+// with the context of the Provider, if it's set.
+func (p *Provider) contextHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ContextHandler",
+		Fn: func(r *request.Request) {
+			p.mu.Lock()
+			ctx := p.ctx
+			p.mu.Unlock()
+			if ctx != nil && r.Context() == aws.BackgroundContext() {
+				r.SetContext(ctx)
+			}
+		},
+	}
+}
+
+// throttleHandler returns a request handler that counts the throttled attempts of the requests.
+func (p *Provider) throttleHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ThrottleHandler",
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				atomic.AddInt64(&p.throttled, 1)
+			}
+		},
+	}
+}
+
 // userAgentHandler returns a http request handler that sets a custom user agent to all aws requests.
 func userAgentHandler() request.NamedHandler {
 	return request.NamedHandler{
@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stepfunctions provides a client to make API requests to AWS Step Functions.
+package stepfunctions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+)
+
+type api interface {
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+}
+
+// StepFunctions wraps an AWS Step Functions client.
+type StepFunctions struct {
+	client api
+}
+
+// Execution is a run of a state machine.
+type Execution struct {
+	Status    string
+	StartDate time.Time
+	StopDate  time.Time // Zero if the execution is still running.
+}
+
+// New returns a StepFunctions struct configured against the input session.
+func New(s *session.Session) *StepFunctions {
+	return &StepFunctions{
+		client: sfn.New(s),
+	}
+}
+
+// RecentExecutions returns up to max executions of the state machine that started after since, from the most to the
+// least recent.
+func (s *StepFunctions) RecentExecutions(stateMachineARN string, since time.Time, max int) ([]Execution, error) {
+	var executions []Execution
+	var nextToken *string
+	for {
+		out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(stateMachineARN),
+			MaxResults:      aws.Int64(int64(max)),
+			NextToken:       nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		// The executions are listed from the most recent, so the older ones are never needed once one is too old.
+		for _, execution := range out.Executions {
+			if len(executions) == max || aws.TimeValue(execution.StartDate).Before(since) {
+				return executions, nil
+			}
+			executions = append(executions, Execution{
+				Status:    aws.StringValue(execution.Status),
+				StartDate: aws.TimeValue(execution.StartDate),
+				StopDate:  aws.TimeValue(execution.StopDate),
+			})
+		}
+		if out.NextToken == nil || len(executions) == max {
+			return executions, nil
+		}
+		nextToken = out.NextToken
+	}
+}
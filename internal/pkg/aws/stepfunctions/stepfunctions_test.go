@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stepfunctions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepFunctions_RecentExecutions(t *testing.T) {
+	mockARN := "arn:aws:states:us-west-2:123456789012:stateMachine:my-app-test-report"
+	mockSince := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inMax      int
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedExecutions []Execution
+		wantedErr        error
+	}{
+		"errors if fail to list the executions": {
+			inMax: 3,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list executions of state machine arn:aws:states:us-west-2:123456789012:stateMachine:my-app-test-report: some error"),
+		},
+		"stops at the executions that started before the lookback": {
+			inMax: 3,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String(mockARN),
+					MaxResults:      aws.Int64(3),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{Status: aws.String("RUNNING"), StartDate: aws.Time(time.Date(2021, time.June, 3, 0, 0, 0, 0, time.UTC))},
+						{Status: aws.String("SUCCEEDED"), StartDate: aws.Time(time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC)), StopDate: aws.Time(time.Date(2021, time.June, 2, 0, 5, 0, 0, time.UTC))},
+						{Status: aws.String("FAILED"), StartDate: aws.Time(time.Date(2021, time.May, 31, 0, 0, 0, 0, time.UTC))},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+			},
+			wantedExecutions: []Execution{
+				{Status: "RUNNING", StartDate: time.Date(2021, time.June, 3, 0, 0, 0, 0, time.UTC)},
+				{Status: "SUCCEEDED", StartDate: time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC), StopDate: time.Date(2021, time.June, 2, 0, 5, 0, 0, time.UTC)},
+			},
+		},
+		"returns at most max executions across pages": {
+			inMax: 2,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String(mockARN),
+					MaxResults:      aws.Int64(2),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{Status: aws.String("SUCCEEDED"), StartDate: aws.Time(time.Date(2021, time.June, 3, 0, 0, 0, 0, time.UTC))},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String(mockARN),
+					MaxResults:      aws.Int64(2),
+					NextToken:       aws.String("next"),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{Status: aws.String("TIMED_OUT"), StartDate: aws.Time(time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC))},
+						{Status: aws.String("SUCCEEDED"), StartDate: aws.Time(time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC))},
+					},
+					NextToken: aws.String("other"),
+				}, nil)
+			},
+			wantedExecutions: []Execution{
+				{Status: "SUCCEEDED", StartDate: time.Date(2021, time.June, 3, 0, 0, 0, 0, time.UTC)},
+				{Status: "TIMED_OUT", StartDate: time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := StepFunctions{
+				client: m,
+			}
+
+			// WHEN
+			executions, err := client.RecentExecutions(mockARN, mockSince, tc.inMax)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecutions, executions)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wafv2 provides a client to make API requests to AWS WAF.
+package wafv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+type api interface {
+	GetWebACLForResource(input *wafv2.GetWebACLForResourceInput) (*wafv2.GetWebACLForResourceOutput, error)
+}
+
+// WAF wraps an AWS WAF client.
+type WAF struct {
+	client api
+}
+
+// New returns a WAF client configured against the input session.
+func New(s *session.Session) *WAF {
+	return &WAF{
+		client: wafv2.New(s),
+	}
+}
+
+// WebACLForResource returns the name of the web ACL associated with a resource given its ARN,
+// like the ARN of a load balancer. It returns an empty string if no web ACL is associated with the resource.
+func (w *WAF) WebACLForResource(resourceARN string) (string, error) {
+	out, err := w.client.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get web ACL for resource %s: %w", resourceARN, err)
+	}
+	if out.WebACL == nil {
+		return "", nil
+	}
+	return aws.StringValue(out.WebACL.Name), nil
+}
@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package wafv2
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/wafv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAF_WebACLForResource(t *testing.T) {
+	const mockARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/1234567890123456"
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedACL string
+		wantedErr error
+	}{
+		"errors if fail to get the web ACL": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get web ACL for resource %s: %w", mockARN, mockErr),
+		},
+		"returns an empty name if no web ACL is associated": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(gomock.Any()).Return(&wafv2.GetWebACLForResourceOutput{}, nil)
+			},
+		},
+		"returns the name of the web ACL": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+					ResourceArn: aws.String(mockARN),
+				}).Return(&wafv2.GetWebACLForResourceOutput{
+					WebACL: &wafv2.WebACL{
+						Name: aws.String("my-acl"),
+					},
+				}, nil)
+			},
+			wantedACL: "my-acl",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := WAF{
+				client: m,
+			}
+
+			// WHEN
+			acl, err := client.WebACLForResource(mockARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedACL, acl)
+		})
+	}
+}
@@ -4,15 +4,61 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	awscodestar "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/wafv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/clipboard"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/pager"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -22,135 +68,2325 @@ import (
 const (
 	appShowNamePrompt     = "Which application would you like to show?"
 	appShowNameHelpPrompt = "An application is a collection of related services."
+
+	fmtAppShowHealthy = "All the environments, services and pipelines of application %s are healthy.\n"
+)
+
+// Warnings about a corrupted application record in the config store.
+const (
+	fmtAppRecordMissingField   = "application record %s is missing the %q field"
+	fmtAppRecordMalformedField = "application record %s has a malformed %q field: %s"
+)
+
+// fmtEnvAccountUnreachable is the warning about an environment whose account can't be accessed at all.
+const fmtEnvAccountUnreachable = "Environment %s is unreachable, its account %s may have been closed or suspended: %v. " +
+	"Check that the account is active and that the role of the environment can be assumed, or remove the environment from the application if its account was closed"
+
+// accountUnreachableErrCodes are the codes of the errors returned when an account can't be accessed at all,
+// as opposed to a missing permission on a single resource.
+var accountUnreachableErrCodes = map[string]bool{
+	"InvalidClientTokenId":        true,
+	"UnrecognizedClientException": true,
+	"OptInRequired":               true,
+	"RegionDisabledException":     true,
+}
+
+var accountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// arnAccountIDRegexp matches the ARNs within a value and captures their account ID. The partition, service and region
+// can be variables of a !Sub string, like ${AWS::Region}.
+var arnAccountIDRegexp = regexp.MustCompile(`arn:(?:[a-z-]|\$\{[^}]*\})+:(?:[a-z0-9-]|\$\{[^}]*\})*:(?:[a-z0-9-]|\$\{[^}]*\})*:(\d{12}):`)
+
+// Values of the audit event written with --audit-log.
+const (
+	appShowAuditLogStderr        = "-"
+	appShowAuditPrincipalUnknown = "unknown"
+)
+
+// Environment variables that provide the default values of the flags.
+const (
+	appShowOutputEnvVar   = "COPILOT_OUTPUT"
+	appShowAppEnvVar      = "COPILOT_APP"
+	appShowNoColorEnvVar  = "COPILOT_NO_COLOR"
+	appShowAuditLogEnvVar = "COPILOT_AUDIT_LOG"
+
+	appShowOutputJSON  = "json"
+	appShowOutputHuman = "human"
+	appShowOutputCSV   = "csv"
+	// appShowOutputOpenMetrics is the OpenMetrics text format, for metrics stores that ingest timestamped samples.
+	appShowOutputOpenMetrics = "openmetrics"
+	// appShowOutputLines is a JSON array of summary lines, to be posted as is to a chat.
+	appShowOutputLines = "lines"
+	// appShowOutputMarkdown is GitHub-flavored Markdown tables, to be pasted in pull requests and wikis.
+	appShowOutputMarkdown = "markdown"
+	// appShowOutputGo is a Go composite literal of the description, to be pasted in table tests.
+	appShowOutputGo = "go"
+	// appShowOutputMermaid is a Mermaid flowchart of the environments and services, to be pasted in architecture docs.
+	appShowOutputMermaid = "mermaid"
+	// appShowOutputDotenv is KEY=value lines of the metadata of the application, to be sourced by shell scripts.
+	appShowOutputDotenv = "dotenv"
+
+	// Sources of the pipelines of the application for --pipeline-source.
+	appShowPipelineSourceCodePipeline  = "codepipeline"
+	appShowPipelineSourceGitHubActions = "github-actions" // Deploy records that GitHub Actions workflows tag the stacks with.
+
+	appShowOutputTargetSep = "=" // Separates a format of --output from the file it's written to.
+	appShowOutputStdout    = "-" // Target of --output that stands for stdout.
+)
+
+// appShowAppCacheTTL is how long the application names to select from are cached for.
+const appShowAppCacheTTL = 5 * time.Minute
+
+// exitCodeAppNotExist is the exit code of "app show --exists" if the application doesn't exist.
+// Other failures exit with 1.
+const exitCodeAppNotExist = 2
+
+// fmtEnvNamespace is the name of the service discovery namespace that the environment stacks create for the application.
+const fmtEnvNamespace = "%s.local"
+
+// maxAppNameSuggestions is the maximum number of application names suggested if --name doesn't match any application.
+const maxAppNameSuggestions = 3
+
+// retryOnEmptyDelay is how long to wait before listing the environments and services again with --retry-on-empty.
+const retryOnEmptyDelay = 2 * time.Second
+
+// defaultWatchInterval is how long to wait between the refreshes of --watch unless --watch-interval is set.
+const defaultWatchInterval = 10 * time.Second
+
+// clearScreen moves the cursor of the terminal to the top left corner and clears the screen, so that each refresh
+// of --watch is rendered in place of the previous one.
+const clearScreen = "\033[H\033[2J"
+
+// exitCodeInterrupted is the exit code of "app show" if it's interrupted, following the shell convention of 128+SIGINT.
+const exitCodeInterrupted = 130
+
+// copilotVariablePrefix is the prefix of the environment variables that Copilot injects in the containers of the services.
+const copilotVariablePrefix = "COPILOT_"
+
+// addonExportPrefix is the prefix of the values exported by the service stacks, as imported by the addons.
+const addonExportPrefix = "${App}-${Env}-"
+
+// appShowDefaultsFileName is the name of the file at the root of the workspace that sets the default values of the flags.
+const appShowDefaultsFileName = ".copilot-show.yaml"
+
+// appShowContextsFileName is the name of the file in the copilot directory of the user's configuration directory
+// that saves the contexts selected with --context.
+const appShowContextsFileName = "contexts.yml"
+
+// wsManifestFileName is the name of the manifest file in the directory of each workload of the workspace.
+const wsManifestFileName = "manifest.yml"
+
+// showAppDefaults are the default values of the flags set in the workspace's .copilot-show.yaml.
+// Keys that are not set leave the built-in defaults of their flags unchanged.
+type showAppDefaults struct {
+	Output      *string `yaml:"output"`
+	Resources   *bool   `yaml:"resources"`
+	ShowSecrets *bool   `yaml:"show-secrets"`
+	Explain     *bool   `yaml:"explain"`
+	Full        *bool   `yaml:"full"`
+	NoColor     *bool   `yaml:"no-color"`
+	NoLegend    *bool   `yaml:"no-legend"`
+	NoHints     *bool   `yaml:"no-hints"`
+	FailOn      *string `yaml:"fail-on"`
+
+	FormatVersion *string `yaml:"format-version"`
+}
+
+// showAppContexts are the contexts saved in the contexts file, by name.
+type showAppContexts struct {
+	Contexts map[string]showAppContext `yaml:"contexts"`
+}
+
+// showAppContext is a saved combination of the profile, the region and the config store to describe applications with.
+// Fields that are not set leave the defaults of the shared configuration and of their flags unchanged.
+type showAppContext struct {
+	Profile       string `yaml:"profile"`
+	Region        string `yaml:"region"`
+	StoreRegion   string `yaml:"store-region"`
+	StoreEndpoint string `yaml:"store-endpoint"`
+}
+
+var appShowEnvFlagDefaults = []envFlagDefault{
+	{
+		envVar: appShowOutputEnvVar,
+		flag:   jsonFlag,
+		value: func(output string) (string, error) {
+			switch strings.ToLower(output) {
+			case appShowOutputJSON:
+				return "true", nil
+			case appShowOutputHuman:
+				return "false", nil
+			}
+			return "", fmt.Errorf("unsupported output %q, must be one of %s or %s", output, appShowOutputJSON, appShowOutputHuman)
+		},
+	},
+	{
+		envVar: appShowAppEnvVar,
+		flag:   nameFlag,
+	},
+	{
+		envVar: appShowNoColorEnvVar,
+		flag:   noColorFlag,
+	},
+	{
+		envVar: config.EndpointEnvVar,
+		flag:   storeEndpointFlag,
+	},
+	{
+		envVar: appShowAuditLogEnvVar,
+		flag:   auditLogFlag,
+	},
+}
+
+// Sources of the values annotated by --explain.
+const (
+	fmtAppParamSource       = "SSM /copilot/applications/%s"
+	fmtEnvParamSource       = "SSM /copilot/applications/%s/environments/%s"
+	fmtSvcParamSource       = "SSM /copilot/applications/%s/components/%s"
+	fmtPipelineSource       = "CodePipeline %s"
+	fmtGitHubWorkflowSource = "GitHub Actions workflow %s"
 )
 
 type showAppVars struct {
-	name             string
-	shouldOutputJSON bool
+	name                  string
+	shouldOutputJSON      bool
+	shouldOutputResources bool
+	shouldShowSecrets     bool
+	shouldShowTags        bool
+	isVerbose             bool
+	shouldShowDeployers   bool
+	shouldShowLogging     bool
+	shouldShowDashboard   bool
+	shouldRedact          bool
+	shouldValidateOnly    bool
+	outputTemplateFile    string
+	profileFromEnv        string
+	isStrict              bool
+	isStrictJSON          bool
+	shouldListOnly        bool
+	shouldCheckExists     bool
+	shouldExplain         bool
+	shouldCopy            bool
+	shouldPage            bool
+	shouldOnlyFailing     bool
+	noColor               bool
+	compareEnvs           []string
+	awsConfigFile         string
+	credentialsFile       string
+	caBundle              string
+	storeRegion           string
+	storeEndpoint         string
+	maxRetries            int
+	retryBaseDelay        time.Duration
+	retryOnEmpty          int
+	shouldRunSerially     bool
+	parallelEnvs          int
+	shouldBenchmark       bool
+	shouldShowFull        bool
+	includeTemplates      bool
+	templatesDir          string
+	failOn                string
+	shouldAuditCalls      bool
+	noLegend              bool
+	noHints               bool
+	noPipelines           bool
+	promotionCheck        []string
+	pipelineSource        string
+	maxWidth              int
+	shouldPrettyPrint     bool
+	shouldIncludeJobRuns  bool
+	ownerTagKey           string
+	sortEnvs              string
+	shouldCheckTopology   bool
+	shouldCheckDrift      bool
+	shouldDiffLastDeploy  bool
+	shouldRefreshCache    bool
+	shouldHintNames       bool
+	shouldAssumeYes       bool
+	shouldAssumeNo        bool
+	stackSetName          string
+	shouldSelectFirst     bool
+	shouldRunDoctor       bool
+	shouldCountOnly       bool
+	allowStackFallback    bool
+	shouldScoreHealth     bool
+	shouldShowCosts       bool
+	shouldPrintSchema     bool
+	shouldWatch           bool
+	shouldWatchDiff       bool
+	watchInterval         time.Duration
+	asOfCommit            string   // Git ref to read the manifests of the workspace from instead of the working tree.
+	healthWeightsFile     string   // YAML file with the weights of the health score, that default to describe.DefaultHealthWeights.
+	contextName           string   // Name of the context of appShowContextsFileName that sets the profile, region and store.
+	fromPipeline          string   // Name of the pipeline whose application is described instead of --name.
+	formatVersion         string   // Version of the layout of the output, one of describe.AppFormatVersions.
+	omitFields            []string // Dotted paths of the fields to leave out of the json output.
+	diffBaseline          string   // Path of the baseline snapshot of the json description to compare the application with.
+	auditLog              string   // File that the audit event is appended to, appShowAuditLogStderr for stderr.
+	tee                   string   // File that the stdout output is also appended to.
+	errorsTo              string   // File that the diagnostics are appended to instead of stderr.
+	outputs               []string // Values of --output, resolved by Validate to outputFormat or to outputTargets.
+	outputFormat          string
+}
+
+// phaseTiming is the wall-clock time spent in a phase of the command.
+type phaseTiming struct {
+	name     string
+	duration time.Duration
+}
+
+// appShowAuditEvent records that an application's metadata was read with app show, for compliance audits.
+type appShowAuditEvent struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Principal    string    `json:"principal"`
+	App          string    `json:"app"`
+	OutputFormat string    `json:"outputFormat"`
+}
+
+// workloadInEnv identifies a workload deployed in an environment. An empty workload stands for the whole environment.
+type workloadInEnv struct {
+	env      string
+	workload string
+}
+
+type showAppOpts struct {
+	showAppVars
+
+	prompt       prompter
+	store        store
+	w            io.Writer // Writer for the output. Its writes are serialized with the ones of diagW.
+	diagW        io.Writer // Writer for diagnostics that must not be mixed with the output.
+	sel          appSelector
+	appChoices   appChoiceLister
+	pipelineSvc  pipelineGetter
+	connections  connectionGetter
+	builds       pipelineBuildGetter
+	pipelineTags pipelineTagsGetter
+	artifacts    pipelineArtifactStoreGetter
+	budgets      budgetLister
+	monitors     anomalyMonitorLister
+	appResources appResourcesGetter
+	appStacks    stackDescriber // Describes the stack of the application, in the region of the default session.
+	tagStacks    stackLister    // Lists the stacks tagged with the application in the region of the default session.
+	identity     identityService
+	clock        serverClock // Time of AWS to measure the skew of the local clock with --doctor.
+	sessProvider sessionProvider
+	ws           copilotDirGetter
+	git          runner // Runs git to read the workspace at --as-of-commit.
+	formatter    runner // Runs the format plugins of --output.
+	addons       wsAddonsReader
+	wsSvcs       wsAppSvcReader
+	stackSets    stackSetInstanceLister
+	calls        callRecorder // Records the AWS API calls made with --audit-calls or --verbose.
+	fs           afero.Fs
+	clipboard    clipboardWriter
+	pager        outputPager
+	isTerminal   func() bool            // Overriden in tests.
+	flagChanged  func(name string) bool // Reports whether a flag was set on the command line or from its environment variable.
+	ctx          context.Context        // Aborts the AWS API calls and the output once it's done. Nil if the command can't be interrupted.
+	screenWidth  func() int             // Overriden in tests.
+
+	isMaxWidthSet bool // True if --max-width was set, in which case it overrides the width of the terminal.
+
+	pluginsDir    string            // Directory of the format plugins, empty if the configuration directory can't be found.
+	formatPlugins map[string]string // Format of --output to the path of its plugin, for the formats that aren't built in.
+
+	redactedIDs map[string]string // Account ID to its token with --redact, so that an account ID has the same token throughout the command.
+
+	defaultSess *session.Session // Session of an embedder that the clients are built from, set with withSession. Nil uses the shared configuration.
+
+	namePrompt     string // Message of the prompt to select an application.
+	nameHelpPrompt string // Help text of the prompt to select an application.
+
+	teeW io.WriteCloser // File of --tee, opened by Validate and closed once the output is written.
+
+	errorsW io.WriteCloser // File of --errors-to, opened by Validate and closed by closeErrorsTo.
+	stderrW io.Writer      // Writer of the diagnostics of the log package before --errors-to replaced it.
+
+	envProfiles map[string]string // Environment name to the named profile used to fetch its details.
+	nameMatches []string          // Applications matching a partial --name, to select from if there are several.
+
+	outputTemplate *template.Template    // Template parsed from --output-template-file to render the description with.
+	outputTargets  []appShowOutputTarget // Formats rendered from the same description when --output has several values.
+	baseline       *describe.App         // Description read from --diff-baseline to compare the live description with.
+	described      *describe.App         // Description written by Execute, to recommend the follow-up actions from.
+	wsCommit       string                // Commit resolved from --as-of-commit that the manifests of the workspace are read at.
+
+	healthWeights *describe.AppHealthWeights // Weights read from --health-weights. Nil uses describe.DefaultHealthWeights.
+	sessRegion    string                     // Region of the default session, that the regions of the environments are compared with.
+
+	mu          sync.Mutex                                        // Guards the fields below that are written while describing environments concurrently.
+	warnings    []*describe.AppWarning                            // Non-fatal advisories found while describing the application.
+	partial     []string                                          // Warnings about the data that couldn't be retrieved.
+	envStacks   map[string][]cloudformation.StackDescription      // Environment name to the stacks of the application in the environment.
+	failing     map[workloadInEnv]bool                            // Services and environments flagged with a warning or a failed status.
+	unreachable map[string]bool                                   // Environments whose account can't be accessed, by name.
+	taskDefs    map[workloadInEnv]*awsecs.TaskDefinition          // Active task definitions of the services in each environment.
+	resources   map[workloadInEnv][]*cloudformation.StackResource // Resources of the service stacks in each environment.
+
+	phases []phaseTiming // Timings of the phases of the command recorded with --benchmark, guarded by mu.
+
+	onSection describe.AppSectionHandler // Called with each section of the description as soon as it's resolved, if set.
+	sectionMu sync.Mutex                 // Serializes the calls to onSection.
+
+	stackListings int              // Number of stack listings of the description, each for one or several environments.
+	limiter       *adaptiveLimiter // Bounds the per-service calls in flight, backing off when they're throttled. Nil uses maxConcurrency.
+
+	newStackLister          func(env *config.Environment) (stackLister, error)               // Overriden in tests.
+	newStackResourcesGetter func(env *config.Environment) (stackResourcesGetter, error)      // Overriden in tests.
+	newTemplateGetter       func(env *config.Environment) (stackTemplateGetter, error)       // Overriden in tests.
+	newTaskDefGetter        func(env *config.Environment) (taskDefinitionGetter, error)      // Overriden in tests.
+	newECSServiceDescriber  func(env *config.Environment) (ecsServiceDescriber, error)       // Overriden in tests.
+	newAppRunnerDescriber   func(env *config.Environment) (appRunnerServiceDescriber, error) // Overriden in tests.
+	newCertDescriber        func(env *config.Environment) (certificateDescriber, error)      // Overriden in tests.
+	newWebACLGetter         func(env *config.Environment) (webACLGetter, error)              // Overriden in tests.
+	newDeploymentGetter     func(env *config.Environment) (stackDeploymentGetter, error)     // Overriden in tests.
+	newLogRetentionGetter   func(env *config.Environment) (logGroupRetentionGetter, error)   // Overriden in tests.
+	newObjectCounter        func(region string) (bucketObjectCounter, error)                 // Overriden in tests.
+	newBucketConfigGetter   func(region string) (bucketConfigGetter, error)                  // Overriden in tests.
+	newAlarmGetter          func(env *config.Environment) (alarmStatusGetter, error)         // Overriden in tests.
+	newExecutionLister      func(env *config.Environment) (jobExecutionLister, error)        // Overriden in tests.
+	now                     func() time.Time                                                 // Overriden in tests.
+	after                   func(d time.Duration) <-chan time.Time                           // Overriden in tests.
+}
+
+// showAppOption allows you to initialize showAppOpts with additional properties.
+type showAppOption func(o *showAppOpts)
+
+// withChangedFlags keeps the flags that were set on the command line or from their environment variables
+// from being overridden by the workspace's .copilot-show.yaml.
+func withChangedFlags(flags *pflag.FlagSet) showAppOption {
+	return func(o *showAppOpts) {
+		o.flagChanged = flags.Changed
+	}
+}
+
+// withContext aborts the AWS API calls of the command once ctx is done, and keeps the command from writing its output.
+func withContext(ctx context.Context) showAppOption {
+	return func(o *showAppOpts) {
+		o.ctx = ctx
+	}
+}
+
+// withSession builds the clients of the command from a copy of sess instead of a session from the shared configuration,
+// for embedders that already hold a configured session. The environment roles are assumed with its credentials.
+func withSession(sess *session.Session) showAppOption {
+	return func(o *showAppOpts) {
+		o.defaultSess = sess
+	}
+}
+
+// withAppNamePrompt overrides the message and help text of the prompt to select an application.
+func withAppNamePrompt(prompt, help string) showAppOption {
+	return func(o *showAppOpts) {
+		o.namePrompt = prompt
+		o.nameHelpPrompt = help
+	}
+}
+
+func newShowAppOpts(vars showAppVars, options ...showAppOption) (*showAppOpts, error) {
+	sessProvider, err := sessions.NewProviderWithConfigFile(vars.awsConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+	if vars.credentialsFile != "" {
+		creds, err := sessions.ReadCredentialsFile(vars.credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("load AWS credentials: %w", err)
+		}
+		sessProvider.UseCredentials(creds)
+	}
+	if vars.caBundle != "" {
+		pool, err := sessions.ReadCABundle(vars.caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("load CA bundle: %w", err)
+		}
+		sessProvider.UseCABundle(pool)
+	}
+	// The session of an embedder must be known before any client is built, so it's read from the options first.
+	var preset showAppOpts
+	for _, option := range options {
+		option(&preset)
+	}
+	if vars.contextName != "" {
+		path, err := contextsFilePath()
+		if err != nil {
+			return nil, err
+		}
+		showCtx, err := readShowAppContext(afero.NewOsFs(), path, vars.contextName)
+		if err != nil {
+			return nil, err
+		}
+		flagChanged := preset.flagChanged
+		if flagChanged == nil {
+			flagChanged = func(string) bool { return false }
+		}
+		showCtx.apply(&vars, flagChanged)
+		sessProvider.UseProfile(showCtx.Profile, showCtx.Region)
+	}
+	if preset.defaultSess != nil {
+		sessProvider.UseDefault(preset.defaultSess)
+	}
+	// The store must be created from a session of the provider for its calls to be audited and retried.
+	sessProvider.Retry(vars.maxRetries, vars.retryBaseDelay)
+	var calls *sessions.CallRecorder
+	if vars.shouldAuditCalls || vars.isVerbose {
+		calls = sessions.NewCallRecorder()
+		sessProvider.RecordCalls(calls)
+	}
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	storeSess, err := storeSession(sessProvider, defaultSession, vars.storeRegion)
+	if err != nil {
+		return nil, err
+	}
+	var storeOptions []config.StoreOption
+	if vars.storeEndpoint != "" {
+		storeOptions = append(storeOptions, config.WithEndpoint(vars.storeEndpoint))
+	}
+	store := config.NewStoreFromSession(storeSess, storeOptions...)
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	var prompter prompter = prompt.New()
+	if assumed := newAssumedAnswerPrompter(vars.shouldAssumeYes, vars.shouldAssumeNo); assumed != nil {
+		// The prompts are answered without asking so that scripts never block on them.
+		prompter = assumed
+	}
+	sel := selector.NewSelect(prompter, store)
+	cacheDir, _ := os.UserCacheDir() // Empty if there's none, and then the names aren't cached.
+	if cache := newAppCache(afero.NewOsFs(), cacheDir, storeSess); cache != nil {
+		if vars.shouldRefreshCache {
+			cache.Refresh()
+		}
+		sel.WithAppCache(cache)
+	}
+	var pipelineSvc pipelineGetter = codepipeline.New(defaultSession)
+	if vars.pipelineSource == appShowPipelineSourceGitHubActions {
+		pipelineSvc = codepipeline.NewGitHubActions(defaultSession)
+	}
+	// stdout and stderr share a mutex as they're usually the same terminal.
+	var outputMu sync.Mutex
+	opts := &showAppOpts{
+		showAppVars:  vars,
+		store:        store,
+		w:            newSyncWriter(log.OutputWriter, &outputMu),
+		diagW:        newSyncWriter(log.DiagnosticWriter, &outputMu),
+		prompt:       prompter,
+		sel:          sel,
+		appChoices:   sel,
+		pipelineSvc:  pipelineSvc,
+		connections:  awscodestar.New(defaultSession),
+		builds:       codepipeline.New(defaultSession),
+		pipelineTags: codepipeline.New(defaultSession),
+		artifacts:    codepipeline.New(defaultSession),
+		budgets:      budgets.New(defaultSession),
+		monitors:     costexplorer.New(defaultSession),
+		appResources: deploycfn.New(defaultSession),
+		appStacks:    cloudformation.New(defaultSession),
+		tagStacks:    cloudformation.New(defaultSession),
+		identity:     identity.New(defaultSession),
+		clock:        identity.New(defaultSession),
+		sessProvider: sessProvider,
+		sessRegion:   aws.StringValue(defaultSession.Config.Region),
+		limiter:      newShowAppLimiter(vars, sessProvider.Throttled),
+		ws:           ws,
+		git:          command.New(),
+		formatter:    command.New(),
+		addons:       ws,
+		wsSvcs:       ws,
+		stackSets:    stackset.New(defaultSession),
+		calls:        calls,
+		fs:           &afero.Afero{Fs: afero.NewOsFs()},
+		clipboard:    clipboard.New(),
+		pager:        pager.New(),
+		isTerminal: func() bool {
+			return pager.IsTerminal(os.Stdout)
+		},
+		screenWidth: func() int {
+			return pager.ScreenWidth(os.Stdout)
+		},
+		flagChanged: func(name string) bool {
+			return false
+		},
+		ctx: context.Background(),
+
+		namePrompt:     appShowNamePrompt,
+		nameHelpPrompt: appShowNameHelpPrompt,
+	}
+	if dir, err := formatPluginsDir(); err == nil {
+		opts.pluginsDir = dir
+	}
+	for _, option := range options {
+		option(opts)
+	}
+	sessProvider.CancelWith(opts.ctx)
+	if err := opts.applyWorkspaceDefaults(); err != nil {
+		return nil, err
+	}
+	opts.newStackLister = func(env *config.Environment) (stackLister, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudformation.New(sess), nil
+	}
+	opts.newStackResourcesGetter = func(env *config.Environment) (stackResourcesGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudformation.New(sess), nil
+	}
+	opts.newTemplateGetter = func(env *config.Environment) (stackTemplateGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudformation.New(sess), nil
+	}
+	opts.newTaskDefGetter = func(env *config.Environment) (taskDefinitionGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return awsecs.New(sess), nil
+	}
+	opts.newECSServiceDescriber = func(env *config.Environment) (ecsServiceDescriber, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return awsecs.New(sess), nil
+	}
+	opts.newAppRunnerDescriber = func(env *config.Environment) (appRunnerServiceDescriber, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return apprunner.New(sess), nil
+	}
+	opts.newCertDescriber = func(env *config.Environment) (certificateDescriber, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return acm.New(sess), nil
+	}
+	opts.newWebACLGetter = func(env *config.Environment) (webACLGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return wafv2.New(sess), nil
+	}
+	opts.newDeploymentGetter = func(env *config.Environment) (stackDeploymentGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudtrail.New(sess), nil
+	}
+	opts.newLogRetentionGetter = func(env *config.Environment) (logGroupRetentionGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudwatchlogs.New(sess), nil
+	}
+	opts.newAlarmGetter = func(env *config.Environment) (alarmStatusGetter, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return cloudwatch.New(sess), nil
+	}
+	opts.newExecutionLister = func(env *config.Environment) (jobExecutionLister, error) {
+		sess, err := opts.envSession(env)
+		if err != nil {
+			return nil, err
+		}
+		return stepfunctions.New(sess), nil
+	}
+	opts.newObjectCounter = func(region string) (bucketObjectCounter, error) {
+		// The artifact buckets are in the application's account.
+		sess, err := opts.sessProvider.DefaultWithRegion(region)
+		if err != nil {
+			return nil, err
+		}
+		return cloudwatch.New(sess), nil
+	}
+	opts.newBucketConfigGetter = func(region string) (bucketConfigGetter, error) {
+		// The artifact buckets are in the application's account.
+		sess, err := opts.sessProvider.DefaultWithRegion(region)
+		if err != nil {
+			return nil, err
+		}
+		return s3.New(sess), nil
+	}
+	opts.now = time.Now
+	opts.after = time.After
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *showAppOpts) Validate() error {
+	// The diagnostics are redirected first so that the errors of the other flags are written to --errors-to too.
+	if o.errorsTo != "" {
+		if err := o.openErrorsTo(); err != nil {
+			return err
+		}
+	}
+	// The retries and the store endpoint are validated first as they apply to the calls made while validating the other flags.
+	if o.maxRetries < 0 {
+		return fmt.Errorf("--%s must be non-negative, got %d", maxRetriesFlag, o.maxRetries)
+	}
+	if o.retryBaseDelay < 0 {
+		return fmt.Errorf("--%s must be non-negative, got %s", retryBaseDelayFlag, o.retryBaseDelay)
+	}
+	if o.retryOnEmpty < 0 {
+		return fmt.Errorf("--%s must be non-negative, got %d", retryOnEmptyFlag, o.retryOnEmpty)
+	}
+	if o.parallelEnvs < 0 {
+		return fmt.Errorf("--%s must not be negative, got %d", parallelEnvsFlag, o.parallelEnvs)
+	}
+	if o.shouldRunSerially && o.parallelEnvs != 0 {
+		return fmt.Errorf("--%s and --%s cannot be specified together", serialFlag, parallelEnvsFlag)
+	}
+	if o.isMaxWidthSet {
+		if o.maxWidth < 0 {
+			return fmt.Errorf("--%s must be non-negative, got %d", maxWidthFlag, o.maxWidth)
+		}
+		if o.shouldShowFull {
+			return fmt.Errorf("--%s and --%s cannot be specified together", maxWidthFlag, fullFlag)
+		}
+	}
+	if o.storeEndpoint != "" {
+		if err := config.ValidateEndpoint(o.storeEndpoint); err != nil {
+			return fmt.Errorf("--%s: %w", storeEndpointFlag, err)
+		}
+	}
+	if o.shouldAssumeYes && o.shouldAssumeNo {
+		return fmt.Errorf("--%s and --%s cannot be specified together", yesFlag, noFlag)
+	}
+	if o.shouldCheckExists {
+		return o.validateExists()
+	}
+	if o.shouldPrintSchema {
+		return o.validateJSONSchema()
+	}
+	if err := o.parseOutputs(); err != nil {
+		return err
+	}
+	if o.pipelineSource != "" {
+		if err := o.validatePipelineSource(); err != nil {
+			return err
+		}
+	}
+	if o.sortEnvs != "" {
+		if err := o.validateSortEnvs(); err != nil {
+			return err
+		}
+	}
+	if o.formatVersion != "" {
+		if err := validateFormatVersion(o.formatVersion, "--"+formatVersionFlag); err != nil {
+			return err
+		}
+	}
+	// The output template is read before any AWS API call so that a typo doesn't cost a full description.
+	if o.outputTemplateFile != "" {
+		if err := o.validateOutputTemplateFile(); err != nil {
+			return err
+		}
+	}
+	// Like the output template, the baseline is read before any AWS API call.
+	if o.diffBaseline != "" {
+		if err := o.validateDiffBaseline(); err != nil {
+			return err
+		}
+	}
+	if o.healthWeightsFile != "" {
+		if err := o.validateHealthWeights(); err != nil {
+			return err
+		}
+	}
+	// Like the baseline, the commit is resolved before any AWS API call.
+	if o.asOfCommit != "" {
+		if err := o.validateAsOfCommit(); err != nil {
+			return err
+		}
+	}
+	// The application of the pipeline is then validated like --name.
+	if o.fromPipeline != "" {
+		if err := o.validateFromPipeline(); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if err := o.validateName(); err != nil {
+			return err
+		}
+	}
+	if o.outputFormat != "" {
+		if err := o.validateOutputFormat(); err != nil {
+			return err
+		}
+	}
+	if o.isStrictJSON {
+		if err := o.validateStrictJSON(); err != nil {
+			return err
+		}
+	}
+	if o.omitFields != nil {
+		if err := o.validateOmit(); err != nil {
+			return err
+		}
+	}
+	if o.profileFromEnv != "" {
+		profiles, err := o.readEnvProfiles()
+		if err != nil {
+			return err
+		}
+		o.envProfiles = profiles
+	}
+	if o.shouldExplain && o.shouldOutputJSON {
+		return fmt.Errorf("--%s and --%s cannot be specified together", explainFlag, jsonFlag)
+	}
+	if o.failOn != "" {
+		if err := o.validateFailOn(); err != nil {
+			return err
+		}
+	}
+	if o.includeTemplates || o.templatesDir != "" {
+		if err := o.validateTemplatesDir(); err != nil {
+			return err
+		}
+	}
+	if o.shouldShowDashboard {
+		if err := o.validateDashboard(); err != nil {
+			return err
+		}
+	}
+	if o.shouldValidateOnly {
+		if err := o.validateValidateOnly(); err != nil {
+			return err
+		}
+	}
+	if o.outputTargets != nil {
+		if err := o.validateOutputTargets(); err != nil {
+			return err
+		}
+	}
+	if o.promotionCheck != nil {
+		if err := o.validatePromotionCheck(); err != nil {
+			return err
+		}
+	}
+	if o.shouldRunDoctor {
+		if err := o.validateDoctor(); err != nil {
+			return err
+		}
+	}
+	if o.shouldCountOnly {
+		if err := o.validateCountOnly(); err != nil {
+			return err
+		}
+	}
+	if err := o.validateWatch(); err != nil {
+		return err
+	}
+	if o.compareEnvs != nil {
+		if err := o.validateCompareEnvs(); err != nil {
+			return err
+		}
+	}
+	if o.tee != "" {
+		return o.openTee()
+	}
+	return nil
+}
+
+// openTee opens the file of --tee in append mode, and duplicates what's written to stdout to it.
+// The file is opened once the other flags are valid, and before describing the application so that no AWS calls are made in vain.
+func (o *showAppOpts) openTee() error {
+	f, err := o.fs.OpenFile(o.tee, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open --%s file %s: %w", teeFlag, o.tee, err)
+	}
+	o.teeW = f
+	o.w = io.MultiWriter(o.w, f)
+	return nil
+}
+
+// openErrorsTo opens the file of --errors-to in append mode, and writes the diagnostics of the command to it instead of stderr.
+func (o *showAppOpts) openErrorsTo() error {
+	f, err := o.fs.OpenFile(o.errorsTo, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open --%s file %s: %w", errorsToFlag, o.errorsTo, err)
+	}
+	o.errorsW = f
+	o.stderrW = log.DiagnosticWriter
+	log.DiagnosticWriter = f
+	o.diagW = f
+	return nil
+}
+
+// routeError writes the error to the file of --errors-to, and returns an error that exits with the same code without
+// being written to stderr. The errors that already exit silently, and all errors without --errors-to, are returned as is.
+func (o *showAppOpts) routeError(err error) error {
+	var silentErr *ErrSilentExit
+	if err == nil || o.errorsW == nil || errors.As(err, &silentErr) {
+		return err
+	}
+	log.Errorln(err.Error())
+	return &ErrSilentExit{Code: 1}
+}
+
+// closeErrorsTo closes the file of --errors-to, if it was opened, and writes the diagnostics to stderr again.
+func (o *showAppOpts) closeErrorsTo() {
+	if o.errorsW == nil {
+		return
+	}
+	log.DiagnosticWriter = o.stderrW
+	o.errorsW.Close()
+	o.errorsW = nil
+}
+
+// applyWorkspaceDefaults sets the flags that weren't set on the command line or from their environment variables
+// to their values in the .copilot-show.yaml file at the root of the workspace. There are no defaults outside of a workspace.
+func (o *showAppOpts) applyWorkspaceDefaults() error {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return nil
+	}
+	path := filepath.Join(filepath.Dir(copilotDir), appShowDefaultsFileName)
+	content, err := afero.ReadFile(o.fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read flag defaults file %s: %w", path, err)
+	}
+	var defaults showAppDefaults
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&defaults); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unmarshal flag defaults file %s: %w", path, err)
+	}
+	if err := defaults.validate(); err != nil {
+		return fmt.Errorf("validate flag defaults file %s: %w", path, err)
+	}
+	// --json and --output are set together from the COPILOT_OUTPUT environment variable.
+	if defaults.Output != nil && !o.flagChanged(jsonFlag) && !o.flagChanged(outputFlag) {
+		o.outputFormat = aws.StringValue(defaults.Output)
+	}
+	for _, d := range []struct {
+		flag   string
+		value  *bool
+		target *bool
+	}{
+		{flag: resourcesFlag, value: defaults.Resources, target: &o.shouldOutputResources},
+		{flag: showSecretsFlag, value: defaults.ShowSecrets, target: &o.shouldShowSecrets},
+		{flag: fullFlag, value: defaults.Full, target: &o.shouldShowFull},
+		{flag: noColorFlag, value: defaults.NoColor, target: &o.noColor},
+		{flag: noLegendFlag, value: defaults.NoLegend, target: &o.noLegend},
+		{flag: noHintsFlag, value: defaults.NoHints, target: &o.noHints},
+	} {
+		if d.value != nil && !o.flagChanged(d.flag) {
+			*d.target = *d.value
+		}
+	}
+	// Defaults that conflict with the flags that were set are ignored rather than reported as errors.
+	isHuman := !o.shouldOutputJSON && !o.isStrictJSON && (o.outputFormat == "" || o.outputFormat == appShowOutputHuman)
+	if defaults.Explain != nil && !o.flagChanged(explainFlag) && isHuman {
+		o.shouldExplain = *defaults.Explain
+	}
+	if defaults.FailOn != nil && !o.flagChanged(failOnFlag) && !o.isStrict {
+		o.failOn = aws.StringValue(defaults.FailOn)
+	}
+	if defaults.FormatVersion != nil && !o.flagChanged(formatVersionFlag) {
+		o.formatVersion = aws.StringValue(defaults.FormatVersion)
+	}
+	return nil
+}
+
+// validate returns an error if the output format or the severity of the defaults isn't supported.
+func (d *showAppDefaults) validate() error {
+	if d.Output != nil {
+		output := aws.StringValue(d.Output)
+		if _, ok := builtInAppShowFormat(output); !ok {
+			return unsupportedAppShowOutputError(output)
+		}
+	}
+	if d.FormatVersion != nil {
+		if err := validateFormatVersion(aws.StringValue(d.FormatVersion), formatVersionFlag); err != nil {
+			return err
+		}
+	}
+	if d.FailOn != nil {
+		severity := aws.StringValue(d.FailOn)
+		for _, s := range describe.WarningSeverities {
+			if severity == s {
+				return nil
+			}
+		}
+		return fmt.Errorf("unsupported severity %q for %s, must be one of %s", severity, failOnFlag, strings.Join(describe.WarningSeverities, ", "))
+	}
+	return nil
+}
+
+// contextsFilePath returns the path of the contexts file in the user's configuration directory.
+func contextsFilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find the directory of the contexts file: %w", err)
+	}
+	return filepath.Join(dir, "copilot", appShowContextsFileName), nil
+}
+
+// readShowAppContext returns the context named name of the contexts file at path.
+func readShowAppContext(fs afero.Fs, path, name string) (*showAppContext, error) {
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read contexts file %s: %w", path, err)
+	}
+	var contexts showAppContexts
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&contexts); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unmarshal contexts file %s: %w", path, err)
+	}
+	showCtx, ok := contexts.Contexts[name]
+	if !ok {
+		names := make([]string, 0, len(contexts.Contexts))
+		for ctxName := range contexts.Contexts {
+			names = append(names, ctxName)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("context %s not found in %s: there are no contexts", name, path)
+		}
+		return nil, fmt.Errorf("context %s not found in %s, must be one of %s", name, path, english.OxfordWordSeries(names, "or"))
+	}
+	return &showCtx, nil
+}
+
+// apply sets the store flags that weren't set on the command line or from their environment variables to the values of the context.
+func (c *showAppContext) apply(vars *showAppVars, flagChanged func(name string) bool) {
+	if c.StoreRegion != "" && !flagChanged(storeRegionFlag) {
+		vars.storeRegion = c.StoreRegion
+	}
+	if c.StoreEndpoint != "" && !flagChanged(storeEndpointFlag) {
+		vars.storeEndpoint = c.StoreEndpoint
+	}
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *showAppOpts) Ask() error {
+	// The diagnostics don't need an application, and must run even if the applications can't be listed to select one.
+	if o.shouldListOnly || o.shouldCheckExists || o.shouldRunDoctor || o.shouldPrintSchema {
+		return nil
+	}
+	if err := o.askName(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Execute writes the application's description.
+// If the command is interrupted, the output isn't written and the command exits with exitCodeInterrupted.
+func (o *showAppOpts) Execute() error {
+	if o.auditLog != "" {
+		o.writeAuditEvent()
+	}
+	if o.teeW != nil {
+		defer o.teeW.Close()
+	}
+	err := o.execute()
+	if o.isInterrupted() {
+		return &ErrSilentExit{Code: exitCodeInterrupted}
+	}
+	return err
+}
+
+// writeAuditEvent appends the audit event of the command to --audit-log as a line of json.
+// The principal is "unknown" if the caller identity can't be retrieved. Failures to write the event are only warnings,
+// so that the audit log never keeps the application from being described.
+func (o *showAppOpts) writeAuditEvent() {
+	principal := appShowAuditPrincipalUnknown
+	if caller, err := o.identity.Get(); err == nil && caller.ARN != "" {
+		principal = caller.ARN
+	}
+	b, err := json.Marshal(appShowAuditEvent{
+		Timestamp:    o.now().UTC(),
+		Principal:    principal,
+		App:          o.name,
+		OutputFormat: o.auditedOutputFormat(),
+	})
+	if err != nil {
+		log.Warningf("Couldn't marshal the audit event: %v\n", err)
+		return
+	}
+	b = append(b, '\n')
+	if o.auditLog == appShowAuditLogStderr {
+		if _, err := o.diagW.Write(b); err != nil {
+			log.Warningf("Couldn't write the audit event to stderr: %v\n", err)
+		}
+		return
+	}
+	f, err := o.fs.OpenFile(o.auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Warningf("Couldn't open audit log %s: %v\n", o.auditLog, err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(b); err != nil {
+		log.Warningf("Couldn't write the audit event to %s: %v\n", o.auditLog, err)
+	}
+}
+
+// auditedOutputFormat returns the formats that the application is described in, separated by commas with several --output.
+func (o *showAppOpts) auditedOutputFormat() string {
+	switch {
+	case o.outputTargets != nil:
+		var formats []string
+		for _, target := range o.outputTargets {
+			formats = append(formats, target.format)
+		}
+		return strings.Join(formats, ",")
+	case o.shouldOutputJSON:
+		return appShowOutputJSON
+	case o.outputFormat != "":
+		return o.outputFormat
+	}
+	return appShowOutputHuman
+}
+
+// RecommendedActions returns the commands to run next given the state of the described application, like the
+// deployment of the services that aren't deployed yet. There are none with --no-hints or if the output isn't human readable.
+func (o *showAppOpts) RecommendedActions() []string {
+	isHuman := !o.shouldOutputJSON && (o.outputFormat == "" || o.outputFormat == appShowOutputHuman) && o.outputTargets == nil && o.outputTemplate == nil
+	if o.described == nil || o.noHints || !isHuman {
+		return nil
+	}
+	app := o.described
+	if len(app.Envs) == 0 {
+		return []string{
+			fmt.Sprintf("Run %s to add an environment to your application.", color.HighlightCode("copilot env init")),
+		}
+	}
+	if len(app.Services) == 0 {
+		return []string{
+			fmt.Sprintf("Run %s to add a service or job to your application.", color.HighlightCode("copilot init")),
+		}
+	}
+	var actions []string
+	deployed := make(map[string]bool)
+	for _, d := range app.Deployments {
+		deployed[d.Service] = true
+	}
+	cmds := make(map[string]string) // Workload name to the command group of its type, "svc" or "job".
+	for _, wkld := range app.Services {
+		cmds[wkld.Name] = "svc"
+		for _, jobType := range manifest.JobTypes {
+			if wkld.Type == jobType {
+				cmds[wkld.Name] = "job"
+			}
+		}
+		if !deployed[wkld.Name] {
+			actions = append(actions, fmt.Sprintf("Run %s to deploy %s to an environment.",
+				color.HighlightCode(fmt.Sprintf("copilot %s deploy --name %s", cmds[wkld.Name], wkld.Name)), wkld.Name))
+		}
+	}
+	for _, env := range app.Envs {
+		if status, ok := app.EnvStatuses[env.Name]; ok && cloudformation.StackStatus(status).Failure() {
+			actions = append(actions, fmt.Sprintf("Run %s to see why environment %s isn't provisioned successfully.",
+				color.HighlightCode(fmt.Sprintf("copilot env show --name %s", env.Name)), env.Name))
+		}
+	}
+	for _, d := range app.Deployments {
+		// There is no status command for the jobs.
+		if cmds[d.Service] == "svc" && cloudformation.StackStatus(d.StackStatus).Failure() {
+			actions = append(actions, fmt.Sprintf("Run %s to see why %s failed to deploy to %s.",
+				color.HighlightCode(fmt.Sprintf("copilot svc status --name %s --env %s", d.Service, d.Environment)), d.Service, d.Environment))
+		}
+	}
+	if len(app.Pipelines) == 0 && !app.PipelinesSkipped {
+		actions = append(actions, fmt.Sprintf("No pipelines found. Run %s to release your services with a pipeline.", color.HighlightCode("copilot pipeline init")))
+	}
+	return actions
+}
+
+// envsAndServices lists the environments and the services of the application. With --retry-on-empty, they're listed
+// again while either list is empty, as the config store can lag behind right after the application or an environment
+// is created. The last lists are returned if they're still empty, like for an application without services.
+func (o *showAppOpts) envsAndServices() ([]*config.Environment, []*config.Workload, error) {
+	for attempt := 1; ; attempt++ {
+		envs, err := o.store.ListEnvironments(o.name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+		}
+		svcs, err := o.store.ListServices(o.name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list services in application %s: %w", o.name, err)
+		}
+		if len(envs) != 0 && len(svcs) != 0 || attempt > o.retryOnEmpty {
+			return envs, svcs, nil
+		}
+		log.Infof("Application %s has %s and %s, listing them again in %s (%d/%d).\n", o.name,
+			english.Plural(len(envs), "environment", ""), english.Plural(len(svcs), "service", ""), retryOnEmptyDelay, attempt, o.retryOnEmpty)
+		if !o.wait(retryOnEmptyDelay) {
+			return envs, svcs, nil
+		}
+	}
+}
+
+// wait returns true once the delay has elapsed, or false if the command is interrupted first.
+func (o *showAppOpts) wait(d time.Duration) bool {
+	var interrupted <-chan struct{}
+	if o.ctx != nil {
+		interrupted = o.ctx.Done()
+	}
+	select {
+	case <-o.after(d):
+		return true
+	case <-interrupted:
+		return false
+	}
+}
+
+// isInterrupted returns true if the context of the command is done.
+func (o *showAppOpts) isInterrupted() bool {
+	return o.ctx != nil && o.ctx.Err() != nil
+}
+
+func (o *showAppOpts) execute() error {
+	if o.shouldPrintSchema {
+		return o.writeJSONSchema()
+	}
+	if o.isVerbose {
+		defer o.writeCallCounts()
+	}
+	if o.shouldAuditCalls {
+		defer o.writeAuditedCalls()
+	}
+	if o.shouldCheckExists {
+		return o.checkExists()
+	}
+	if o.shouldListOnly {
+		return o.listChoices()
+	}
+	if o.compareEnvs != nil {
+		return o.compareEnvironments()
+	}
+	if o.promotionCheck != nil {
+		return o.checkPromotion()
+	}
+	if o.shouldRunDoctor {
+		return o.runDoctor()
+	}
+	if o.shouldCountOnly {
+		return o.writeCounts()
+	}
+	if o.shouldWatch {
+		return o.watch()
+	}
+	if o.shouldBenchmark {
+		defer o.writeBenchmark(o.now())
+	}
+	description, err := o.description()
+	if err != nil {
+		return err
+	}
+	if o.shouldValidateOnly {
+		return o.writeValidation(description)
+	}
+	if o.baseline != nil {
+		return o.writeBaselineDiff(description)
+	}
+	if o.isStrictJSON {
+		if err := o.checkComplete(description); err != nil {
+			return err
+		}
+	}
+	o.described = description
+	healthy := len(o.failing) == 0 && len(description.Warnings) == 0
+	if o.shouldOnlyFailing {
+		o.onlyFailing(description)
+	}
+	if o.outputTargets != nil {
+		err = o.writeOutputTargets(description, healthy)
+	} else {
+		err = o.writeOutput(description, healthy)
+	}
+	if err != nil {
+		return err
+	}
+	if o.isStrict && len(description.Warnings) != 0 {
+		return &errStrictWarnings{count: len(description.Warnings)}
+	}
+	if o.failOn != "" {
+		var count int
+		for _, warning := range description.Warnings {
+			if warning.AtLeast(o.failOn) {
+				count++
+			}
+		}
+		if count != 0 {
+			return &errFailOnWarnings{severity: o.failOn, count: count}
+		}
+	}
+	return nil
+}
+
+// checkComplete returns an error if the description is partial, like an environment whose stack status is unknown,
+// after writing what is incomplete as a json error to the diagnostics. The output isn't written then, so that
+// the reports of --strict-json are either complete or clearly failed.
+func (o *showAppOpts) checkComplete(description *describe.App) error {
+	fields, err := description.IncompleteFields()
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 && len(o.partial) == 0 {
+		return nil
+	}
+	incomplete := &errIncompleteDescription{fields: len(fields), errors: len(o.partial)}
+	b, err := json.Marshal(appShowIncompleteError{
+		Error:            incomplete.Error(),
+		App:              description.Name,
+		IncompleteFields: fields,
+		PartialErrors:    o.partial,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal incomplete description error: %w", err)
+	}
+	fmt.Fprintf(o.diagW, "%s\n", b)
+	return incomplete
+}
+
+// compareEnvironments writes the differences between the services deployed in the two compared environments.
+func (o *showAppOpts) compareEnvironments() error {
+	svcs, err := o.store.ListServices(o.name)
+	if err != nil {
+		return fmt.Errorf("list services in application %s: %w", o.name, err)
+	}
+	o.envStacks = make(map[string][]cloudformation.StackDescription)
+	o.taskDefs = make(map[workloadInEnv]*awsecs.TaskDefinition)
+	compared := make([][]*describe.ComparedService, len(o.compareEnvs))
+	for i, name := range o.compareEnvs {
+		env, err := o.store.GetEnvironment(o.name, name)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", name, err)
+		}
+		compared[i], err = o.comparedSvcs(env, svcs)
+		if err != nil {
+			return err
+		}
+	}
+	comparison := describe.NewAppEnvComparison(o.name, o.compareEnvs[0], compared[0], o.compareEnvs[1], compared[1])
+	comparison.Width = o.tableWidth()
+	var out string
+	if o.shouldOutputJSON {
+		out, err = comparison.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+	} else {
+		out = comparison.HumanString()
+	}
+	out = o.redact(out)
+	if err := o.render(out); err != nil {
+		return err
+	}
+	if o.shouldCopy {
+		o.copy(out)
+	}
+	return nil
+}
+
+// checkPromotion writes whether the second environment of --promotion-check is ready for the services of the first
+// to be promoted to it, and returns an error if it isn't.
+func (o *showAppOpts) checkPromotion() error {
+	from, to := o.promotionCheck[0], o.promotionCheck[1]
+	fromEnv, err := o.store.GetEnvironment(o.name, from)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", from, err)
+	}
+	o.envStacks = make(map[string][]cloudformation.StackDescription)
+	checks, err := o.promotionChecks(fromEnv, to)
+	if err != nil {
+		return err
+	}
+	report := describe.NewAppPromotionCheck(o.name, from, to, checks)
+	var out string
+	if o.shouldOutputJSON {
+		out, err = report.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+	} else {
+		out = report.HumanString()
+	}
+	if err := o.render(o.redact(out)); err != nil {
+		return err
+	}
+	if !report.Ready {
+		return &errPromotionCheckFailed{env: to, failed: report.Failed(), total: len(report.Checks)}
+	}
+	return nil
+}
+
+// Thresholds of the skew of the local clock with --doctor. AWS rejects the requests signed more than 5 minutes away from its time.
+const (
+	doctorClockSkewWarn = time.Minute
+	doctorClockSkewFail = 5 * time.Minute
+)
+
+// accessDeniedErrCodes are the error codes of the AWS API calls that the caller isn't allowed to make.
+var accessDeniedErrCodes = map[string]bool{
+	"AccessDenied":          true,
+	"AccessDeniedException": true,
+	"UnauthorizedOperation": true,
+}
+
+// runDoctor writes the checklist of the diagnostics of what the command needs to describe the application,
+// without describing it, and exits with an error if any of them failed.
+func (o *showAppOpts) runDoctor() error {
+	report := &describe.AppDiagnostics{
+		App:         o.name,
+		Diagnostics: o.diagnostics(),
+	}
+	var out string
+	var err error
+	if o.shouldOutputJSON {
+		out, err = report.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+	} else {
+		out = report.HumanString()
+	}
+	if err := o.render(o.redact(out)); err != nil {
+		return err
+	}
+	if failed := report.Count(describe.DiagnosticFail); failed != 0 {
+		return &errDiagnosticsFailed{failed: failed, total: len(report.Diagnostics)}
+	}
+	return nil
+}
+
+// counts tallies the environments, services, jobs and pipelines of the application, and the health of the deployments
+// of its workloads from the status of their stacks. None of the workloads is looked up beyond the stack listing of
+// each environment, and only the names of the pipelines are listed.
+// The workloads of the environments whose stacks can't be listed aren't tallied, with a warning.
+func (o *showAppOpts) counts() (*describe.AppCounts, error) {
+	o.envStacks = make(map[string][]cloudformation.StackDescription)
+	envs, svcs, err := o.envsAndServices()
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := o.store.ListJobs(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in application %s: %w", o.name, err)
+	}
+	counts := &describe.AppCounts{
+		App:          o.name,
+		Environments: len(envs),
+		Services:     make(map[string]int),
+		Jobs:         len(jobs),
+	}
+	for _, svc := range svcs {
+		counts.Services[svc.Type]++
+	}
+	if !o.noPipelines {
+		names, err := o.pipelineSvc.ListPipelineNamesByTags(map[string]string{
+			deploy.AppTagKey: o.name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list pipelines in application %s: %w", o.name, err)
+		}
+		pipelines := len(names)
+		counts.Pipelines = &pipelines
+	}
+	var workloads []string
+	for _, wl := range append(svcs, jobs...) {
+		workloads = append(workloads, wl.Name)
+	}
+	o.batchStacks(envs)
+	// The warnings are logged in the order of the environments once they're all counted, not from the goroutines.
+	warnings := make([]string, len(envs))
+	forEachConcurrently(len(envs), o.maxConcurrency(), func(i int) error {
+		env := envs[i]
+		stacks, err := o.stacks(env)
+		if err != nil {
+			warnings[i] = fmt.Sprintf("Couldn't count the workloads deployed in environment %s: %v\n", env.Name, err)
+			return nil
+		}
+		statuses := make(map[string]string)
+		for _, s := range stacks {
+			statuses[aws.StringValue(s.StackName)] = aws.StringValue(s.StackStatus)
+		}
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		for _, wl := range workloads {
+			status, ok := statuses[stack.NameForService(o.name, env.Name, wl)]
+			if !ok {
+				continue
+			}
+			if cloudformation.StackStatus(status).Success() {
+				counts.Healthy++
+			} else {
+				counts.Unhealthy++
+			}
+		}
+		return nil
+	})
+	for _, warning := range warnings {
+		if warning != "" {
+			log.Warning(o.redact(warning))
+		}
+	}
+	return counts, nil
 }
 
-type showAppOpts struct {
-	showAppVars
+// diagnostics checks the version of the CLI, the credentials, the skew of the local clock and the config store.
+// With --name, it also checks that the application exists and that the stacks of each of its environments
+// and its pipelines can be listed.
+func (o *showAppOpts) diagnostics() []*describe.AppDiagnostic {
+	diagnostics := []*describe.AppDiagnostic{o.versionDiagnostic()}
+	if caller, err := o.identity.Get(); err != nil {
+		diagnostics = append(diagnostics, &describe.AppDiagnostic{Check: "Credentials", Status: describe.DiagnosticFail, Detail: err.Error()})
+	} else {
+		diagnostics = append(diagnostics, &describe.AppDiagnostic{Check: "Credentials", Status: describe.DiagnosticPass, Detail: caller.ARN})
+	}
+	diagnostics = append(diagnostics, o.clockSkewDiagnostic())
+	if o.name == "" {
+		apps, err := o.store.ListApplications()
+		if err != nil {
+			return append(diagnostics, &describe.AppDiagnostic{Check: "Config store", Status: describe.DiagnosticFail, Detail: fmt.Sprintf("list applications: %v", err)})
+		}
+		return append(diagnostics, &describe.AppDiagnostic{Check: "Config store", Status: describe.DiagnosticPass, Detail: fmt.Sprintf("%s found", english.Plural(len(apps), "application", ""))})
+	}
+	if _, err := o.store.GetApplication(o.name); err != nil {
+		detail := fmt.Sprintf("get application %s: %v", o.name, err)
+		var noSuchAppErr *config.ErrNoSuchApplication
+		if errors.As(err, &noSuchAppErr) {
+			detail = fmt.Sprintf("application %s not found", o.name)
+		}
+		return append(diagnostics, &describe.AppDiagnostic{Check: "Config store", Status: describe.DiagnosticFail, Detail: detail})
+	}
+	diagnostics = append(diagnostics, &describe.AppDiagnostic{Check: "Config store", Status: describe.DiagnosticPass, Detail: fmt.Sprintf("application %s found", o.name)})
+	return append(diagnostics, o.permissionDiagnostics()...)
+}
 
-	prompt      prompter
-	store       store
-	w           io.Writer
-	sel         appSelector
-	pipelineSvc pipelineGetter
+// versionDiagnostic reports the version of the CLI, to include in bug reports. Builds from source have no version.
+func (o *showAppOpts) versionDiagnostic() *describe.AppDiagnostic {
+	if version.Version == "" {
+		return &describe.AppDiagnostic{Check: "CLI version", Status: describe.DiagnosticWarn, Detail: "unknown, the CLI was built from source"}
+	}
+	return &describe.AppDiagnostic{Check: "CLI version", Status: describe.DiagnosticPass, Detail: version.Version}
 }
 
-func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
-	store, err := config.NewStore()
+// clockSkewDiagnostic compares the local clock with the time of AWS.
+func (o *showAppOpts) clockSkewDiagnostic() *describe.AppDiagnostic {
+	serverTime, err := o.clock.ServerTime()
 	if err != nil {
-		return nil, fmt.Errorf("new config store: %w", err)
+		return &describe.AppDiagnostic{Check: "Clock skew", Status: describe.DiagnosticWarn, Detail: fmt.Sprintf("couldn't compare the local clock with AWS: %v", err)}
+	}
+	// The time of AWS is truncated to the second.
+	skew := o.now().Sub(serverTime).Round(time.Second)
+	direction := "ahead of"
+	if skew < 0 {
+		skew, direction = -skew, "behind"
 	}
+	detail := fmt.Sprintf("the local clock is %s %s AWS", skew, direction)
+	switch {
+	case skew > doctorClockSkewFail:
+		return &describe.AppDiagnostic{Check: "Clock skew", Status: describe.DiagnosticFail, Detail: fmt.Sprintf("%s, requests signed more than %s away are rejected", detail, doctorClockSkewFail)}
+	case skew > doctorClockSkewWarn:
+		return &describe.AppDiagnostic{Check: "Clock skew", Status: describe.DiagnosticWarn, Detail: detail}
+	}
+	return &describe.AppDiagnostic{Check: "Clock skew", Status: describe.DiagnosticPass, Detail: detail}
+}
 
-	defaultSession, err := sessions.NewProvider().Default()
+// permissionDiagnostics checks that the stacks of each environment of the application and its pipelines can be listed,
+// concurrently. An error that isn't a denied permission or an unreachable account is only a warning.
+func (o *showAppOpts) permissionDiagnostics() []*describe.AppDiagnostic {
+	envs, err := o.store.ListEnvironments(o.name)
 	if err != nil {
-		return nil, fmt.Errorf("default session: %w", err)
+		return []*describe.AppDiagnostic{{Check: "Environments", Status: describe.DiagnosticFail, Detail: fmt.Sprintf("list environments: %v", err)}}
+	}
+	o.envStacks = make(map[string][]cloudformation.StackDescription)
+	diagnostics := make([]*describe.AppDiagnostic, len(envs))
+	o.forEachPooled(len(envs), func(i int) error {
+		env := envs[i]
+		check := fmt.Sprintf("Environment %s", env.Name)
+		if _, err := o.stacks(env); err != nil {
+			diagnostics[i] = permissionDiagnostic(check, err)
+			return nil
+		}
+		diagnostics[i] = &describe.AppDiagnostic{Check: check, Status: describe.DiagnosticPass, Detail: fmt.Sprintf("stacks listed in account %s and region %s", env.AccountID, env.Region)}
+		return nil
+	})
+	if o.noPipelines {
+		return diagnostics
+	}
+	if _, err := o.pipelineSvc.GetPipelinesByTags(map[string]string{deploy.AppTagKey: o.name}); err != nil {
+		return append(diagnostics, permissionDiagnostic("Pipelines", fmt.Errorf("list pipelines: %w", err)))
 	}
-	prompter := prompt.New()
-	return &showAppOpts{
-		showAppVars: vars,
-		store:       store,
-		w:           log.OutputWriter,
-		prompt:      prompter,
-		sel:         selector.NewSelect(prompter, store),
-		pipelineSvc: codepipeline.New(defaultSession),
-	}, nil
+	return append(diagnostics, &describe.AppDiagnostic{Check: "Pipelines", Status: describe.DiagnosticPass, Detail: "pipelines listed"})
 }
 
-// Validate returns an error if the values provided by the user are invalid.
-func (o *showAppOpts) Validate() error {
-	if o.name != "" {
-		_, err := o.store.GetApplication(o.name)
+// permissionDiagnostic fails the check if the caller isn't allowed to make the call or can't access the account,
+// and only warns about the other errors.
+func permissionDiagnostic(check string, err error) *describe.AppDiagnostic {
+	var aerr awserr.Error
+	switch {
+	case isAccountUnreachableErr(err):
+		return &describe.AppDiagnostic{Check: check, Status: describe.DiagnosticFail, Detail: fmt.Sprintf("account unreachable: %v", err)}
+	case errors.As(err, &aerr) && accessDeniedErrCodes[aerr.Code()]:
+		return &describe.AppDiagnostic{Check: check, Status: describe.DiagnosticFail, Detail: fmt.Sprintf("permission denied: %v", err)}
+	}
+	return &describe.AppDiagnostic{Check: check, Status: describe.DiagnosticWarn, Detail: err.Error()}
+}
+
+// promotionChecks checks that the target environment exists, that none of its stacks failed or are being deployed,
+// and that its environment template is at least as recent as the one of the source environment.
+// The other checks are skipped if the target environment doesn't exist.
+func (o *showAppOpts) promotionChecks(fromEnv *config.Environment, to string) ([]*describe.PromotionCheckResult, error) {
+	toEnv, err := o.store.GetEnvironment(o.name, to)
+	var notFound *config.ErrNoSuchEnvironment
+	if errors.As(err, &notFound) {
+		return []*describe.PromotionCheckResult{
+			{Name: describe.PromotionCheckExists, Reason: fmt.Sprintf("Environment %s is not in application %s.", to, o.name)},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", to, err)
+	}
+	checks := []*describe.PromotionCheckResult{
+		{Name: describe.PromotionCheckExists, Passed: true, Reason: fmt.Sprintf("Environment %s is in application %s.", to, o.name)},
+	}
+	stacks, err := o.stacks(toEnv)
+	if err != nil {
+		return nil, err
+	}
+	envStackName := stack.NameForEnv(o.name, to)
+	var hasEnvStack bool
+	var failed, deploying []string
+	for _, s := range stacks {
+		name, status := aws.StringValue(s.StackName), cloudformation.StackStatus(aws.StringValue(s.StackStatus))
+		if name == envStackName {
+			hasEnvStack = true
+		}
+		switch {
+		case status.Failure():
+			failed = append(failed, fmt.Sprintf("%s is in %s", name, status))
+		case status.InProgress():
+			deploying = append(deploying, fmt.Sprintf("%s is in %s", name, status))
+		}
+	}
+	healthy := &describe.PromotionCheckResult{Name: describe.PromotionCheckHealthy, Passed: true, Reason: fmt.Sprintf("No stack of environment %s is in a failed state.", to)}
+	switch {
+	case !hasEnvStack:
+		healthy.Passed, healthy.Reason = false, fmt.Sprintf("Stack %s of environment %s was not found.", envStackName, to)
+	case len(failed) != 0:
+		healthy.Passed, healthy.Reason = false, fmt.Sprintf("Stack %s.", strings.Join(failed, ", stack "))
+	}
+	checks = append(checks, healthy)
+	version := &describe.PromotionCheckResult{Name: describe.PromotionCheckTemplateVersion, Reason: fmt.Sprintf("Stack %s of environment %s was not found.", envStackName, to)}
+	if hasEnvStack {
+		fromVersion, err := o.envTemplateVersion(fromEnv)
+		if err != nil {
+			return nil, err
+		}
+		toVersion, err := o.envTemplateVersion(toEnv)
 		if err != nil {
-			return fmt.Errorf("get application %s: %w", o.name, err)
+			return nil, err
 		}
+		version.Passed = semver.Compare(toVersion, fromVersion) >= 0
+		version.Reason = fmt.Sprintf("Environment %s is on version %s, and %s on version %s.", to, toVersion, fromEnv.Name, fromVersion)
+		if !version.Passed {
+			version.Reason = fmt.Sprintf("Environment %s is on version %s, older than version %s of %s: run \"copilot env upgrade -n %s\".", to, toVersion, fromVersion, fromEnv.Name, to)
+		}
+	}
+	checks = append(checks, version)
+	notDeploying := &describe.PromotionCheckResult{Name: describe.PromotionCheckNotDeploying, Passed: true, Reason: fmt.Sprintf("No stack of environment %s is being deployed.", to)}
+	if len(deploying) != 0 {
+		notDeploying.Passed, notDeploying.Reason = false, fmt.Sprintf("Stack %s.", strings.Join(deploying, ", stack "))
 	}
+	return append(checks, notDeploying), nil
+}
 
-	return nil
+// envTemplateVersion returns the version in the Metadata of the deployed template of the environment.
+// Templates without a version are legacy templates.
+func (o *showAppOpts) envTemplateVersion(env *config.Environment) (string, error) {
+	getter, err := o.newTemplateGetter(env)
+	if err != nil {
+		return "", fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+	}
+	stackName := stack.NameForEnv(o.name, env.Name)
+	body, err := getter.TemplateBody(stackName)
+	if err != nil {
+		return "", fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	var tpl struct {
+		Metadata struct {
+			Version string `yaml:"Version"`
+		} `yaml:"Metadata"`
+	}
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return "", fmt.Errorf("unmarshal template of stack %s to read its version: %w", stackName, err)
+	}
+	if tpl.Metadata.Version == "" {
+		return deploy.LegacyEnvTemplateVersion, nil
+	}
+	return tpl.Metadata.Version, nil
 }
 
-// Ask asks for fields that are required but not passed in.
-func (o *showAppOpts) Ask() error {
-	if err := o.askName(); err != nil {
-		return err
+// comparedSvcs returns the type and the container images of the services deployed in the environment.
+func (o *showAppOpts) comparedSvcs(env *config.Environment, svcs []*config.Workload) ([]*describe.ComparedService, error) {
+	deployed, err := o.deployedSvcs(env, svcs)
+	if err != nil {
+		return nil, err
+	}
+	var compared []*describe.ComparedService
+	for _, svc := range deployed {
+		comparedSvc := &describe.ComparedService{
+			Name: svc.Name,
+			Type: svc.Type,
+		}
+		compared = append(compared, comparedSvc)
+		// App Runner services don't have task definitions.
+		if svc.Type == manifest.RequestDrivenWebServiceType {
+			continue
+		}
+		taskDef, err := o.taskDefinition(env, svc.Name)
+		if err != nil {
+			return nil, err
+		}
+		comparedSvc.Images = make(map[string]string)
+		for _, image := range taskDef.Images() {
+			comparedSvc.Images[image.Container] = image.Image
+		}
 	}
+	return compared, nil
+}
 
-	return nil
+// startPhase starts timing a phase of the command if --benchmark is on. The returned function stops the timer.
+func (o *showAppOpts) startPhase(name string) (done func()) {
+	if !o.shouldBenchmark {
+		return func() {}
+	}
+	start := o.now()
+	return func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		o.phases = append(o.phases, phaseTiming{name: name, duration: o.now().Sub(start)})
+	}
 }
 
-// Execute writes the application's description.
-func (o *showAppOpts) Execute() error {
-	description, err := o.description()
+// listChoices writes the applications that the selector would prompt for as a JSON array.
+func (o *showAppOpts) listChoices() error {
+	choices, err := o.appChoices.ApplicationChoices()
 	if err != nil {
-		return err
+		return fmt.Errorf("list application choices: %w", err)
 	}
-	if !o.shouldOutputJSON {
-		fmt.Fprint(o.w, description.HumanString())
-		return nil
+	if choices == nil {
+		choices = []string{}
 	}
-	data, err := description.JSONString()
+	data, err := json.Marshal(choices)
 	if err != nil {
-		return fmt.Errorf("get JSON string: %w", err)
+		return fmt.Errorf("marshal application choices: %w", err)
 	}
-	fmt.Fprint(o.w, data)
+	fmt.Fprintf(o.w, "%s\n", data)
 	return nil
 }
 
+// checkExists returns nil if the application exists, and makes the command exit with exitCodeAppNotExist otherwise.
+// Nothing is written, and the application is retrieved only once.
+func (o *showAppOpts) checkExists() error {
+	_, err := o.store.GetApplication(o.name)
+	if err == nil {
+		return nil
+	}
+	var noSuchAppErr *config.ErrNoSuchApplication
+	if errors.As(err, &noSuchAppErr) {
+		return &ErrSilentExit{Code: exitCodeAppNotExist}
+	}
+	return fmt.Errorf("get application %s: %w", o.name, err)
+}
+
+type errStrictWarnings struct {
+	count int
+}
+
+func (e *errStrictWarnings) Error() string {
+	if e.count == 1 {
+		return "found 1 warning with --strict"
+	}
+	return fmt.Sprintf("found %d warnings with --strict", e.count)
+}
+
+// appShowIncompleteError is the structured error of --strict-json, written to the diagnostics in place of the output.
+type appShowIncompleteError struct {
+	Error            string   `json:"error"`
+	App              string   `json:"app"`
+	IncompleteFields []string `json:"incompleteFields,omitempty"` // Paths of the fields of the json output, see describe.App.IncompleteFields.
+	PartialErrors    []string `json:"partialErrors,omitempty"`    // Warnings about the data that couldn't be retrieved.
+}
+
+type errIncompleteDescription struct {
+	fields int
+	errors int
+}
+
+func (e *errIncompleteDescription) Error() string {
+	return fmt.Sprintf("found %s and %s with --%s", english.Plural(e.fields, "incomplete field", ""), english.Plural(e.errors, "partial error", ""), strictJSONFlag)
+}
+
+type errBaselineDiffers struct {
+	path  string
+	count int
+}
+
+func (e *errBaselineDiffers) Error() string {
+	if e.count == 1 {
+		return fmt.Sprintf("1 field differs from baseline %s", e.path)
+	}
+	return fmt.Sprintf("%d fields differ from baseline %s", e.count, e.path)
+}
+
+type errValidateOnlyProblems struct {
+	count int
+}
+
+func (e *errValidateOnlyProblems) Error() string {
+	if e.count == 1 {
+		return fmt.Sprintf("found 1 problem of severity %s with --%s", describe.WarningSeverityError, validateOnlyFlag)
+	}
+	return fmt.Sprintf("found %d problems of severity %s with --%s", e.count, describe.WarningSeverityError, validateOnlyFlag)
+}
+
+type errPromotionCheckFailed struct {
+	env    string
+	failed int
+	total  int
+}
+
+func (e *errPromotionCheckFailed) Error() string {
+	return fmt.Sprintf("environment %s failed %d of %d checks with --%s", e.env, e.failed, e.total, promotionCheckFlag)
+}
+
+type errDiagnosticsFailed struct {
+	failed int
+	total  int
+}
+
+func (e *errDiagnosticsFailed) Error() string {
+	return fmt.Sprintf("%d of %d checks failed with --%s", e.failed, e.total, doctorFlag)
+}
+
+type errFailOnWarnings struct {
+	severity string
+	count    int
+}
+
+func (e *errFailOnWarnings) Error() string {
+	if e.count == 1 {
+		return fmt.Sprintf("found 1 warning of severity %s or higher with --%s", e.severity, failOnFlag)
+	}
+	return fmt.Sprintf("found %d warnings of severity %s or higher with --%s", e.count, e.severity, failOnFlag)
+}
+
+// warnf records a non-fatal advisory of the given severity to surface in the application's description.
+func (o *showAppOpts) warnf(severity, format string, args ...interface{}) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.warnings = append(o.warnings, &describe.AppWarning{
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// partialf records a warning like warnf about data that couldn't be retrieved, which makes the description partial.
+func (o *showAppOpts) partialf(severity, format string, args ...interface{}) {
+	o.warnf(severity, format, args...)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.partial = append(o.partial, fmt.Sprintf(format, args...))
+}
+
+// markFailing records that the service is unhealthy in the environment. An empty svc marks the whole environment.
+func (o *showAppOpts) markFailing(env, svc string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.failing[workloadInEnv{env: env, workload: svc}] = true
+}
+
 func (o *showAppOpts) description() (*describe.App, error) {
+	o.warnings = nil
+	o.partial = nil
+	o.envStacks = make(map[string][]cloudformation.StackDescription)
+	o.failing = make(map[workloadInEnv]bool)
+	o.unreachable = make(map[string]bool)
+	o.taskDefs = make(map[workloadInEnv]*awsecs.TaskDefinition)
+	o.resources = make(map[workloadInEnv][]*cloudformation.StackResource)
+	o.stackListings = 0
+	done := o.startPhase("read config store")
+	var envs []*config.Environment
+	var svcs []*config.Workload
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
-		return nil, fmt.Errorf("get application %s: %w", o.name, err)
+		err = fmt.Errorf("get application %s: %w", o.name, err)
+	} else {
+		app = o.validateAppRecord(app)
+		envs, svcs, err = o.envsAndServices()
 	}
-	envs, err := o.store.ListEnvironments(o.name)
-	if err != nil {
-		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	var derived bool
+	if err != nil && o.allowStackFallback && isTransientErr(err) {
+		app, envs, svcs, err = o.inventoryFromStacks(err)
+		derived = true
 	}
-	svcs, err := o.store.ListServices(o.name)
 	if err != nil {
-		return nil, fmt.Errorf("list services in application %s: %w", o.name, err)
+		return nil, err
 	}
+	owner := o.owner(app)
+	done()
+	if err := o.checkSessionRegion(envs); err != nil {
+		return nil, err
+	}
+	o.checkEnvNameCollisions(envs)
 
-	pipelines, err := o.pipelineSvc.GetPipelinesByTags(map[string]string{
-		deploy.AppTagKey: o.name,
+	// The sections are resolved concurrently, and each is delivered as soon as it's resolved so that a slow one,
+	// like the pipelines with many builds, doesn't hold back the others.
+	var pipelines []*codepipeline.Pipeline
+	var trimmedEnvs []*config.Environment
+	var stackSetEnvs []string
+	var trimmedSvcs []*config.Workload
+	var notDeployed []string
+	var sections errgroup.Group
+	sections.Go(func() error {
+		if !o.noPipelines {
+			done := o.startPhase("list pipelines")
+			var err error
+			pipelines, err = o.pipelineSvc.GetPipelinesByTags(map[string]string{
+				deploy.AppTagKey: o.name,
+			})
+			if err != nil {
+				return fmt.Errorf("list pipelines in application %s: %w", o.name, err)
+			}
+			o.resolveConnections(pipelines)
+			o.resolveBuilds(pipelines)
+			done()
+		}
+		o.deliverSection(describe.AppSectionPipelines, &describe.App{
+			Name:             app.Name,
+			Pipelines:        pipelines,
+			PipelinesSkipped: o.noPipelines,
+		})
+		return nil
+	})
+	sections.Go(func() error {
+		for _, env := range envs {
+			trimmedEnvs = append(trimmedEnvs, &config.Environment{
+				Name:      env.Name,
+				AccountID: env.AccountID,
+				Region:    env.Region,
+				Prod:      env.Prod,
+			})
+		}
+		// The instances of the stack set are listed, but never looked up like the environments of the config store.
+		if o.stackSetName != "" {
+			done := o.startPhase("list stack set instances")
+			instanceEnvs, err := o.stackSetEnvs(envs)
+			if err != nil {
+				return err
+			}
+			for _, env := range instanceEnvs {
+				trimmedEnvs = append(trimmedEnvs, env)
+				stackSetEnvs = append(stackSetEnvs, env.Name)
+			}
+			done()
+		}
+		o.deliverSection(describe.AppSectionEnvironments, &describe.App{
+			Name: app.Name,
+			Envs: trimmedEnvs,
+		})
+		return nil
+	})
+	sections.Go(func() error {
+		for _, svc := range svcs {
+			trimmedSvcs = append(trimmedSvcs, &config.Workload{
+				Name: svc.Name,
+				Type: svc.Type,
+			})
+		}
+		// The services that are only in the workspace are listed, but never looked up in the environments.
+		for _, svc := range o.workspaceOnlySvcs(svcs) {
+			trimmedSvcs = append(trimmedSvcs, svc)
+			notDeployed = append(notDeployed, svc.Name)
+		}
+		o.deliverSection(describe.AppSectionServices, &describe.App{
+			Name:        app.Name,
+			Services:    trimmedSvcs,
+			NotDeployed: notDeployed,
+		})
+		return nil
 	})
+	if err := sections.Wait(); err != nil {
+		return nil, err
+	}
+	dependencies := o.dependencies(svcs)
+	crossAccountRefs := o.crossAccountRefs(envs, svcs)
+	done = o.startPhase("describe deployments")
+	o.batchStacks(envs)
+	deployments := o.deployments(app, envs, svcs)
+	envStatuses := o.envStatuses(envs)
+	namespaces := o.envNamespaces(envs)
+	var envTags map[string]map[string]string
+	if o.shouldShowTags {
+		envTags = o.envTags(envs, app.Tags)
+	}
+	done()
+	// Every call to the environments whose account is unreachable fails, or hangs until it times out,
+	// so they're no longer looked up.
+	reachableEnvs := o.reachableEnvs(envs)
+	manuallyDeployed := o.manuallyDeployed(svcs, pipelines, deployments)
+	pipelineStages := o.pipelineStages(envs, svcs, pipelines)
+	o.markDanglingEnvs(envs, pipelines)
+	if o.shouldCheckTopology {
+		o.checkTopology(envs)
+	}
+	if o.shouldCheckDrift {
+		done = o.startPhase("check drift")
+		o.drift(reachableEnvs, deployments)
+		done()
+	}
+	if o.shouldDiffLastDeploy {
+		done = o.startPhase("compare with the previous deployments")
+		o.sinceLastDeploy(reachableEnvs, deployments)
+		done()
+	}
+	var envLastDeployedAt map[string]time.Time
+	if o.sortEnvs == describe.EnvSortRecency {
+		envLastDeployedAt = o.envLastDeployedAt(envs)
+	}
+	if o.shouldShowLogging {
+		done = o.startPhase("look up logging")
+		o.logging(reachableEnvs, deployments)
+		done()
+	}
+	var lastDeployedBy map[string]string
+	if o.shouldShowDeployers {
+		done = o.startPhase("look up deployers")
+		lastDeployedBy = o.lastDeployedBy(reachableEnvs)
+		done()
+	}
+	var secrets []*describe.AppSecret
+	if o.shouldShowSecrets {
+		done = o.startPhase("list secrets")
+		secrets, err = o.secrets(reachableEnvs, svcs)
+		if err != nil {
+			return nil, err
+		}
+		done()
+	}
+	if o.includeTemplates {
+		done = o.startPhase("write stack templates")
+		if err := o.writeTemplates(reachableEnvs); err != nil {
+			return nil, err
+		}
+		done()
+	}
+	var appRunnerSvcs []*describe.AppRunnerService
+	var artifactBuckets []*describe.AppArtifactBucket
+	var serviceConnect []*describe.AppServiceConnect
+	var terminationProtection map[string]bool
+	var allocation *describe.AppAllocation
+	if o.shouldOutputResources {
+		done = o.startPhase("describe App Runner services")
+		appRunnerSvcs, err = o.appRunnerServices(reachableEnvs, svcs)
+		if err != nil {
+			return nil, err
+		}
+		done()
+		allocation = o.allocation(deployments, appRunnerSvcs)
+		o.endpoints(svcs, deployments, appRunnerSvcs)
+		done = o.startPhase("list alarms")
+		o.alarms(reachableEnvs, svcs, deployments)
+		done()
+		done = o.startPhase("look up storage")
+		o.storage(reachableEnvs, deployments)
+		done()
+		done = o.startPhase("look up deployment controllers")
+		o.deploymentControllers(reachableEnvs, deployments)
+		done()
+		o.offlineServices(deployments, appRunnerSvcs)
+		done = o.startPhase("read Service Connect configurations")
+		serviceConnect = o.serviceConnect(reachableEnvs, deployments)
+		done()
+		done = o.startPhase("look up web ACLs")
+		o.webACLs(reachableEnvs, svcs, deployments)
+		done()
+		done = o.startPhase("list artifact buckets")
+		artifactBuckets = o.artifactBuckets(app, envs)
+		o.pipelineArtifactStores(pipelines)
+		done()
+		done = o.startPhase("look up termination protection")
+		terminationProtection = o.terminationProtection(reachableEnvs)
+		done()
+	}
+	domainConflicts := o.domainConflicts(app, svcs, deployments, appRunnerSvcs)
+	insecureEndpoints := o.insecureEndpoints(envs, svcs, deployments)
+	var jobs []*describe.AppJob
+	if o.shouldIncludeJobRuns {
+		done = o.startPhase("list job runs")
+		jobs, err = o.jobRuns(reachableEnvs)
+		if err != nil {
+			return nil, err
+		}
+		done()
+	}
+	var costControls *describe.AppCostControls
+	if o.shouldShowCosts {
+		done = o.startPhase("list cost controls")
+		costControls = o.costControls(app)
+		done()
+	}
+	if o.shouldValidateOnly {
+		o.checkConsistency(envs, svcs)
+	}
+	var appTags map[string]string
+	if o.shouldShowTags && len(app.Tags) != 0 {
+		appTags = app.Tags
+	}
+	description := &describe.App{
+		Name:                  app.Name,
+		URI:                   app.Domain,
+		Owner:                 owner,
+		Tags:                  appTags,
+		Envs:                  trimmedEnvs,
+		Services:              trimmedSvcs,
+		Pipelines:             pipelines,
+		PipelinesSkipped:      o.noPipelines,
+		PipelineStages:        pipelineStages,
+		Secrets:               secrets,
+		Dependencies:          dependencies,
+		CrossAccountRefs:      crossAccountRefs,
+		DomainConflicts:       domainConflicts,
+		InsecureEndpoints:     insecureEndpoints,
+		EnvStatuses:           envStatuses,
+		Namespaces:            namespaces,
+		EnvTags:               envTags,
+		LastDeployedBy:        lastDeployedBy,
+		Deployments:           deployments,
+		Allocation:            allocation,
+		AppRunnerServices:     appRunnerSvcs,
+		ServiceConnect:        serviceConnect,
+		ArtifactBuckets:       artifactBuckets,
+		TerminationProtection: terminationProtection,
+		NotDeployed:           notDeployed,
+		StackSetEnvs:          stackSetEnvs,
+		ManuallyDeployed:      manuallyDeployed,
+		Jobs:                  jobs,
+		CostControls:          costControls,
+		DerivedFromStacks:     derived,
+		WorkspaceCommit:       o.wsCommit,
+		EnvLastDeployedAt:     envLastDeployedAt,
+		Warnings:              o.warnings,
+	}
+	if o.shouldScoreHealth {
+		weights := describe.DefaultHealthWeights()
+		if o.healthWeights != nil {
+			weights = *o.healthWeights
+		}
+		description.HealthScore = description.ScoreHealth(weights, o.now())
+	}
+	return description, nil
+}
+
+// DescribeWithSections returns the description of the application like the command does, and calls onSection
+// with each of its sections as soon as it's resolved so that they can be rendered before the whole description is.
+// See describe.AppSectionHandler for the ordering guarantees.
+func (o *showAppOpts) DescribeWithSections(onSection describe.AppSectionHandler) (*describe.App, error) {
+	o.onSection = onSection
+	defer func() { o.onSection = nil }()
+	return o.description()
+}
+
+// deliverSection calls the section handler, if any, with the resolved section.
+func (o *showAppOpts) deliverSection(section describe.AppSection, partial *describe.App) {
+	if o.onSection == nil {
+		return
+	}
+	// The handler is never called concurrently, so that it can render the sections without its own synchronization.
+	o.sectionMu.Lock()
+	defer o.sectionMu.Unlock()
+	o.onSection(section, partial)
+}
+
+// validateAppRecord warns about the required fields of the application record that are missing or malformed.
+// It returns a copy of the record without the malformed fields so that only the valid ones are rendered.
+func (o *showAppOpts) validateAppRecord(app *config.Application) *config.Application {
+	valid := *app
+	if valid.Name == "" {
+		o.warnf(describe.WarningSeverityWarning, fmtAppRecordMissingField, o.name, "name")
+		valid.Name = o.name
+	}
+	if valid.AccountID == "" {
+		o.warnf(describe.WarningSeverityWarning, fmtAppRecordMissingField, o.name, "account")
+	} else if !accountIDRegexp.MatchString(valid.AccountID) {
+		o.warnf(describe.WarningSeverityWarning, fmtAppRecordMalformedField, o.name, "account", valid.AccountID)
+	}
+	if valid.Version == "" {
+		o.warnf(describe.WarningSeverityWarning, fmtAppRecordMissingField, o.name, "version")
+	}
+	// An empty domain is valid: the application doesn't have a custom domain.
+	if valid.Domain != "" && validateDomainName(valid.Domain) != nil {
+		o.warnf(describe.WarningSeverityWarning, fmtAppRecordMalformedField, o.name, "domain", valid.Domain)
+		valid.Domain = ""
+	}
+	return &valid
+}
+
+// commitWorkspace reads the services of the workspace at a commit with git instead of from the working tree.
+type commitWorkspace struct {
+	git    runner
+	dir    string // Root of the workspace, that contains the copilot directory.
+	commit string
+}
 
+// Summary returns the summary of the workspace at the commit.
+func (ws *commitWorkspace) Summary() (*workspace.Summary, error) {
+	content, err := ws.show(workspace.SummaryFileName)
 	if err != nil {
-		return nil, fmt.Errorf("list pipelines in application %s: %w", o.name, err)
+		return nil, err
+	}
+	var summary workspace.Summary
+	if err := yaml.Unmarshal(content, &summary); err != nil {
+		return nil, fmt.Errorf("unmarshal workspace summary at commit %s: %w", ws.commit, err)
 	}
+	return &summary, nil
+}
 
-	var trimmedEnvs []*config.Environment
-	for _, env := range envs {
-		trimmedEnvs = append(trimmedEnvs, &config.Environment{
-			Name:      env.Name,
-			AccountID: env.AccountID,
-			Region:    env.Region,
-			Prod:      env.Prod,
-		})
+// ServiceNames returns the names of the services of the workspace at the commit. The directories without a manifest,
+// or with the manifest of a job, are skipped like in the working tree.
+func (ws *commitWorkspace) ServiceNames() ([]string, error) {
+	var stdout, stderr bytes.Buffer
+	args := []string{"-C", ws.dir, "ls-tree", "-d", "--name-only", ws.commit, "--", "./" + workspace.CopilotDirName + "/"}
+	if err := ws.git.Run("git", args, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return nil, fmt.Errorf("list the directories of %s at commit %s: %w", workspace.CopilotDirName, ws.commit, err)
 	}
-	var trimmedSvcs []*config.Workload
-	for _, svc := range svcs {
-		trimmedSvcs = append(trimmedSvcs, &config.Workload{
-			Name: svc.Name,
-			Type: svc.Type,
-		})
+	isSvcType := make(map[string]bool)
+	for _, svcType := range manifest.ServiceTypes {
+		isSvcType[svcType] = true
+	}
+	var names []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		name := path.Base(line)
+		content, err := ws.ReadServiceManifest(name)
+		if err != nil {
+			continue
+		}
+		var mft manifest.Workload
+		if err := yaml.Unmarshal(content, &mft); err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of workload %s at commit %s: %w", name, ws.commit, err)
+		}
+		if isSvcType[aws.StringValue(mft.Type)] {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// ReadServiceManifest returns the content of the manifest of the service at the commit.
+func (ws *commitWorkspace) ReadServiceManifest(name string) ([]byte, error) {
+	content, err := ws.show(path.Join(name, wsManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest file: %w", name, err)
+	}
+	return content, nil
+}
+
+// show returns the content of the file of the copilot directory at the commit.
+func (ws *commitWorkspace) show(name string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	object := fmt.Sprintf("%s:./%s", ws.commit, path.Join(workspace.CopilotDirName, name))
+	if err := ws.git.Run("git", []string{"-C", ws.dir, "show", object}, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return nil, fmt.Errorf("read %s at commit %s: %w", path.Join(workspace.CopilotDirName, name), ws.commit, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// newAppCache returns the cache of the application names of the store, keyed by the access key and region of its session
+// so that the names of another account or region are never selected from. It returns nil if there is no cache directory.
+func newAppCache(fs afero.Fs, cacheDir string, storeSess *session.Session) *selector.AppCache {
+	if cacheDir == "" {
+		return nil
+	}
+	creds, err := storeSess.Config.Credentials.Get()
+	if err != nil {
+		return nil
 	}
-	return &describe.App{
-		Name:      app.Name,
-		URI:       app.Domain,
-		Envs:      trimmedEnvs,
-		Services:  trimmedSvcs,
-		Pipelines: pipelines,
-	}, nil
+	key := fmt.Sprintf("%s/%s", creds.AccessKeyID, aws.StringValue(storeSess.Config.Region))
+	return selector.NewAppCache(fs, filepath.Join(cacheDir, "copilot", "apps.json"), key, appShowAppCacheTTL)
+}
+
+// storeSession returns the session to read the config store with, in the region of --store-region if it's set
+// and in the region of the default session otherwise. The environments' resources are read in their own regions.
+func storeSession(provider regionalSessionProvider, defaultSession *session.Session, region string) (*session.Session, error) {
+	if region == "" {
+		return defaultSession, nil
+	}
+	sess, err := provider.DefaultWithRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("create session in store region %s: %w", region, err)
+	}
+	return sess, nil
+}
+
+// envSession returns a session that can make calls against the environment's account and region.
+// If the environment is mapped to a named profile, the profile's credentials are used instead of
+// assuming the environment manager role.
+func (o *showAppOpts) envSession(env *config.Environment) (*session.Session, error) {
+	if profile, ok := o.envProfiles[env.Name]; ok {
+		sess, err := o.sessProvider.FromProfile(profile)
+		if err != nil {
+			return nil, fmt.Errorf("create session from profile %s for environment %s: %w", profile, env.Name, err)
+		}
+		return sess.Copy(&aws.Config{Region: aws.String(env.Region)}), nil
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+	}
+	return sess, nil
 }
 
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
 	}
-	name, err := o.sel.Application(appShowNamePrompt, appShowNameHelpPrompt)
+	if o.shouldSelectFirst {
+		return o.selectOnlyApp()
+	}
+	if len(o.nameMatches) != 0 {
+		name, err := o.sel.ApplicationFrom(o.namePrompt, o.nameHelpPrompt, o.nameMatches)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.name = name
+		return nil
+	}
+	name, err := o.sel.Application(o.namePrompt, o.nameHelpPrompt)
 	if err != nil {
 		return fmt.Errorf("select application: %w", err)
 	}
@@ -158,36 +2394,169 @@ func (o *showAppOpts) askName() error {
 	return nil
 }
 
+// selectOnlyApp sets the name to the only application that can be selected, or among the applications matching
+// a partial name, without prompting. It returns an error if there are none or several of them.
+func (o *showAppOpts) selectOnlyApp() error {
+	choices := o.nameMatches
+	if len(choices) == 0 {
+		var err error
+		choices, err = o.appChoices.ApplicationChoices()
+		if err != nil {
+			return fmt.Errorf("list application choices: %w", err)
+		}
+	}
+	switch len(choices) {
+	case 0:
+		return fmt.Errorf("--%s requires exactly one application to select, found none", firstFlag)
+	case 1:
+		o.name = choices[0]
+		return nil
+	}
+	return fmt.Errorf("--%s requires exactly one application to select, found %d: %s", firstFlag, len(choices), strings.Join(choices, ", "))
+}
+
 // buildAppShowCmd builds the command for showing details of an application.
 func buildAppShowCmd() *cobra.Command {
 	vars := showAppVars{}
 	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "Shows info about an application.",
-		Long:  "Shows configuration, environments and services for an application.",
+		Long: `Shows configuration, environments and services for an application.
+The COPILOT_OUTPUT (json or human), COPILOT_APP and COPILOT_NO_COLOR environment variables
+set the default values of --json, --name and --no-color. Flags on the command line always take precedence.
+The AWS configuration and credentials are read from $AWS_CONFIG_FILE and $AWS_SHARED_CREDENTIALS_FILE if they're set.`,
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Prints the applications that can be selected as a JSON array
+  /code $ copilot app show --list-only
+  Shows where each value of the application "my-app" is retrieved from
+  /code $ copilot app show -n my-app --explain
+  Copies the description of the application "my-app" to the clipboard
+  /code $ copilot app show -n my-app --clipboard
+  Browses the description of an application with many services one screen at a time
+  /code $ copilot app show -n my-app --page
+  Shows only the unhealthy environments and services of the application "my-app"
+  /code $ copilot app show -n my-app --only-failing
+  Compares the services deployed in the "test" and "prod" environments
+  /code $ copilot app show -n my-app --compare-env test,prod
+  Checks that the "prod" environment is ready for the services of "staging" to be promoted to it
+  /code $ copilot app show -n my-app --promotion-check staging,prod
+  Checks the credentials, the clock and the permissions that app show needs to describe "my-app"
+  /code $ copilot app show -n my-app --doctor`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newShowAppOpts(vars)
+			if err := defaultFlagsFromEnv(cmd.Flags(), os.LookupEnv, appShowEnvFlagDefaults); err != nil {
+				return err
+			}
+			ctx, stop := interruptContext()
+			defer stop()
+			opts, err := newShowAppOpts(vars, withChangedFlags(cmd.Flags()), withContext(ctx))
 			if err != nil {
 				return err
 			}
+			opts.isMaxWidthSet = cmd.Flags().Changed(maxWidthFlag)
+			if opts.noColor {
+				color.Disable()
+			}
+			defer opts.closeErrorsTo()
 			if err := opts.Validate(); err != nil {
-				return err
+				return opts.routeError(err)
 			}
 			if err := opts.Ask(); err != nil {
-				return err
+				return opts.routeError(err)
 			}
 			if err := opts.Execute(); err != nil {
-				return err
+				return opts.routeError(err)
+			}
+			if actions := opts.RecommendedActions(); len(actions) != 0 {
+				log.Infoln()
+				log.Infoln("Recommended follow-up actions:")
+				for _, followUp := range actions {
+					log.Infof("- %s\n", followUp)
+				}
 			}
-
 			return nil
 		}),
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, appResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowSecrets, showSecretsFlag, false, showSecretsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowTags, showTagsFlag, false, appShowTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.isVerbose, verboseFlag, false, appVerboseFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowDeployers, showDeployersFlag, false, appShowDeployersFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowLogging, showLoggingFlag, false, appShowLoggingFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowDashboard, dashboardFlag, false, appDashboardFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldRedact, redactFlag, false, appRedactFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldValidateOnly, validateOnlyFlag, false, appValidateOnlyFlagDescription)
+	cmd.Flags().StringVar(&vars.outputTemplateFile, outputTemplateFileFlag, "", appOutputTemplateFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.noPipelines, noPipelinesFlag, false, appNoPipelinesFlagDescription)
+	cmd.Flags().StringVar(&vars.pipelineSource, pipelineSourceFlag, appShowPipelineSourceCodePipeline, appPipelineSourceFlagDescription)
+	cmd.Flags().StringVar(&vars.profileFromEnv, profileFromEnvFlag, "", profileFromEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.isStrict, strictFlag, false, appStrictFlagDescription)
+	cmd.Flags().BoolVar(&vars.isStrictJSON, strictJSONFlag, false, appStrictJSONFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldListOnly, listOnlyFlag, false, appListOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldPrintSchema, jsonSchemaFlag, false, appJSONSchemaFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCheckExists, existsFlag, false, appExistsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldExplain, explainFlag, false, appExplainFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCopy, clipboardFlag, false, appClipboardFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldPage, pageFlag, false, appPageFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOnlyFailing, onlyFailingFlag, false, appOnlyFailingFlagDescription)
+	cmd.Flags().BoolVar(&vars.noColor, noColorFlag, false, noColorFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.compareEnvs, compareEnvFlag, nil, appCompareEnvFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.promotionCheck, promotionCheckFlag, nil, appPromotionCheckFlagDescription)
+	cmd.Flags().StringVar(&vars.awsConfigFile, awsConfigFlag, "", appAWSConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.credentialsFile, credentialsFileFlag, "", appCredentialsFileFlagDescription)
+	cmd.Flags().StringVar(&vars.caBundle, caBundleFlag, "", appCABundleFlagDescription)
+	cmd.Flags().StringVar(&vars.storeRegion, storeRegionFlag, "", appStoreRegionFlagDescription)
+	cmd.Flags().StringVar(&vars.storeEndpoint, storeEndpointFlag, "", appStoreEndpointFlagDescription)
+	cmd.Flags().IntVar(&vars.maxRetries, maxRetriesFlag, sessions.DefaultMaxRetries, appMaxRetriesFlagDescription)
+	cmd.Flags().DurationVar(&vars.retryBaseDelay, retryBaseDelayFlag, sessions.DefaultRetryBaseDelay, appRetryBaseDelayFlagDescription)
+	cmd.Flags().IntVar(&vars.retryOnEmpty, retryOnEmptyFlag, 0, appRetryOnEmptyFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldRunSerially, serialFlag, false, appSerialFlagDescription)
+	cmd.Flags().IntVar(&vars.parallelEnvs, parallelEnvsFlag, 0, appParallelEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldBenchmark, benchmarkFlag, false, appBenchmarkFlagDescription)
+	_ = cmd.Flags().MarkHidden(benchmarkFlag)
+	cmd.Flags().BoolVar(&vars.shouldShowFull, fullFlag, false, appFullFlagDescription)
+	cmd.Flags().IntVar(&vars.maxWidth, maxWidthFlag, 0, appMaxWidthFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldPrettyPrint, prettyFlag, false, appPrettyFlagDescription)
+	cmd.Flags().StringVar(&vars.auditLog, auditLogFlag, "", appAuditLogFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldIncludeJobRuns, includeJobRunsFlag, false, appIncludeJobRunsFlagDescription)
+	cmd.Flags().StringVar(&vars.ownerTagKey, ownerTagKeyFlag, defaultOwnerTagKey, appOwnerTagKeyFlagDescription)
+	cmd.Flags().StringVar(&vars.sortEnvs, sortEnvsFlag, describe.EnvSortName, appSortEnvsFlagDescription)
+	cmd.Flags().StringVar(&vars.formatVersion, formatVersionFlag, describe.AppFormatVersionLatest, appFormatVersionFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCheckTopology, checkTopologyFlag, false, appCheckTopologyFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCheckDrift, checkDriftFlag, false, appCheckDriftFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldDiffLastDeploy, sinceLastDeployFlag, false, appSinceLastDeployFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldRefreshCache, refreshCacheFlag, false, appRefreshCacheFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldHintNames, completionHintFlag, true, appCompletionHintFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldAssumeYes, yesFlag, false, appAssumeYesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldAssumeNo, noFlag, false, appAssumeNoFlagDescription)
+	cmd.Flags().StringVar(&vars.stackSetName, stackSetFlag, "", appStackSetFlagDescription)
+	cmd.Flags().StringVar(&vars.diffBaseline, diffBaselineFlag, "", appDiffBaselineFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldSelectFirst, firstFlag, false, appFirstFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldRunDoctor, doctorFlag, false, appDoctorFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCountOnly, countOnlyFlag, false, appCountOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldWatch, watchFlag, false, appWatchFlagDescription)
+	cmd.Flags().DurationVar(&vars.watchInterval, watchIntervalFlag, defaultWatchInterval, appWatchIntervalFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldWatchDiff, watchDiffFlag, false, appWatchDiffFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowStackFallback, stackFallbackFlag, false, appStackFallbackFlagDescription)
+	cmd.Flags().StringVar(&vars.asOfCommit, asOfCommitFlag, "", appAsOfCommitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldScoreHealth, healthScoreFlag, false, appHealthScoreFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowCosts, showCostControlsFlag, false, appShowCostControlsFlagDescription)
+	cmd.Flags().StringVar(&vars.healthWeightsFile, healthWeightsFlag, "", appHealthWeightsFlagDescription)
+	cmd.Flags().StringVar(&vars.contextName, contextFlag, "", appContextFlagDescription)
+	cmd.Flags().StringVar(&vars.fromPipeline, fromPipelineFlag, "", appFromPipelineFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.omitFields, omitFlag, nil, appOmitFlagDescription)
+	cmd.Flags().StringVar(&vars.tee, teeFlag, "", appTeeFlagDescription)
+	cmd.Flags().StringVar(&vars.errorsTo, errorsToFlag, "", appErrorsToFlagDescription)
+	cmd.Flags().BoolVar(&vars.includeTemplates, includeTemplatesFlag, false, appIncludeTemplatesFlagDescription)
+	cmd.Flags().StringVar(&vars.templatesDir, templatesDirFlag, "", appTemplatesDirFlagDescription)
+	cmd.Flags().StringVar(&vars.failOn, failOnFlag, "", appFailOnFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldAuditCalls, auditCallsFlag, false, appAuditCallsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noLegend, noLegendFlag, false, appNoLegendFlagDescription)
+	cmd.Flags().BoolVar(&vars.noHints, noHintsFlag, false, appNoHintsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.outputs, outputFlag, nil, appOutputFlagDescription)
 	return cmd
 }
@@ -0,0 +1,2705 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+// Log drivers of the containers of the services, and the options that hold the name of their log group.
+const (
+	ecsLogDriverAWSLogs    = "awslogs"
+	ecsLogDriverFireLens   = "awsfirelens"
+	ecsAWSLogsGroupOption  = "awslogs-group"
+	ecsFireLensGroupOption = "log_group_name"
+)
+
+// pipelineDeployStagePrefix is the prefix of the names of the stages of a pipeline that deploy to an environment.
+const pipelineDeployStagePrefix = "DeployTo-"
+
+const (
+	fmtSvcTaskDefFamily = "%s-%s-%s"
+
+	appRunnerServiceResourceType = "AWS::AppRunner::Service"
+	ecsServiceResourceType       = "AWS::ECS::Service"
+	alarmResourceType            = "AWS::CloudWatch::Alarm"
+	nestedStackResourceType      = "AWS::CloudFormation::Stack"
+	stateMachineResourceType     = "AWS::StepFunctions::StateMachine"
+
+	defaultOwnerTagKey = "owner"
+
+	topologyMaxRegions = 3
+
+	jobRunsLookback = 7 * 24 * time.Hour
+	maxJobRuns      = 5
+
+	envCertificateLogicalID = "HTTPSCert"
+	certExpiryWarningWindow = 30 * 24 * time.Hour
+	certExpiryUnknown       = "unknown"
+
+	envLoadBalancerLogicalID = "PublicLoadBalancer"
+
+	// Units of the instance configuration of the App Runner services, each worth 1024 CPU units or MiB.
+	appRunnerCPUUnit    = "vCPU"
+	appRunnerMemoryUnit = "GB"
+
+	taskDefUnknown = "unknown"
+
+	// fmtLBWebSvcDomain is the domain of a load balanced service in an environment of an application with a domain,
+	// under the subdomain of the environment.
+	fmtLBWebSvcDomain = "%s.%s.%s.%s"
+
+	fmtStackTemplateFileName = "%s.stack.yml"
+	templatesDirWriteCheck   = ".copilot-write-check"
+)
+
+// regionContinents maps the prefixes of the AWS regions to the continents they're in, to tell the regions that are
+// distant from each other with --check-topology.
+var regionContinents = map[string]string{
+	"us": "North America",
+	"ca": "North America",
+	"sa": "South America",
+	"eu": "Europe",
+	"me": "Middle East",
+	"il": "Middle East",
+	"af": "Africa",
+	"ap": "Asia Pacific",
+	"cn": "Asia Pacific",
+}
+
+// owner returns the value of the owner tag of the application, or describe.AppUnowned if it doesn't have the tag.
+// The applications without an owner are only flagged with --strict.
+func (o *showAppOpts) owner(app *config.Application) string {
+	key := o.ownerTagKey
+	if key == "" {
+		key = defaultOwnerTagKey
+	}
+	if owner := app.Tags[key]; owner != "" {
+		return owner
+	}
+	if o.isStrict {
+		o.warnf(describe.WarningSeverityWarning, "Application %s has no %s tag to tell who owns it", app.Name, key)
+	}
+	return describe.AppUnowned
+}
+
+// resolveConnections sets the provider type and status of the pipelines' source connections.
+// Failing to retrieve a connection is reported as a warning, and so are pending connections as they block deployments.
+func (o *showAppOpts) resolveConnections(pipelines []*codepipeline.Pipeline) {
+	for _, pipeline := range pipelines {
+		if pipeline.Connection == nil {
+			continue
+		}
+		conn, err := o.connections.GetConnection(pipeline.Connection.ARN)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the source connection of pipeline %s: %v", pipeline.Name, err)
+			continue
+		}
+		pipeline.Connection.ProviderType = conn.ProviderType
+		pipeline.Connection.Status = conn.Status
+		if conn.Status == codestarconnections.ConnectionStatusPending {
+			o.warnf(describe.WarningSeverityWarning, "The source connection %s of pipeline %s is PENDING: update it in the AWS console so that the pipeline can be triggered", conn.ARN, pipeline.Name)
+		}
+	}
+}
+
+// resolveBuilds sets the most recent build of the build project of each pipeline, so that a failed build can be opened
+// directly. Failing to retrieve a build is reported as a warning.
+func (o *showAppOpts) resolveBuilds(pipelines []*codepipeline.Pipeline) {
+	for _, pipeline := range pipelines {
+		if pipeline.Build == nil {
+			continue
+		}
+		build, err := o.builds.LastBuild(pipeline)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the last build of pipeline %s: %v", pipeline.Name, err)
+			continue
+		}
+		pipeline.Build.Last = build
+	}
+}
+
+// onlyFailing trims the description down to the environments, services and their resources that are failing.
+// Pipelines and secrets don't have a status, so they're left out.
+func (o *showAppOpts) onlyFailing(description *describe.App) {
+	failingEnvs := make(map[string]bool)
+	failingSvcs := make(map[string]bool)
+	for wl := range o.failing {
+		failingEnvs[wl.env] = true
+		if wl.workload != "" {
+			failingSvcs[wl.workload] = true
+		}
+	}
+	isFailing := func(env, svc string) bool {
+		return o.failing[workloadInEnv{env: env}] || o.failing[workloadInEnv{env: env, workload: svc}]
+	}
+
+	var envs []*config.Environment
+	for _, env := range description.Envs {
+		if failingEnvs[env.Name] {
+			envs = append(envs, env)
+		}
+	}
+	var svcs []*config.Workload
+	for _, svc := range description.Services {
+		if failingSvcs[svc.Name] {
+			svcs = append(svcs, svc)
+		}
+	}
+	var deployments []*describe.AppDeployment
+	for _, deployment := range description.Deployments {
+		if isFailing(deployment.Environment, deployment.Service) {
+			deployments = append(deployments, deployment)
+		}
+	}
+	var appRunnerSvcs []*describe.AppRunnerService
+	for _, svc := range description.AppRunnerServices {
+		if isFailing(svc.Environment, svc.Service) {
+			appRunnerSvcs = append(appRunnerSvcs, svc)
+		}
+	}
+	var dependencies []*describe.ServiceDependencies
+	for _, dependency := range description.Dependencies {
+		if failingSvcs[dependency.Service] {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	envStatuses := make(map[string]string)
+	var lastDeployedBy map[string]string
+	var namespaces map[string]*describe.AppEnvNamespace
+	for _, env := range envs {
+		if status, ok := description.EnvStatuses[env.Name]; ok {
+			envStatuses[env.Name] = status
+		}
+		if namespace, ok := description.Namespaces[env.Name]; ok {
+			if namespaces == nil {
+				namespaces = make(map[string]*describe.AppEnvNamespace)
+			}
+			namespaces[env.Name] = namespace
+		}
+		if deployer, ok := description.LastDeployedBy[env.Name]; ok {
+			if lastDeployedBy == nil {
+				lastDeployedBy = make(map[string]string)
+			}
+			lastDeployedBy[env.Name] = deployer
+		}
+	}
+	description.Envs = envs
+	description.EnvStatuses = envStatuses
+	description.LastDeployedBy = lastDeployedBy
+	description.Namespaces = namespaces
+	description.Services = svcs
+	description.Pipelines = nil
+	description.PipelineStages = nil
+	description.Secrets = nil
+	description.Dependencies = dependencies
+	description.Deployments = deployments
+	description.AppRunnerServices = appRunnerSvcs
+}
+
+// sources returns where the values of the application's description are retrieved from if --explain is on.
+func (o *showAppOpts) sources(description *describe.App) *describe.AppSources {
+	if !o.shouldExplain {
+		return nil
+	}
+	appSource := fmt.Sprintf(fmtAppParamSource, description.Name)
+	sources := &describe.AppSources{
+		Name: describe.Sourced{Value: description.Name, Source: appSource},
+		URI:  describe.Sourced{Value: description.URI, Source: appSource},
+	}
+	for _, env := range description.Envs {
+		sources.Environments = append(sources.Environments, describe.Sourced{
+			Value:  env.Name,
+			Source: fmt.Sprintf(fmtEnvParamSource, description.Name, env.Name),
+		})
+	}
+	for _, svc := range description.Services {
+		sources.Services = append(sources.Services, describe.Sourced{
+			Value:  svc.Name,
+			Source: fmt.Sprintf(fmtSvcParamSource, description.Name, svc.Name),
+		})
+	}
+	for _, pipeline := range description.Pipelines {
+		sources.Pipelines = append(sources.Pipelines, describe.Sourced{
+			Value:  pipeline.Name,
+			Source: fmt.Sprintf(o.pipelineSourceFormat(), pipeline.Name),
+		})
+	}
+	return sources
+}
+
+// pipelineSourceFormat returns the format of the source of a pipeline for --explain.
+func (o *showAppOpts) pipelineSourceFormat() string {
+	if o.pipelineSource == appShowPipelineSourceGitHubActions {
+		return fmtGitHubWorkflowSource
+	}
+	return fmtPipelineSource
+}
+
+// deployments returns the state of the services deployed in each environment.
+// Environments whose stacks can't be listed are reported as warnings instead of failing the description.
+// If the application has a custom domain, the load balanced services also include the expiry of their certificate.
+func (o *showAppOpts) deployments(app *config.Application, envs []*config.Environment, svcs []*config.Workload) []*describe.AppDeployment {
+	isLBWebSvc := make(map[string]bool)
+	isRDWebSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isLBWebSvc[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType
+		isRDWebSvc[svc.Name] = svc.Type == manifest.RequestDrivenWebServiceType
+	}
+	deploymentsPerEnv := make([][]*describe.AppDeployment, len(envs))
+	forEachConcurrently(len(envs), o.maxConcurrency(), func(i int) error {
+		env := envs[i]
+		stacks, err := o.stacks(env)
+		if err != nil && isAccountUnreachableErr(err) {
+			o.partialf(describe.WarningSeverityWarning, fmtEnvAccountUnreachable, env.Name, env.AccountID, err)
+			o.markFailing(env.Name, "")
+			o.mu.Lock()
+			o.unreachable[env.Name] = true
+			o.mu.Unlock()
+			return nil
+		}
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the services deployed in environment %s: %v", env.Name, err)
+			o.markFailing(env.Name, "")
+			return nil
+		}
+		stacksByName := make(map[string]cloudformation.StackDescription)
+		for _, s := range stacks {
+			stacksByName[aws.StringValue(s.StackName)] = s
+		}
+		for _, svc := range svcs {
+			stackName := stack.NameForService(o.name, env.Name, svc.Name)
+			svcStack, ok := stacksByName[stackName]
+			if !ok {
+				continue
+			}
+			status := aws.StringValue(svcStack.StackStatus)
+			if cloudformation.StackStatus(status).RolledBack() {
+				o.warnf(describe.WarningSeverityError, "The last deployment of service %s in environment %s was rolled back: stack %s is in %s", svc.Name, env.Name, stackName, status)
+			}
+			if cloudformation.StackStatus(status).Failure() {
+				o.markFailing(env.Name, svc.Name)
+			}
+			deployment := &describe.AppDeployment{
+				Service:     svc.Name,
+				Environment: env.Name,
+				StackStatus: status,
+			}
+			if o.shouldShowTags {
+				deployment.Tags = stackTags(svcStack, app.Tags)
+			}
+			deploymentsPerEnv[i] = append(deploymentsPerEnv[i], deployment)
+		}
+		for _, deployment := range deploymentsPerEnv[i] {
+			// App Runner services don't have task definitions.
+			if isRDWebSvc[deployment.Service] {
+				deployment.TaskDefinition = describe.TaskDefinitionNotApplicable
+				continue
+			}
+			deployment.TaskDefinition = o.taskDefRevision(env, deployment.Service)
+		}
+		if app.Domain == "" {
+			return nil
+		}
+		var certExpiry string
+		for _, deployment := range deploymentsPerEnv[i] {
+			if !isLBWebSvc[deployment.Service] {
+				continue
+			}
+			if certExpiry == "" {
+				// The certificate of the load balancer is shared by all the services in the environment.
+				certExpiry = o.certExpiry(env)
+			}
+			deployment.CertExpiry = certExpiry
+		}
+		return nil
+	})
+	var deployments []*describe.AppDeployment
+	for _, envDeployments := range deploymentsPerEnv {
+		deployments = append(deployments, envDeployments...)
+	}
+	return deployments
+}
+
+// taskDefRevision returns the family and revision of the active task definition of the service in the environment.
+// Failures to retrieve the task definition are not fatal, the revision is "unknown" instead.
+func (o *showAppOpts) taskDefRevision(env *config.Environment, svc string) string {
+	taskDef, err := o.taskDefinition(env, svc)
+	if err != nil {
+		return taskDefUnknown
+	}
+	return fmt.Sprintf("%s:%d", aws.StringValue(taskDef.Family), aws.Int64Value(taskDef.Revision))
+}
+
+// taskDefinition returns the active task definition of the service in the environment.
+// The task definition is retrieved once per service and environment and reused by the other calls.
+func (o *showAppOpts) taskDefinition(env *config.Environment, svc string) (*awsecs.TaskDefinition, error) {
+	key := workloadInEnv{env: env.Name, workload: svc}
+	o.mu.Lock()
+	taskDef, ok := o.taskDefs[key]
+	o.mu.Unlock()
+	if ok {
+		return taskDef, nil
+	}
+	getter, err := o.newTaskDefGetter(env)
+	if err != nil {
+		return nil, fmt.Errorf("create task definition client for environment %s: %w", env.Name, err)
+	}
+	taskDef, err = getter.TaskDefinition(fmt.Sprintf(fmtSvcTaskDefFamily, o.name, env.Name, svc))
+	if err != nil {
+		return nil, fmt.Errorf("get task definition of service %s in environment %s: %w", svc, env.Name, err)
+	}
+	o.mu.Lock()
+	o.taskDefs[key] = taskDef
+	o.mu.Unlock()
+	return taskDef, nil
+}
+
+// logging sets the logging configuration of the deployments of the services running on Amazon ECS, concurrently.
+// Services whose main container doesn't ship its logs are flagged with a warning.
+func (o *showAppOpts) logging(envs []*config.Environment, deployments []*describe.AppDeployment) {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		// App Runner services don't have task definitions.
+		if deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		env := envsByName[deployment.Environment]
+		deployment.Logging, deployment.LogGroup, deployment.LogRetention = o.loggingConfig(env, deployment.Service)
+		if deployment.Logging == describe.LoggingDisabled {
+			o.warnf(describe.WarningSeverityWarning, "Service %s in environment %s doesn't ship its logs: its main container has no log configuration", deployment.Service, env.Name)
+			o.markFailing(env.Name, deployment.Service)
+		}
+		return nil
+	})
+}
+
+// loggingConfig returns whether the main container of the service ships its logs, and the log group and its retention.
+// The main container is the one named after the service. Failures to retrieve the configuration are not fatal,
+// the values are "unknown" instead.
+func (o *showAppOpts) loggingConfig(env *config.Environment, svc string) (logging, logGroup, retention string) {
+	taskDef, err := o.taskDefinition(env, svc)
+	if err != nil || len(taskDef.ContainerDefinitions) == 0 {
+		return describe.LoggingUnknown, "", describe.LogRetentionUnknown
+	}
+	main := taskDef.ContainerDefinitions[0]
+	for _, container := range taskDef.ContainerDefinitions {
+		if aws.StringValue(container.Name) == svc {
+			main = container
+		}
+	}
+	if main.LogConfiguration == nil {
+		return describe.LoggingDisabled, "", ""
+	}
+	options := main.LogConfiguration.Options
+	switch aws.StringValue(main.LogConfiguration.LogDriver) {
+	case ecsLogDriverAWSLogs:
+		logGroup = aws.StringValue(options[ecsAWSLogsGroupOption])
+	case ecsLogDriverFireLens:
+		logGroup = aws.StringValue(options[ecsFireLensGroupOption])
+	}
+	if logGroup == "" {
+		return describe.LoggingEnabled, "", describe.LogRetentionUnknown
+	}
+	getter, err := o.newLogRetentionGetter(env)
+	if err != nil {
+		return describe.LoggingEnabled, logGroup, describe.LogRetentionUnknown
+	}
+	days, err := getter.LogGroupRetention(logGroup)
+	switch {
+	case err != nil:
+		return describe.LoggingEnabled, logGroup, describe.LogRetentionUnknown
+	case days == 0:
+		return describe.LoggingEnabled, logGroup, describe.LogRetentionNever
+	}
+	return describe.LoggingEnabled, logGroup, fmt.Sprintf("%d days", days)
+}
+
+// alarms sets the CloudWatch alarms of each deployment, defined in the stack of the service or in the stack of its addons.
+// The public-facing services without alarms are flagged unless they're already failing.
+func (o *showAppOpts) alarms(envs []*config.Environment, svcs []*config.Workload, deployments []*describe.AppDeployment) {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	isPublic := make(map[string]bool)
+	for _, svc := range svcs {
+		isPublic[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType || svc.Type == manifest.RequestDrivenWebServiceType
+	}
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		deployment.Alarms, errs[i] = o.serviceAlarms(envsByName[deployment.Environment], deployment.Service)
+		return nil
+	})
+	// The warnings are added once all the alarms are retrieved so that they're in the order of the deployments.
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the alarms of service %s in environment %s: %v", deployment.Service, deployment.Environment, errs[i])
+			continue
+		}
+		// The services that already failed, like the App Runner services that aren't created yet, aren't flagged twice.
+		failing := o.failing[workloadInEnv{env: deployment.Environment, workload: deployment.Service}]
+		if len(deployment.Alarms) == 0 && isPublic[deployment.Service] && !failing {
+			o.warnf(describe.WarningSeverityInfo, "Public-facing service %s in environment %s has no alarms", deployment.Service, deployment.Environment)
+		}
+	}
+}
+
+// storage sets the persistent volumes that the tasks of the deployments of the services running on Amazon ECS mount,
+// concurrently. Stateless services have none.
+func (o *showAppOpts) storage(envs []*config.Environment, deployments []*describe.AppDeployment) {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		// App Runner services don't have task definitions.
+		if deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		taskDef, err := o.taskDefinition(envsByName[deployment.Environment], deployment.Service)
+		if err != nil {
+			errs[i] = err
+			return nil
+		}
+		for _, volume := range taskDef.EFSVolumes() {
+			deployment.Storage = append(deployment.Storage, &describe.AppVolume{
+				Name:          volume.Name,
+				FileSystemID:  volume.FileSystemID,
+				AccessPointID: volume.AccessPointID,
+			})
+		}
+		return nil
+	})
+	// The warnings are added once all the volumes are retrieved so that they're in the order of the deployments.
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the storage of service %s in environment %s: %v", deployment.Service, deployment.Environment, errs[i])
+		}
+	}
+}
+
+// webACLs sets the AWS WAF web ACL associated with the load balancer of each deployment of the Load Balanced Web Services.
+// In strict mode, the public-facing services without a web ACL are flagged.
+// App Runner services can't be associated with a web ACL, so they are skipped.
+func (o *showAppOpts) webACLs(envs []*config.Environment, svcs []*config.Workload, deployments []*describe.AppDeployment) {
+	isLBWebSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isLBWebSvc[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType
+	}
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	// The load balancer is shared by all the services in the environment, so its web ACL is retrieved once.
+	acls := make(map[string]string)
+	for _, deployment := range deployments {
+		if !isLBWebSvc[deployment.Service] {
+			continue
+		}
+		acl, ok := acls[deployment.Environment]
+		if !ok {
+			name, err := o.envWebACL(envsByName[deployment.Environment])
+			switch {
+			case err != nil:
+				o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the web ACL of the load balancer in environment %s: %v", deployment.Environment, err)
+				acl = describe.WebACLUnknown
+			case name == "":
+				acl = describe.WebACLNone
+			default:
+				acl = name
+			}
+			acls[deployment.Environment] = acl
+		}
+		deployment.WebACL = acl
+		if acl == describe.WebACLNone && o.isStrict {
+			o.warnf(describe.WarningSeverityWarning, "Public-facing service %s in environment %s has no WAF web ACL", deployment.Service, deployment.Environment)
+			o.markFailing(deployment.Environment, deployment.Service)
+		}
+	}
+}
+
+// envWebACL returns the name of the web ACL associated with the public load balancer of the environment,
+// or an empty string if it has none.
+func (o *showAppOpts) envWebACL(env *config.Environment) (string, error) {
+	getter, err := o.newStackResourcesGetter(env)
+	if err != nil {
+		return "", fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+	}
+	envStack := stack.NameForEnv(o.name, env.Name)
+	resources, err := getter.StackResources(envStack)
+	if err != nil {
+		return "", fmt.Errorf("get resources of environment stack %s: %w", envStack, err)
+	}
+	var lbARN string
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == envLoadBalancerLogicalID {
+			lbARN = aws.StringValue(resource.PhysicalResourceId)
+		}
+	}
+	if lbARN == "" {
+		return "", fmt.Errorf("environment stack %s has no public load balancer", envStack)
+	}
+	client, err := o.newWebACLGetter(env)
+	if err != nil {
+		return "", fmt.Errorf("create WAF client for environment %s: %w", env.Name, err)
+	}
+	return client.WebACLForResource(lbARN)
+}
+
+// deploymentControllers sets how the deployments of the services running on Amazon ECS are deployed, concurrently.
+// The state of the blue/green deployments is read from the task sets of the ECS service rather than from CodeDeploy.
+func (o *showAppOpts) deploymentControllers(envs []*config.Environment, deployments []*describe.AppDeployment) {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		// App Runner services don't run on Amazon ECS.
+		if deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		svc, err := o.ecsService(envsByName[deployment.Environment], deployment.Service)
+		if err != nil {
+			errs[i] = err
+			deployment.DeploymentController = describe.DeploymentControllerUnknown
+			return nil
+		}
+		deployment.DeploymentController = svc.DeploymentControllerType()
+		deployment.DeploymentState = svc.TaskSetsState()
+		if svc.DesiredCount != nil && aws.Int64Value(svc.DesiredCount) == 0 {
+			deployment.Offline = describe.ServiceScaledToZero
+		}
+		return nil
+	})
+	// The warnings are added once all the services are described so that they're in the order of the deployments.
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the deployment controller of service %s in environment %s: %v", deployment.Service, deployment.Environment, errs[i])
+		}
+	}
+}
+
+// offlineServices marks the deployments of the paused App Runner services as offline, and warns about each deployment
+// that doesn't serve any traffic, including the Amazon ECS services scaled to zero found by deploymentControllers.
+// They're deployed successfully, so they aren't failing.
+func (o *showAppOpts) offlineServices(deployments []*describe.AppDeployment, appRunnerSvcs []*describe.AppRunnerService) {
+	paused := make(map[workloadInEnv]bool)
+	for _, svc := range appRunnerSvcs {
+		if svc.Status == apprunner.ServiceStatusPaused {
+			paused[workloadInEnv{workload: svc.Service, env: svc.Environment}] = true
+		}
+	}
+	for _, deployment := range deployments {
+		if paused[workloadInEnv{workload: deployment.Service, env: deployment.Environment}] {
+			deployment.Offline = describe.ServicePaused
+		}
+		if deployment.Offline != "" {
+			o.warnf(describe.WarningSeverityWarning, "Service %s in environment %s is %s and doesn't serve any traffic", deployment.Service, deployment.Environment, deployment.Offline)
+		}
+	}
+}
+
+// ecsService describes the Amazon ECS service in the stack of the service in the environment.
+func (o *showAppOpts) ecsService(env *config.Environment, svc string) (*awsecs.Service, error) {
+	resources, err := o.svcStackResources(env, svc)
+	if err != nil {
+		return nil, err
+	}
+	var serviceARN awsecs.ServiceArn
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) == ecsServiceResourceType {
+			serviceARN = awsecs.ServiceArn(aws.StringValue(resource.PhysicalResourceId))
+		}
+	}
+	if serviceARN == "" {
+		return nil, fmt.Errorf("stack %s has no Amazon ECS service", stack.NameForService(o.name, env.Name, svc))
+	}
+	cluster, err := serviceARN.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("parse cluster of service %s: %w", serviceARN, err)
+	}
+	name, err := serviceARN.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("parse name of service %s: %w", serviceARN, err)
+	}
+	describer, err := o.newECSServiceDescriber(env)
+	if err != nil {
+		return nil, fmt.Errorf("create ECS client for environment %s: %w", env.Name, err)
+	}
+	return describer.Service(cluster, name)
+}
+
+// serviceConnect returns the Service Connect configuration of the services running on Amazon ECS that enabled it,
+// concurrently. The configuration is read from the deployed template of the service stack.
+func (o *showAppOpts) serviceConnect(envs []*config.Environment, deployments []*describe.AppDeployment) []*describe.AppServiceConnect {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	configs := make([]*describe.AppServiceConnect, len(deployments))
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		// App Runner services don't run on Amazon ECS.
+		if deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		configs[i], errs[i] = o.svcServiceConnect(envsByName[deployment.Environment], deployment.Service)
+		return nil
+	})
+	// The warnings are added once all the templates are read so that they're in the order of the deployments.
+	var enabled []*describe.AppServiceConnect
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the Service Connect configuration of service %s in environment %s: %v", deployment.Service, deployment.Environment, errs[i])
+			continue
+		}
+		if configs[i] != nil {
+			enabled = append(enabled, configs[i])
+		}
+	}
+	return enabled
+}
+
+// svcServiceConnect returns the Service Connect configuration of the Amazon ECS service in the deployed template
+// of the service stack, or nil if it didn't enable Service Connect.
+func (o *showAppOpts) svcServiceConnect(env *config.Environment, svc string) (*describe.AppServiceConnect, error) {
+	getter, err := o.newTemplateGetter(env)
+	if err != nil {
+		return nil, fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+	}
+	stackName := stack.NameForService(o.name, env.Name, svc)
+	body, err := getter.TemplateBody(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	params := map[string]string{
+		"AppName":      o.name,
+		"EnvName":      env.Name,
+		"WorkloadName": svc,
+	}
+	cfg, err := serviceConnectConfig([]byte(body), params)
+	if err != nil {
+		return nil, fmt.Errorf("read Service Connect configuration in template of stack %s: %w", stackName, err)
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	cfg.Service = svc
+	cfg.Environment = env.Name
+	return cfg, nil
+}
+
+// serviceConnectConfig returns the ServiceConnectConfiguration of the Amazon ECS service of the template, or nil if
+// there is none or it's disabled. The references to the parameters are replaced with their values, and the
+// configurations that depend on a condition can't be resolved from the template so they're skipped.
+func serviceConnectConfig(template []byte, params map[string]string) (*describe.AppServiceConnect, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				ServiceConnectConfiguration yaml.Node `yaml:"ServiceConnectConfiguration"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal(template, &tpl); err != nil {
+		return nil, err
+	}
+	for _, resource := range tpl.Resources {
+		node := resource.Properties.ServiceConnectConfiguration
+		if resource.Type != ecsServiceResourceType || node.Kind != yaml.MappingNode || node.Tag != "!!map" {
+			continue
+		}
+		var sc struct {
+			Enabled   yaml.Node `yaml:"Enabled"`
+			Namespace yaml.Node `yaml:"Namespace"`
+			Services  []struct {
+				PortName      yaml.Node `yaml:"PortName"`
+				DiscoveryName yaml.Node `yaml:"DiscoveryName"`
+				ClientAliases []struct {
+					DNSName yaml.Node `yaml:"DnsName"`
+					Port    yaml.Node `yaml:"Port"`
+				} `yaml:"ClientAliases"`
+			} `yaml:"Services"`
+		}
+		if err := node.Decode(&sc); err != nil {
+			return nil, err
+		}
+		if cfnValue(&sc.Enabled, params) != "true" {
+			return nil, nil
+		}
+		cfg := &describe.AppServiceConnect{
+			Namespace: cfnValue(&sc.Namespace, params),
+		}
+		for _, svc := range sc.Services {
+			endpoint := &describe.AppServiceConnectEndpoint{
+				PortName: cfnValue(&svc.PortName, params),
+			}
+			for _, alias := range svc.ClientAliases {
+				// The DNS name of an alias defaults to the discovery name of the port, which defaults to its name.
+				dnsName := cfnValue(&alias.DNSName, params)
+				if dnsName == "" {
+					dnsName = cfnValue(&svc.DiscoveryName, params)
+				}
+				if dnsName == "" {
+					dnsName = endpoint.PortName
+				}
+				endpoint.Aliases = append(endpoint.Aliases, fmt.Sprintf("%s:%s", dnsName, cfnValue(&alias.Port, params)))
+			}
+			cfg.Endpoints = append(cfg.Endpoints, endpoint)
+		}
+		return cfg, nil
+	}
+	return nil, nil
+}
+
+// cfnValue returns the value of a property of a template, either a scalar or a reference to a parameter
+// or a substitution of parameters with the "Ref" and "Fn::Sub" functions or their short forms.
+// The values of the other functions depend on the deployed resources so they're empty.
+func cfnValue(node *yaml.Node, params map[string]string) string {
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 {
+		switch node.Content[0].Value {
+		case "Ref":
+			return params[node.Content[1].Value]
+		case "Fn::Sub":
+			return cfnSub(node.Content[1].Value, params)
+		}
+		return ""
+	}
+	if node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	switch node.Tag {
+	case "!Ref":
+		return params[node.Value]
+	case "!Sub":
+		return cfnSub(node.Value, params)
+	case "!!str", "!!int", "!!bool":
+		return node.Value
+	}
+	return ""
+}
+
+// cfnSub replaces the "${Param}" variables of the string with the values of the parameters.
+func cfnSub(s string, params map[string]string) string {
+	for name, value := range params {
+		s = strings.ReplaceAll(s, fmt.Sprintf("${%s}", name), value)
+	}
+	return s
+}
+
+// serviceAlarms returns the alarms in the resources of the service stack and of its addons stack, sorted by name.
+func (o *showAppOpts) serviceAlarms(env *config.Environment, svc string) ([]*describe.AppAlarm, error) {
+	svcResources, err := o.svcStackResources(env, svc)
+	if err != nil {
+		return nil, err
+	}
+	resources := svcResources
+	for _, resource := range svcResources {
+		if aws.StringValue(resource.LogicalResourceId) != addon.StackName || aws.StringValue(resource.ResourceType) != nestedStackResourceType {
+			continue
+		}
+		resourcesGetter, err := o.newStackResourcesGetter(env)
+		if err != nil {
+			return nil, fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+		}
+		addonsStack := aws.StringValue(resource.PhysicalResourceId)
+		addonsResources, err := resourcesGetter.StackResources(addonsStack)
+		if err != nil {
+			return nil, fmt.Errorf("get resources of addons stack %s: %w", addonsStack, err)
+		}
+		// The resources of the service stack are cached, so they are copied rather than appended to.
+		resources = append(append([]*cloudformation.StackResource{}, svcResources...), addonsResources...)
+		break
+	}
+	var names []string
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) == alarmResourceType && aws.StringValue(resource.PhysicalResourceId) != "" {
+			names = append(names, aws.StringValue(resource.PhysicalResourceId))
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	getter, err := o.newAlarmGetter(env)
+	if err != nil {
+		return nil, fmt.Errorf("create CloudWatch client: %w", err)
+	}
+	statuses, err := getter.AlarmStatus(names)
+	if err != nil {
+		return nil, err
+	}
+	var alarms []*describe.AppAlarm
+	for _, status := range statuses {
+		alarms = append(alarms, &describe.AppAlarm{
+			Name:      status.Name,
+			Metric:    status.Metric,
+			Threshold: status.Threshold,
+		})
+	}
+	sort.Slice(alarms, func(i, j int) bool { return alarms[i].Name < alarms[j].Name })
+	return alarms, nil
+}
+
+// jobRuns returns the recent runs of each job deployed in the environments, from the most to the least recent,
+// up to maxJobRuns of the past jobRunsLookback.
+func (o *showAppOpts) jobRuns(envs []*config.Environment) ([]*describe.AppJob, error) {
+	jobs, err := o.store.ListJobs(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in application %s: %w", o.name, err)
+	}
+	var appJobs []*describe.AppJob
+	var jobEnvs []*config.Environment // Environment of each job in appJobs.
+	for _, env := range envs {
+		deployed, err := o.deployedSvcs(env, jobs)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range deployed {
+			appJobs = append(appJobs, &describe.AppJob{
+				Name:        job.Name,
+				Environment: env.Name,
+			})
+			jobEnvs = append(jobEnvs, env)
+		}
+	}
+	errs := make([]error, len(appJobs))
+	o.forEachPooled(len(appJobs), func(i int) error {
+		job := appJobs[i]
+		job.RecentRuns, errs[i] = o.recentJobRuns(jobEnvs[i], job.Name)
+		return nil
+	})
+	// The warnings are added once all the runs are retrieved so that they're in the order of the jobs.
+	for i, job := range appJobs {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the recent runs of job %s in environment %s: %v", job.Name, job.Environment, errs[i])
+			job.RecentRuns = []*describe.AppJobRun{}
+		}
+	}
+	return appJobs, nil
+}
+
+// recentJobRuns returns the recent executions of the state machine in the stack of the job.
+func (o *showAppOpts) recentJobRuns(env *config.Environment, job string) ([]*describe.AppJobRun, error) {
+	resources, err := o.svcStackResources(env, job)
+	if err != nil {
+		return nil, err
+	}
+	var stateMachineARN string
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) == stateMachineResourceType {
+			stateMachineARN = aws.StringValue(resource.PhysicalResourceId)
+			break
+		}
+	}
+	if stateMachineARN == "" {
+		return []*describe.AppJobRun{}, nil
+	}
+	lister, err := o.newExecutionLister(env)
+	if err != nil {
+		return nil, fmt.Errorf("create Step Functions client: %w", err)
+	}
+	executions, err := lister.RecentExecutions(stateMachineARN, o.now().Add(-jobRunsLookback), maxJobRuns)
+	if err != nil {
+		return nil, err
+	}
+	runs := []*describe.AppJobRun{}
+	for _, execution := range executions {
+		runs = append(runs, &describe.AppJobRun{
+			StartedAt: execution.StartDate,
+			Status:    execution.Status,
+		})
+	}
+	return runs, nil
+}
+
+// terminationProtection returns whether termination protection is enabled on the stack of the application and on the
+// stack of each environment, by stack name, and flags the production environments whose stack isn't protected.
+// The stacks of the environments are already described, so only the stack of the application is described again.
+func (o *showAppOpts) terminationProtection(envs []*config.Environment) map[string]bool {
+	protection := make(map[string]bool)
+	appStack := stack.NameForApp(o.name)
+	descr, err := o.appStacks.Describe(appStack)
+	var notFound *cloudformation.ErrStackNotFound
+	switch {
+	case errors.As(err, &notFound):
+		// There's no stack of the application to protect.
+	case err != nil:
+		o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the termination protection of stack %s: %v", appStack, err)
+	default:
+		protection[appStack] = aws.BoolValue(descr.EnableTerminationProtection)
+	}
+	for _, env := range envs {
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		envStack := stack.NameForEnv(o.name, env.Name)
+		for _, s := range stacks {
+			if aws.StringValue(s.StackName) != envStack {
+				continue
+			}
+			protected := aws.BoolValue(s.EnableTerminationProtection)
+			protection[envStack] = protected
+			if env.Prod && !protected {
+				o.warnf(describe.WarningSeverityWarning, "Termination protection is disabled on stack %s of production environment %s", envStack, env.Name)
+			}
+		}
+	}
+	return protection
+}
+
+// artifactBuckets returns the artifact bucket of each environment, and the buckets of the regions without environments.
+// The number of objects is only an approximation from CloudWatch, and is left out if it can't be retrieved.
+func (o *showAppOpts) artifactBuckets(app *config.Application, envs []*config.Environment) []*describe.AppArtifactBucket {
+	regionalResources, err := o.appResources.GetRegionalAppResources(app)
+	if err != nil {
+		o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the artifact buckets of application %s: %v", o.name, err)
+		return nil
+	}
+	var buckets []*describe.AppArtifactBucket
+	for _, resources := range regionalResources {
+		if resources.S3Bucket == "" {
+			continue
+		}
+		var count *int64
+		counter, err := o.newObjectCounter(resources.Region)
+		if err == nil {
+			n, err := counter.BucketObjectCount(resources.S3Bucket)
+			switch {
+			case err == nil:
+				count = aws.Int64(n)
+			case !errors.Is(err, cloudwatch.ErrNoMetricData):
+				o.partialf(describe.WarningSeverityInfo, "Couldn't retrieve the number of objects in artifact bucket %s: %v", resources.S3Bucket, err)
+			}
+		}
+		var inRegion bool
+		for _, env := range envs {
+			if env.Region != resources.Region {
+				continue
+			}
+			inRegion = true
+			buckets = append(buckets, &describe.AppArtifactBucket{
+				Region:                 resources.Region,
+				Bucket:                 resources.S3Bucket,
+				Environment:            env.Name,
+				ApproximateObjectCount: count,
+			})
+		}
+		if !inRegion {
+			buckets = append(buckets, &describe.AppArtifactBucket{
+				Region:                 resources.Region,
+				Bucket:                 resources.S3Bucket,
+				ApproximateObjectCount: count,
+			})
+		}
+	}
+	return buckets
+}
+
+// pipelineArtifactStores sets the artifact store of each pipeline, with the default encryption and the lifecycle rules
+// of its bucket. The pipelines whose artifacts are encrypted neither by the pipeline nor by the bucket are flagged
+// with a warning. Failures to retrieve the settings are not fatal.
+func (o *showAppOpts) pipelineArtifactStores(pipelines []*codepipeline.Pipeline) {
+	// The GitHub Actions workflows don't store artifacts in the buckets of the application.
+	if o.pipelineSource == appShowPipelineSourceGitHubActions {
+		return
+	}
+	for _, pipeline := range pipelines {
+		store, err := o.artifacts.ArtifactStore(pipeline.Name)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the artifact store of pipeline %s: %v", pipeline.Name, err)
+			continue
+		}
+		pipeline.ArtifactStore = store
+		if store.EncryptionKey != "" {
+			store.Encrypted = aws.Bool(true)
+		}
+		getter, err := o.newBucketConfigGetter(pipeline.Region)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the settings of artifact bucket %s of pipeline %s: %v", store.Bucket, pipeline.Name, err)
+			continue
+		}
+		encryption, err := getter.BucketEncryption(store.Bucket)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the encryption of artifact bucket %s of pipeline %s: %v", store.Bucket, pipeline.Name, err)
+		} else {
+			store.BucketEncryption = encryption
+			store.Encrypted = aws.Bool(store.EncryptionKey != "" || encryption != "")
+		}
+		days, err := getter.BucketExpirationDays(store.Bucket)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't retrieve the lifecycle rules of artifact bucket %s of pipeline %s: %v", store.Bucket, pipeline.Name, err)
+		} else {
+			store.ExpirationDays = days
+		}
+		if store.Encrypted != nil && !*store.Encrypted {
+			o.warnf(describe.WarningSeverityWarning, "The artifacts of pipeline %s are not encrypted: neither the pipeline nor its bucket %s sets an encryption key", pipeline.Name, store.Bucket)
+		}
+	}
+}
+
+// costControls returns the budgets of the account of the application and the cost anomaly monitors that are filtered
+// on the cost allocation tag of the application. The budgets that are over their limit are flagged with a warning.
+// Failures to list them are not fatal, the list is empty instead.
+func (o *showAppOpts) costControls(app *config.Application) *describe.AppCostControls {
+	controls := &describe.AppCostControls{
+		Budgets:         []*describe.AppBudget{},
+		AnomalyMonitors: []*describe.AppAnomalyMonitor{},
+	}
+	tagged, err := o.budgets.BudgetsWithTag(app.AccountID, deploy.AppTagKey, app.Name)
+	if err != nil {
+		o.partialf(describe.WarningSeverityWarning, "Couldn't list the budgets of application %s: %v", app.Name, err)
+	}
+	for _, b := range tagged {
+		budget := &describe.AppBudget{
+			Name:     b.Name,
+			Period:   b.TimeUnit,
+			Unit:     b.Unit,
+			Limit:    b.Limit,
+			Actual:   b.Actual,
+			Forecast: b.Forecast,
+		}
+		controls.Budgets = append(controls.Budgets, budget)
+		if used := budget.UsedPercent(); used != nil && *used >= 100 {
+			o.warnf(describe.WarningSeverityWarning, "Budget %s of application %s is over its limit: %.2f of %.2f %s spent", b.Name, app.Name, b.Actual, b.Limit, b.Unit)
+		}
+	}
+	monitors, err := o.monitors.AnomalyMonitorsWithTag(deploy.AppTagKey, app.Name)
+	if err != nil {
+		o.partialf(describe.WarningSeverityWarning, "Couldn't list the cost anomaly monitors of application %s: %v", app.Name, err)
+	}
+	for _, m := range monitors {
+		controls.AnomalyMonitors = append(controls.AnomalyMonitors, &describe.AppAnomalyMonitor{
+			Name:          m.Name,
+			ARN:           m.ARN,
+			LastEvaluated: m.LastEvaluated,
+		})
+	}
+	return controls
+}
+
+// certExpiry returns the expiry date of the certificate of the environment's load balancer.
+// Failures to resolve the certificate are not fatal, the expiry is "unknown" instead.
+func (o *showAppOpts) certExpiry(env *config.Environment) string {
+	getter, err := o.newStackResourcesGetter(env)
+	if err != nil {
+		return certExpiryUnknown
+	}
+	resources, err := getter.StackResources(stack.NameForEnv(o.name, env.Name))
+	if err != nil {
+		return certExpiryUnknown
+	}
+	var certARN string
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == envCertificateLogicalID {
+			certARN = aws.StringValue(resource.PhysicalResourceId)
+		}
+	}
+	if certARN == "" {
+		return certExpiryUnknown
+	}
+	describer, err := o.newCertDescriber(env)
+	if err != nil {
+		return certExpiryUnknown
+	}
+	expiry, err := describer.CertificateExpiry(certARN)
+	if err != nil {
+		return certExpiryUnknown
+	}
+	if expiry.Sub(o.now()) < certExpiryWarningWindow {
+		o.warnf(describe.WarningSeverityWarning, "The certificate for the custom domain in environment %s expires on %s", env.Name, expiry.Format("2006-01-02"))
+		o.markFailing(env.Name, "")
+	}
+	return expiry.Format(time.RFC3339)
+}
+
+// secrets returns the references to the secrets used by the services deployed in each environment.
+// The values of the secrets are never retrieved.
+func (o *showAppOpts) secrets(envs []*config.Environment, svcs []*config.Workload) ([]*describe.AppSecret, error) {
+	var secrets []*describe.AppSecret
+	for _, env := range envs {
+		deployed, err := o.deployedSvcs(env, svcs)
+		if err != nil {
+			return nil, err
+		}
+		// App Runner services don't have task definitions.
+		deployed = filterWorkloads(deployed, func(svc *config.Workload) bool {
+			return svc.Type != manifest.RequestDrivenWebServiceType
+		})
+		for _, svc := range deployed {
+			taskDef, err := o.taskDefinition(env, svc.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range taskDef.Secrets() {
+				secrets = append(secrets, &describe.AppSecret{
+					Service:     svc.Name,
+					Environment: env.Name,
+					Container:   s.Container,
+					Name:        s.Name,
+					ValueFrom:   s.ValueFrom,
+					Source:      describe.SecretSource(s.ValueFrom),
+				})
+			}
+		}
+	}
+	return secrets, nil
+}
+
+// appRunnerServices returns the App Runner specifics of the Request-Driven Web Services deployed in each environment.
+func (o *showAppOpts) appRunnerServices(envs []*config.Environment, svcs []*config.Workload) ([]*describe.AppRunnerService, error) {
+	rdwsList := filterWorkloads(svcs, func(svc *config.Workload) bool {
+		return svc.Type == manifest.RequestDrivenWebServiceType
+	})
+	if len(rdwsList) == 0 {
+		return nil, nil
+	}
+	var appRunnerSvcs []*describe.AppRunnerService
+	for _, env := range envs {
+		deployed, err := o.deployedSvcs(env, rdwsList)
+		if err != nil {
+			return nil, err
+		}
+		if len(deployed) == 0 {
+			continue
+		}
+		describer, err := o.newAppRunnerDescriber(env)
+		if err != nil {
+			return nil, fmt.Errorf("create App Runner client for environment %s: %w", env.Name, err)
+		}
+		for _, svc := range deployed {
+			resources, err := o.svcStackResources(env, svc.Name)
+			if err != nil {
+				return nil, err
+			}
+			var svcARN string
+			for _, resource := range resources {
+				if aws.StringValue(resource.ResourceType) == appRunnerServiceResourceType {
+					svcARN = aws.StringValue(resource.PhysicalResourceId)
+					break
+				}
+			}
+			if svcARN == "" {
+				o.warnf(describe.WarningSeverityInfo, "App Runner service for %s in environment %s is not created yet", svc.Name, env.Name)
+				o.markFailing(env.Name, svc.Name)
+				continue
+			}
+			appRunnerSvc, err := describer.DescribeService(svcARN)
+			if err != nil {
+				return nil, fmt.Errorf("describe App Runner service %s in environment %s: %w", svc.Name, env.Name, err)
+			}
+			domains := make([]*describe.AppRunnerCustomDomain, len(appRunnerSvc.CustomDomains))
+			for i, domain := range appRunnerSvc.CustomDomains {
+				domains[i] = &describe.AppRunnerCustomDomain{
+					DomainName: domain.DomainName,
+					Status:     domain.Status,
+				}
+			}
+			appRunnerSvcs = append(appRunnerSvcs, &describe.AppRunnerService{
+				Service:       svc.Name,
+				Environment:   env.Name,
+				ServiceARN:    appRunnerSvc.ARN,
+				Status:        appRunnerSvc.Status,
+				URL:           appRunnerSvc.URL,
+				CPU:           allocationValue(appRunnerSvc.CPU, appRunnerCPUUnit),
+				Memory:        allocationValue(appRunnerSvc.Memory, appRunnerMemoryUnit),
+				CustomDomains: domains,
+			})
+		}
+	}
+	return appRunnerSvcs, nil
+}
+
+// allocation sets the CPU and memory of each deployment, from the task definition that was retrieved with the
+// deployments, or from the instance configuration of the App Runner service, and returns their total.
+// No other call is made: a deployment whose allocation wasn't retrieved is unknown.
+func (o *showAppOpts) allocation(deployments []*describe.AppDeployment, appRunnerSvcs []*describe.AppRunnerService) *describe.AppAllocation {
+	appRunnerSvcsByDeployment := make(map[workloadInEnv]*describe.AppRunnerService)
+	for _, svc := range appRunnerSvcs {
+		appRunnerSvcsByDeployment[workloadInEnv{env: svc.Environment, workload: svc.Service}] = svc
+	}
+	total := &describe.AppAllocation{}
+	for _, deployment := range deployments {
+		key := workloadInEnv{env: deployment.Environment, workload: deployment.Service}
+		cpu, memory := describe.AllocationUnknown, describe.AllocationUnknown
+		if deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			if svc, ok := appRunnerSvcsByDeployment[key]; ok {
+				cpu, memory = svc.CPU, svc.Memory
+			}
+		} else {
+			o.mu.Lock()
+			taskDef := o.taskDefs[key]
+			o.mu.Unlock()
+			if taskDef != nil {
+				cpu, memory = allocationValue(aws.StringValue(taskDef.Cpu), ""), allocationValue(aws.StringValue(taskDef.Memory), "")
+			}
+		}
+		deployment.CPU, deployment.Memory = cpu, memory
+		cpuUnits, cpuErr := strconv.Atoi(cpu)
+		mib, memoryErr := strconv.Atoi(memory)
+		if cpuErr != nil || memoryErr != nil {
+			total.Unknown++
+			continue
+		}
+		total.CPU += cpuUnits
+		total.Memory += mib
+	}
+	return total
+}
+
+// allocationValue returns the CPU units or MiB of memory of an allocation like "1024", or like "1 vCPU" and "2 GB"
+// if it's counted in the unit, which is worth 1024 of them. The value is AllocationUnknown if it's empty, and kept as is
+// if it can't be parsed.
+func allocationValue(value, unit string) string {
+	if value == "" {
+		return describe.AllocationUnknown
+	}
+	if unit == "" || !strings.HasSuffix(value, unit) {
+		return value
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, unit)), 64)
+	if err != nil {
+		return value
+	}
+	return strconv.Itoa(int(n * 1024))
+}
+
+// stacks returns the stacks of the application in the environment.
+// The stacks are listed once per environment and reused by the other calls.
+func (o *showAppOpts) stacks(env *config.Environment) ([]cloudformation.StackDescription, error) {
+	o.mu.Lock()
+	stacks, ok := o.envStacks[env.Name]
+	o.mu.Unlock()
+	if ok {
+		return stacks, nil
+	}
+	lister, err := o.newStackLister(env)
+	if err != nil {
+		return nil, fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+	}
+	stacks, err = lister.ListStacksWithTags(map[string]string{
+		deploy.AppTagKey: o.name,
+		deploy.EnvTagKey: env.Name,
+	})
+	o.mu.Lock()
+	o.stackListings++
+	o.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("list stacks in environment %s: %w", env.Name, err)
+	}
+	o.mu.Lock()
+	o.envStacks[env.Name] = stacks
+	o.mu.Unlock()
+	return stacks, nil
+}
+
+// batchStacks lists the stacks of the environments that share an account and region with a single listing, as
+// DescribeStacks returns all the stacks of an account and region anyway, and caches them for stacks.
+// The environments alone in their account and region, or whose batch failed, are listed by stacks on their own.
+func (o *showAppOpts) batchStacks(envs []*config.Environment) {
+	type accountRegion struct {
+		account string
+		region  string
+	}
+	var keys []accountRegion
+	batches := make(map[accountRegion][]*config.Environment)
+	for _, env := range envs {
+		if env.AccountID == "" || env.Region == "" {
+			// The environment can't be known to share the account and region of another one.
+			continue
+		}
+		if _, ok := o.envStacks[env.Name]; ok {
+			// The stacks of the environment were already listed while deriving it from them.
+			continue
+		}
+		key := accountRegion{account: env.AccountID, region: env.Region}
+		if _, ok := batches[key]; !ok {
+			keys = append(keys, key)
+		}
+		batches[key] = append(batches[key], env)
+	}
+	forEachConcurrently(len(keys), o.maxConcurrency(), func(i int) error {
+		batch := batches[keys[i]]
+		if len(batch) < 2 {
+			return nil
+		}
+		// The stacks of an environment are always read with the session of an environment of the same account.
+		lister, err := o.newStackLister(batch[0])
+		if err != nil {
+			return nil
+		}
+		stacks, err := lister.ListStacksWithTags(map[string]string{
+			deploy.AppTagKey: o.name,
+		})
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		o.stackListings++
+		if err != nil {
+			return nil
+		}
+		stacksByEnv := make(map[string][]cloudformation.StackDescription)
+		for _, s := range stacks {
+			for _, tag := range s.Tags {
+				if aws.StringValue(tag.Key) == deploy.EnvTagKey {
+					stacksByEnv[aws.StringValue(tag.Value)] = append(stacksByEnv[aws.StringValue(tag.Value)], s)
+				}
+			}
+		}
+		for _, env := range batch {
+			o.envStacks[env.Name] = stacksByEnv[env.Name]
+		}
+		return nil
+	})
+}
+
+// forEachPooled calls fn for each index in [0, n) behind the adaptive limiter of the command, for the per-service calls
+// whose API has no batch version.
+func (o *showAppOpts) forEachPooled(n int, fn func(i int) error) error {
+	if o.limiter == nil {
+		return forEachConcurrently(n, o.maxConcurrency(), fn)
+	}
+	return forEachLimited(n, o.limiter, fn)
+}
+
+// maxConcurrency returns the maximum number of calls in flight set with --serial or --parallel-envs,
+// or defaultMaxConcurrency if neither is set.
+func (o *showAppOpts) maxConcurrency() int {
+	switch {
+	case o.shouldRunSerially:
+		return 1
+	case o.parallelEnvs > 0:
+		return o.parallelEnvs
+	}
+	return defaultMaxConcurrency
+}
+
+// newShowAppLimiter returns the limiter of the per-service calls. It only backs off when the calls are throttled
+// if neither --serial nor --parallel-envs is set, as these fix the number of calls in flight.
+func newShowAppLimiter(vars showAppVars, throttled func() int) *adaptiveLimiter {
+	switch {
+	case vars.shouldRunSerially:
+		return newFixedLimiter(1)
+	case vars.parallelEnvs > 0:
+		return newFixedLimiter(vars.parallelEnvs)
+	}
+	return newAdaptiveLimiter(defaultMaxConcurrency, throttled)
+}
+
+// svcStackResources returns the resources of the stack of the service in the environment.
+// The resources are only retrieved once per description, as they're read for several sections.
+func (o *showAppOpts) svcStackResources(env *config.Environment, svc string) ([]*cloudformation.StackResource, error) {
+	key := workloadInEnv{env: env.Name, workload: svc}
+	o.mu.Lock()
+	resources, ok := o.resources[key]
+	o.mu.Unlock()
+	if ok {
+		return resources, nil
+	}
+	getter, err := o.newStackResourcesGetter(env)
+	if err != nil {
+		return nil, fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+	}
+	resources, err = getter.StackResources(stack.NameForService(o.name, env.Name, svc))
+	if err != nil {
+		return nil, fmt.Errorf("get resources of service %s in environment %s: %w", svc, env.Name, err)
+	}
+	o.mu.Lock()
+	o.resources[key] = resources
+	o.mu.Unlock()
+	return resources, nil
+}
+
+// dependencies returns what each service of the workspace depends on: its addons, and the other services whose
+// stack outputs its addons import. Services without addons in the workspace have no dependencies.
+// Cycles between the services are reported as warnings since the services can't be deployed one after the other.
+func (o *showAppOpts) dependencies(svcs []*config.Workload) []*describe.ServiceDependencies {
+	isSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isSvc[svc.Name] = true
+	}
+	var dependencies []*describe.ServiceDependencies
+	svcDependencies := make(map[string][]string)
+	for _, svc := range svcs {
+		fnames, err := o.addons.ReadAddonsDir(svc.Name)
+		if err != nil {
+			// The service has no addons directory, or isn't in the workspace.
+			continue
+		}
+		dependsOn := make(map[string]bool)
+		for _, fname := range fnames {
+			ext := filepath.Ext(fname)
+			if ext != ".yml" && ext != ".yaml" {
+				continue
+			}
+			dependsOn[strings.TrimSuffix(fname, ext)] = true
+			content, err := o.addons.ReadAddon(svc.Name, fname)
+			if err != nil {
+				o.partialf(describe.WarningSeverityWarning, "Couldn't read addon %s of service %s: %v", fname, svc.Name, err)
+				continue
+			}
+			imported, err := importedServices(content, isSvc)
+			if err != nil {
+				o.partialf(describe.WarningSeverityWarning, "Couldn't parse addon %s of service %s: %v", fname, svc.Name, err)
+				continue
+			}
+			for _, other := range imported {
+				if other != svc.Name {
+					dependsOn[other] = true
+					if !contains(other, svcDependencies[svc.Name]) {
+						svcDependencies[svc.Name] = append(svcDependencies[svc.Name], other)
+					}
+				}
+			}
+		}
+		if len(dependsOn) == 0 {
+			continue
+		}
+		dependency := &describe.ServiceDependencies{Service: svc.Name}
+		for name := range dependsOn {
+			dependency.DependsOn = append(dependency.DependsOn, name)
+		}
+		sort.Strings(dependency.DependsOn)
+		dependencies = append(dependencies, dependency)
+	}
+	for _, cycle := range dependencyCycles(svcDependencies) {
+		o.warnf(describe.WarningSeverityWarning, "Services depend on each other in a cycle and can't be deployed one after the other: %s", strings.Join(cycle, " -> "))
+	}
+	return dependencies
+}
+
+// importedServices returns the services whose stack outputs are imported by the addon template.
+// The outputs of a service stack are exported as "${App}-${Env}-<service>-<output>".
+func importedServices(template []byte, isSvc map[string]bool) ([]string, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(template, &root); err != nil {
+		return nil, err
+	}
+	var imported []string
+	for _, value := range importedValues(&root, false) {
+		if !strings.HasPrefix(value, addonExportPrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(value, addonExportPrefix)
+		i := strings.LastIndex(rest, "-")
+		if i <= 0 || !isSvc[rest[:i]] {
+			continue
+		}
+		imported = append(imported, rest[:i])
+	}
+	return imported, nil
+}
+
+// importedValues returns the scalars of the node that are the names of imported values,
+// either with the "Fn::ImportValue" function or with the "!ImportValue" short form.
+func importedValues(node *yaml.Node, inImport bool) []string {
+	inImport = inImport || node.Tag == "!ImportValue"
+	if node.Kind == yaml.ScalarNode {
+		if inImport {
+			return []string{node.Value}
+		}
+		return nil
+	}
+	var values []string
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 0 {
+			continue
+		}
+		isImportKey := node.Kind == yaml.MappingNode && node.Content[i-1].Value == "Fn::ImportValue"
+		values = append(values, importedValues(child, inImport || isImportKey)...)
+	}
+	return values
+}
+
+// crossAccountRefs returns the services whose addons, including their parameters, reference resources in an account
+// other than the account of one of the environments, either with the ARN of a resource or with the ID of the account.
+// The addons that can't be read or parsed are skipped, as they're already reported by dependencies.
+func (o *showAppOpts) crossAccountRefs(envs []*config.Environment, svcs []*config.Workload) []*describe.ServiceCrossAccountRefs {
+	envAccounts := make(map[string]bool)
+	for _, env := range envs {
+		if env.AccountID != "" {
+			envAccounts[env.AccountID] = true
+		}
+	}
+	if len(envAccounts) == 0 {
+		return nil
+	}
+	var refs []*describe.ServiceCrossAccountRefs
+	for _, svc := range svcs {
+		fnames, err := o.addons.ReadAddonsDir(svc.Name)
+		if err != nil {
+			continue
+		}
+		referenced := make(map[string]bool)
+		for _, fname := range fnames {
+			if ext := filepath.Ext(fname); ext != ".yml" && ext != ".yaml" {
+				continue
+			}
+			content, err := o.addons.ReadAddon(svc.Name, fname)
+			if err != nil {
+				continue
+			}
+			accounts, err := referencedAccounts(content)
+			if err != nil {
+				continue
+			}
+			for _, account := range accounts {
+				// A single environment in another account is enough for the reference to cross accounts once deployed there.
+				if len(envAccounts) > 1 || !envAccounts[account] {
+					referenced[account] = true
+				}
+			}
+		}
+		if len(referenced) == 0 {
+			continue
+		}
+		ref := &describe.ServiceCrossAccountRefs{Service: svc.Name}
+		for account := range referenced {
+			ref.Accounts = append(ref.Accounts, account)
+		}
+		sort.Strings(ref.Accounts)
+		refs = append(refs, ref)
+		o.warnf(describe.WarningSeverityInfo, "Service %s references resources in other accounts than the ones of its environments: %s", svc.Name, strings.Join(ref.Accounts, ", "))
+	}
+	return refs
+}
+
+// referencedAccounts returns the account IDs in the values of the template, the ones of ARNs and the values that are IDs.
+func referencedAccounts(template []byte) ([]string, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(template, &root); err != nil {
+		return nil, err
+	}
+	var accounts []string
+	for _, value := range scalarValues(&root) {
+		if accountIDRegexp.MatchString(value) {
+			accounts = append(accounts, value)
+			continue
+		}
+		for _, match := range arnAccountIDRegexp.FindAllStringSubmatch(value, -1) {
+			accounts = append(accounts, match[1])
+		}
+	}
+	return accounts, nil
+}
+
+// scalarValues returns the scalars of the node that aren't the keys of a mapping.
+func scalarValues(node *yaml.Node) []string {
+	if node.Kind == yaml.ScalarNode {
+		return []string{node.Value}
+	}
+	var values []string
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 0 {
+			continue
+		}
+		values = append(values, scalarValues(child)...)
+	}
+	return values
+}
+
+// dependencyCycles returns the cycles among the services, each as the path from a service back to itself.
+// The services are visited in alphabetical order so that the cycles are reported in the same order every time.
+func dependencyCycles(dependsOn map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int)
+	var path []string
+	var cycles [][]string
+	var visit func(svc string)
+	visit = func(svc string) {
+		states[svc] = visiting
+		path = append(path, svc)
+		deps := append([]string(nil), dependsOn[svc]...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			switch states[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				for i := range path {
+					if path[i] == dep {
+						cycles = append(cycles, append(append([]string(nil), path[i:]...), dep))
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		states[svc] = visited
+	}
+	var svcs []string
+	for svc := range dependsOn {
+		svcs = append(svcs, svc)
+	}
+	sort.Strings(svcs)
+	for _, svc := range svcs {
+		if states[svc] == unvisited {
+			visit(svc)
+		}
+	}
+	return cycles
+}
+
+// stackTags returns the tags of the stack of a service or an environment, without the tags reserved by Copilot
+// and the ones that are identical to the tags of the application. It returns nil if no tags are left.
+func stackTags(s cloudformation.StackDescription, appTags map[string]string) map[string]string {
+	var tags map[string]string
+	for _, tag := range s.Tags {
+		key, value := aws.StringValue(tag.Key), aws.StringValue(tag.Value)
+		switch key {
+		case deploy.AppTagKey, deploy.EnvTagKey, deploy.ServiceTagKey:
+			continue
+		}
+		if appValue, ok := appTags[key]; ok && appValue == value {
+			continue
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
+// envStatuses returns the status of the stack of each environment from the stacks listed to describe the deployments.
+// The status is unknown if the stacks of the environment couldn't be listed or if its stack isn't among them.
+// Environments that failed or are still being provisioned are flagged with a warning.
+func (o *showAppOpts) envStatuses(envs []*config.Environment) map[string]string {
+	statuses := make(map[string]string)
+	for _, env := range envs {
+		statuses[env.Name] = describe.EnvStatusUnknown
+		o.mu.Lock()
+		stacks, unreachable := o.envStacks[env.Name], o.unreachable[env.Name]
+		o.mu.Unlock()
+		if unreachable {
+			// The environment is already flagged as unreachable.
+			statuses[env.Name] = describe.EnvStatusAccountUnreachable
+			continue
+		}
+		stackName := stack.NameForEnv(o.name, env.Name)
+		for _, s := range stacks {
+			if aws.StringValue(s.StackName) == stackName {
+				statuses[env.Name] = aws.StringValue(s.StackStatus)
+				break
+			}
+		}
+		switch status := cloudformation.StackStatus(statuses[env.Name]); {
+		case status.Success():
+		case status.Failure():
+			o.warnf(describe.WarningSeverityError, "Environment %s is in a failed state: stack %s is in %s", env.Name, stackName, status)
+			o.markFailing(env.Name, "")
+		case status.InProgress():
+			o.warnf(describe.WarningSeverityWarning, "Environment %s is still being provisioned: stack %s is in %s", env.Name, stackName, status)
+			o.markFailing(env.Name, "")
+		}
+	}
+	return statuses
+}
+
+// insecureEndpoints returns the endpoints of the deployed load balanced services whose stack disables HTTPS,
+// and flags them. Without HTTPS, the load balancer of the environment has no HTTPS listener to serve them on nor
+// to redirect HTTP to. The services whose stack doesn't have the parameter are skipped, as their protocol is unknown.
+// App Runner services always serve HTTPS, and the other services aren't public.
+func (o *showAppOpts) insecureEndpoints(envs []*config.Environment, svcs []*config.Workload, deployments []*describe.AppDeployment) []*describe.AppInsecureEndpoint {
+	isLBWebSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isLBWebSvc[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType
+	}
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	var insecure []*describe.AppInsecureEndpoint
+	for _, deployment := range deployments {
+		if !isLBWebSvc[deployment.Service] || envsByName[deployment.Environment] == nil {
+			continue
+		}
+		o.mu.Lock()
+		stacks := o.envStacks[deployment.Environment]
+		o.mu.Unlock()
+		svcParams := stackParameters(stacks, stack.NameForService(o.name, deployment.Environment, deployment.Service))
+		if svcParams[stack.LBWebServiceHTTPSParamKey] != "false" {
+			continue
+		}
+		endpoint := &describe.AppInsecureEndpoint{
+			Service:     deployment.Service,
+			Environment: deployment.Environment,
+		}
+		if dnsName := stackOutputs(stacks, stack.NameForEnv(o.name, deployment.Environment))[stack.EnvOutputPublicLBDNSName]; dnsName != "" {
+			path := svcParams[stack.LBWebServiceRulePathParamKey]
+			if path == "" {
+				path = "/"
+			}
+			endpoint.URL = (&describe.WebServiceURI{DNSName: dnsName, Path: path}).String()
+		}
+		o.warnf(describe.WarningSeverityWarning, "Service %s in environment %s is public but only serves HTTP: its load balancer has no HTTPS listener nor redirect to HTTPS", deployment.Service, deployment.Environment)
+		insecure = append(insecure, endpoint)
+	}
+	return insecure
+}
+
+// endpoints sets all the URLs that each deployed service is reached at, from the stacks that are already listed and
+// the App Runner services that were described: the domain of the environment and the DNS name of its public load balancer
+// for the load balanced web services, and the default and custom domains of the App Runner services.
+// None of them is left out in favor of another, as clients can reach the service at any of them.
+func (o *showAppOpts) endpoints(svcs []*config.Workload, deployments []*describe.AppDeployment, appRunnerSvcs []*describe.AppRunnerService) {
+	isLBWebSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isLBWebSvc[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType
+	}
+	appRunnerSvcsByDeployment := make(map[workloadInEnv]*describe.AppRunnerService)
+	for _, svc := range appRunnerSvcs {
+		appRunnerSvcsByDeployment[workloadInEnv{env: svc.Environment, workload: svc.Service}] = svc
+	}
+	for _, deployment := range deployments {
+		if svc, ok := appRunnerSvcsByDeployment[workloadInEnv{env: deployment.Environment, workload: deployment.Service}]; ok {
+			if svc.URL != "" {
+				deployment.Endpoints = append(deployment.Endpoints, &describe.AppEndpoint{URL: "https://" + svc.URL, Kind: describe.EndpointAppRunner})
+			}
+			for _, domain := range svc.CustomDomains {
+				deployment.Endpoints = append(deployment.Endpoints, &describe.AppEndpoint{URL: "https://" + domain.DomainName, Kind: describe.EndpointCustomDomain})
+			}
+			continue
+		}
+		if !isLBWebSvc[deployment.Service] {
+			continue
+		}
+		o.mu.Lock()
+		stacks := o.envStacks[deployment.Environment]
+		o.mu.Unlock()
+		envOutputs := stackOutputs(stacks, stack.NameForEnv(o.name, deployment.Environment))
+		svcParams := stackParameters(stacks, stack.NameForService(o.name, deployment.Environment, deployment.Service))
+		if subdomain := envOutputs[stack.EnvOutputSubdomain]; subdomain != "" {
+			deployment.Endpoints = append(deployment.Endpoints, &describe.AppEndpoint{
+				URL:  fmt.Sprintf("https://%s.%s", deployment.Service, subdomain),
+				Kind: describe.EndpointCustomDomain,
+			})
+		}
+		if dnsName := envOutputs[stack.EnvOutputPublicLBDNSName]; dnsName != "" {
+			scheme := "http"
+			if svcParams[stack.LBWebServiceHTTPSParamKey] == "true" {
+				scheme = "https"
+			}
+			endpointURL := fmt.Sprintf("%s://%s", scheme, dnsName)
+			if path := svcParams[stack.LBWebServiceRulePathParamKey]; path != "" && path != "/" {
+				endpointURL += "/" + path
+			}
+			deployment.Endpoints = append(deployment.Endpoints, &describe.AppEndpoint{URL: endpointURL, Kind: describe.EndpointLoadBalancer})
+		}
+	}
+}
+
+// stackParameters returns the parameters of the stack with the name among the stacks by key, or nil if it's not among them.
+func stackParameters(stacks []cloudformation.StackDescription, name string) map[string]string {
+	for _, s := range stacks {
+		if aws.StringValue(s.StackName) != name {
+			continue
+		}
+		params := make(map[string]string)
+		for _, param := range s.Parameters {
+			params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+		}
+		return params
+	}
+	return nil
+}
+
+// stackOutputs returns the outputs of the stack with the name among the stacks by key, or nil if it's not among them.
+func stackOutputs(stacks []cloudformation.StackDescription, name string) map[string]string {
+	for _, s := range stacks {
+		if aws.StringValue(s.StackName) != name {
+			continue
+		}
+		outputs := make(map[string]string)
+		for _, output := range s.Outputs {
+			outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+		}
+		return outputs
+	}
+	return nil
+}
+
+// envNamespaces returns the service discovery namespace of each environment whose stack outputs the ID of one,
+// from the stacks that are already listed. The environment stacks all name their namespace after the application.
+func (o *showAppOpts) envNamespaces(envs []*config.Environment) map[string]*describe.AppEnvNamespace {
+	namespaces := make(map[string]*describe.AppEnvNamespace)
+	for _, env := range envs {
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		if id := stackOutputs(stacks, stack.NameForEnv(o.name, env.Name))[stack.EnvOutputNamespaceID]; id != "" {
+			namespaces[env.Name] = &describe.AppEnvNamespace{
+				Name: fmt.Sprintf(fmtEnvNamespace, o.name),
+				ID:   id,
+			}
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil
+	}
+	return namespaces
+}
+
+// envTags returns the tags of the stack of each environment that differ from the tags of the application, from the
+// stacks that are already listed. The environments without such tags are left out.
+func (o *showAppOpts) envTags(envs []*config.Environment, appTags map[string]string) map[string]map[string]string {
+	tags := make(map[string]map[string]string)
+	for _, env := range envs {
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		envStackName := stack.NameForEnv(o.name, env.Name)
+		for _, s := range stacks {
+			if aws.StringValue(s.StackName) != envStackName {
+				continue
+			}
+			if envTags := stackTags(s, appTags); envTags != nil {
+				tags[env.Name] = envTags
+			}
+			break
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// domainConflicts returns the domain names claimed by several deployments, sorted by domain, and flags them.
+// The load balanced services claim a domain under the subdomain of their environment if the application has a domain,
+// and the App Runner services their custom domains, which are only retrieved with the resources.
+func (o *showAppOpts) domainConflicts(app *config.Application, svcs []*config.Workload, deployments []*describe.AppDeployment, appRunnerSvcs []*describe.AppRunnerService) []*describe.AppDomainConflict {
+	claims := make(map[string][]*describe.AppDomainClaim)
+	claim := func(domain, svc, env string) {
+		domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+		for _, c := range claims[domain] {
+			if c.Service == svc && c.Environment == env {
+				return
+			}
+		}
+		claims[domain] = append(claims[domain], &describe.AppDomainClaim{Service: svc, Environment: env})
+	}
+	if app.Domain != "" {
+		isLBWebSvc := make(map[string]bool)
+		for _, svc := range svcs {
+			isLBWebSvc[svc.Name] = svc.Type == manifest.LoadBalancedWebServiceType
+		}
+		for _, deployment := range deployments {
+			if isLBWebSvc[deployment.Service] {
+				claim(fmt.Sprintf(fmtLBWebSvcDomain, deployment.Service, deployment.Environment, app.Name, app.Domain), deployment.Service, deployment.Environment)
+			}
+		}
+	}
+	for _, svc := range appRunnerSvcs {
+		for _, domain := range svc.CustomDomains {
+			claim(domain.DomainName, svc.Service, svc.Environment)
+		}
+	}
+	var conflicts []*describe.AppDomainConflict
+	for domain, domainClaims := range claims {
+		if len(domainClaims) > 1 {
+			conflicts = append(conflicts, &describe.AppDomainConflict{Domain: domain, Claims: domainClaims})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Domain < conflicts[j].Domain })
+	for _, conflict := range conflicts {
+		var names []string
+		for _, c := range conflict.Claims {
+			names = append(names, fmt.Sprintf("%s in environment %s", c.Service, c.Environment))
+			o.markFailing(c.Environment, c.Service)
+		}
+		o.warnf(describe.WarningSeverityError, "Domain %s is claimed by several services, only one of them receives its traffic: %s", conflict.Domain, strings.Join(names, ", "))
+	}
+	return conflicts
+}
+
+// reachableEnvs returns the environments whose account could be accessed while describing the deployments.
+func (o *showAppOpts) reachableEnvs(envs []*config.Environment) []*config.Environment {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var reachable []*config.Environment
+	for _, env := range envs {
+		if !o.unreachable[env.Name] {
+			reachable = append(reachable, env)
+		}
+	}
+	return reachable
+}
+
+// isAccountUnreachableErr returns true if the error is due to the account itself being inaccessible, like when the
+// role of an environment can't be assumed because its account was closed, rather than to a single failed call.
+func isAccountUnreachableErr(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	if accountUnreachableErrCodes[aerr.Code()] {
+		return true
+	}
+	return aerr.Code() == "AccessDenied" && strings.Contains(aerr.Message(), "sts:AssumeRole")
+}
+
+// isTransientErr returns true if the error is likely to go away if the call is made again, like throttling.
+func isTransientErr(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return request.IsErrorThrottle(aerr) || request.IsErrorRetryable(aerr)
+}
+
+// inventoryFromStacks returns the application and its environments and services as derived from the stacks tagged
+// with the application in the region of the default session, after the config store failed with storeErr.
+// The environments whose stacks are in other accounts or regions aren't found, and the type of the services is unknown.
+// The stacks are cached for the environments so that they aren't listed again.
+func (o *showAppOpts) inventoryFromStacks(storeErr error) (*config.Application, []*config.Environment, []*config.Workload, error) {
+	stacks, err := o.tagStacks.ListStacksWithTags(map[string]string{
+		deploy.AppTagKey: o.name,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list stacks of application %s after the config store failed (%v): %w", o.name, storeErr, err)
+	}
+	o.partialf(describe.WarningSeverityWarning, "The config store is unavailable, the environments and services of application %s are derived from its stacks: %v", o.name, storeErr)
+	stacksByEnv := make(map[string][]cloudformation.StackDescription)
+	var envNames, svcNames []string
+	isSvc := make(map[string]bool)
+	for _, s := range stacks {
+		tags := make(map[string]string)
+		for _, tag := range s.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		envName, svcName := tags[deploy.EnvTagKey], tags[deploy.ServiceTagKey]
+		if envName == "" {
+			continue
+		}
+		if _, ok := stacksByEnv[envName]; !ok {
+			envNames = append(envNames, envName)
+		}
+		stacksByEnv[envName] = append(stacksByEnv[envName], s)
+		if svcName != "" && !isSvc[svcName] {
+			isSvc[svcName] = true
+			svcNames = append(svcNames, svcName)
+		}
+	}
+	sort.Strings(envNames)
+	sort.Strings(svcNames)
+	var envs []*config.Environment
+	for _, name := range envNames {
+		envs = append(envs, o.envFromStacks(name, stacksByEnv[name]))
+		o.envStacks[name] = stacksByEnv[name]
+	}
+	var svcs []*config.Workload
+	for _, name := range svcNames {
+		svcs = append(svcs, &config.Workload{App: o.name, Name: name})
+	}
+	return &config.Application{Name: o.name}, envs, svcs, nil
+}
+
+// envFromStacks returns the environment as derived from its stack, or from the account and region of any of its stacks
+// if the environment stack isn't among them.
+func (o *showAppOpts) envFromStacks(name string, stacks []cloudformation.StackDescription) *config.Environment {
+	env := &config.Environment{App: o.name, Name: name}
+	for _, s := range stacks {
+		if aws.StringValue(s.StackName) == stack.NameForEnv(o.name, name) {
+			cfg := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{AppName: o.name, Name: name})
+			sdkStack := sdkcloudformation.Stack(s)
+			if derived, err := cfg.ToEnv(&sdkStack); err == nil {
+				return derived
+			}
+		}
+		if stackARN, err := arn.Parse(aws.StringValue(s.StackId)); err == nil {
+			env.AccountID, env.Region = stackARN.AccountID, stackARN.Region
+		}
+	}
+	return env
+}
+
+// envLastDeployedAt returns when any of the stacks of each environment was last created or updated.
+// The environments whose stacks couldn't be listed are left out.
+func (o *showAppOpts) envLastDeployedAt(envs []*config.Environment) map[string]time.Time {
+	deployedAt := make(map[string]time.Time)
+	for _, env := range envs {
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		for _, s := range stacks {
+			last := aws.TimeValue(s.LastUpdatedTime)
+			if last.IsZero() {
+				last = aws.TimeValue(s.CreationTime)
+			}
+			if last.After(deployedAt[env.Name]) {
+				deployedAt[env.Name] = last
+			}
+		}
+	}
+	return deployedAt
+}
+
+// lastDeployedBy returns who or what made the most recent deployment of any of the stacks of each environment,
+// from the CloudTrail event history of its region. The deployer is unknown if none of the stacks were deployed
+// within the event history or if it couldn't be looked up.
+func (o *showAppOpts) lastDeployedBy(envs []*config.Environment) map[string]string {
+	deployers := make(map[string]string)
+	for _, env := range envs {
+		deployers[env.Name] = describe.LastDeployedByUnknown
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		if len(stacks) == 0 {
+			continue
+		}
+		getter, err := o.newDeploymentGetter(env)
+		if err != nil {
+			continue
+		}
+		var last *cloudtrail.StackDeployment
+		for _, s := range stacks {
+			// CloudTrail records the ID of the stacks as the name of the resources of their events.
+			id := aws.StringValue(s.StackId)
+			if id == "" {
+				id = aws.StringValue(s.StackName)
+			}
+			deployment, err := getter.LastStackDeployment(id)
+			if err != nil || deployment == nil {
+				continue
+			}
+			if last == nil || deployment.Time.After(last.Time) {
+				last = deployment
+			}
+		}
+		if last != nil && last.Initiator != "" {
+			deployers[env.Name] = last.Initiator
+		}
+	}
+	return deployers
+}
+
+// checkConsistency flags the deployed resources that the config store doesn't know about, which the description
+// doesn't otherwise report: the service stacks of services that aren't in the application.
+func (o *showAppOpts) checkConsistency(envs []*config.Environment, svcs []*config.Workload) {
+	isSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isSvc[svc.Name] = true
+	}
+	for _, env := range envs {
+		o.mu.Lock()
+		stacks := o.envStacks[env.Name]
+		o.mu.Unlock()
+		for _, s := range stacks {
+			for _, tag := range s.Tags {
+				if aws.StringValue(tag.Key) != deploy.ServiceTagKey || isSvc[aws.StringValue(tag.Value)] {
+					continue
+				}
+				o.warnf(describe.WarningSeverityWarning, "Stack %s in environment %s belongs to service %s, which isn't in the application", aws.StringValue(s.StackName), env.Name, aws.StringValue(tag.Value))
+			}
+		}
+	}
+}
+
+// markDanglingEnvs sets the environments that the deploy stages of each pipeline target but that aren't in the
+// application anymore, and warns about them since the pipeline only fails once it reaches their stage.
+func (o *showAppOpts) markDanglingEnvs(envs []*config.Environment, pipelines []*codepipeline.Pipeline) {
+	isEnv := make(map[string]bool)
+	for _, env := range envs {
+		isEnv[env.Name] = true
+	}
+	for _, pipeline := range pipelines {
+		seen := make(map[string]bool)
+		for _, stage := range pipeline.Stages {
+			if !strings.HasPrefix(stage.Name, pipelineDeployStagePrefix) {
+				continue
+			}
+			env := strings.TrimPrefix(stage.Name, pipelineDeployStagePrefix)
+			if isEnv[env] || seen[env] {
+				continue
+			}
+			seen[env] = true
+			pipeline.DanglingEnvs = append(pipeline.DanglingEnvs, env)
+			o.warnf(describe.WarningSeverityWarning, "Pipeline %s deploys to environment %s, which isn't in the application", pipeline.Name, env)
+		}
+	}
+}
+
+// stackSetEnvs returns a pseudo-environment for each instance of the --stackset stack set whose account and region
+// aren't the ones of an environment of the config store. The pseudo-environments are named after their account and region.
+func (o *showAppOpts) stackSetEnvs(envs []*config.Environment) ([]*config.Environment, error) {
+	instances, err := o.stackSets.InstanceSummaries(o.stackSetName)
+	if err != nil {
+		return nil, err
+	}
+	type accountRegion struct {
+		account string
+		region  string
+	}
+	known := make(map[accountRegion]bool)
+	for _, env := range envs {
+		known[accountRegion{account: env.AccountID, region: env.Region}] = true
+	}
+	var stackSetEnvs []*config.Environment
+	for _, instance := range instances {
+		key := accountRegion{account: instance.Account, region: instance.Region}
+		if known[key] {
+			continue
+		}
+		// A stack set has at most one instance per account and region.
+		known[key] = true
+		stackSetEnvs = append(stackSetEnvs, &config.Environment{
+			Name:      fmt.Sprintf("%s/%s", instance.Account, instance.Region),
+			AccountID: instance.Account,
+			Region:    instance.Region,
+		})
+	}
+	return stackSetEnvs, nil
+}
+
+// workspaceOnlySvcs returns the services of the workspace that aren't in the application, like the services
+// that were initialized but never deployed. There are none outside of a workspace of the application.
+func (o *showAppOpts) workspaceOnlySvcs(svcs []*config.Workload) []*config.Workload {
+	if o.wsSvcs == nil {
+		return nil
+	}
+	summary, err := o.wsSvcs.Summary()
+	if err != nil || summary.Application != o.name {
+		return nil
+	}
+	names, err := o.wsSvcs.ServiceNames()
+	if err != nil {
+		o.partialf(describe.WarningSeverityWarning, "Couldn't list the services of the workspace: %v", err)
+		return nil
+	}
+	isSvc := make(map[string]bool)
+	for _, svc := range svcs {
+		isSvc[svc.Name] = true
+	}
+	var wsOnly []*config.Workload
+	for _, name := range names {
+		if isSvc[name] {
+			continue
+		}
+		svc := &config.Workload{Name: name}
+		// The type of the service is only known from its manifest as it isn't in the config store.
+		var mft manifest.Workload
+		if content, err := o.wsSvcs.ReadServiceManifest(name); err == nil && yaml.Unmarshal(content, &mft) == nil {
+			svc.Type = aws.StringValue(mft.Type)
+		}
+		wsOnly = append(wsOnly, svc)
+	}
+	return wsOnly
+}
+
+// drift sets the fields of the manifest of each service in the workspace, with the overrides of its environment,
+// that differ from the deployed task definition of the service, concurrently, and flags the drifted deployments.
+// Only the services of the workspace of the application that run on Amazon ECS are compared.
+func (o *showAppOpts) drift(envs []*config.Environment, deployments []*describe.AppDeployment) {
+	if o.wsSvcs == nil {
+		o.partialf(describe.WarningSeverityInfo, "Couldn't check the drift of the services: not in a workspace of application %s", o.name)
+		return
+	}
+	summary, err := o.wsSvcs.Summary()
+	if err != nil || summary.Application != o.name {
+		o.partialf(describe.WarningSeverityInfo, "Couldn't check the drift of the services: not in a workspace of application %s", o.name)
+		return
+	}
+	names, err := o.wsSvcs.ServiceNames()
+	if err != nil {
+		o.partialf(describe.WarningSeverityWarning, "Couldn't list the services of the workspace: %v", err)
+		return
+	}
+	// The manifests are read once, before the deployments are compared concurrently.
+	manifests := make(map[string][]byte)
+	for _, name := range names {
+		content, err := o.wsSvcs.ReadServiceManifest(name)
+		if err != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't read the manifest of service %s: %v", name, err)
+			continue
+		}
+		manifests[name] = content
+	}
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		content, ok := manifests[deployment.Service]
+		env, reachable := envsByName[deployment.Environment]
+		// App Runner services don't run on Amazon ECS.
+		if !ok || !reachable || deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		cfg, err := svcDriftConfig(content, env.Name)
+		if err != nil {
+			errs[i] = fmt.Errorf("parse manifest: %w", err)
+			return nil
+		}
+		if cfg == nil {
+			return nil
+		}
+		taskDef, err := o.taskDefinition(env, deployment.Service)
+		if err != nil {
+			errs[i] = err
+			return nil
+		}
+		deployment.Drift = driftedFields(cfg, taskDef, deployment.Service)
+		return nil
+	})
+	// The warnings are added once all the deployments are compared so that they're in the order of the deployments.
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't check the drift of service %s in environment %s: %v", deployment.Service, deployment.Environment, errs[i])
+			continue
+		}
+		if len(deployment.Drift) == 0 {
+			continue
+		}
+		var fields []string
+		for _, drift := range deployment.Drift {
+			fields = append(fields, drift.Field)
+		}
+		o.warnf(describe.WarningSeverityWarning, "Service %s in environment %s drifted from its manifest: %s", deployment.Service, deployment.Environment, strings.Join(fields, ", "))
+	}
+}
+
+// driftConfig is the configuration of the manifest of a service that is compared with its deployed task definition.
+type driftConfig struct {
+	image manifest.ServiceImageWithPort
+	task  manifest.TaskConfig
+}
+
+// svcDriftConfig returns the configuration of the manifest of the service with the overrides of the environment,
+// or nil if the manifest isn't the one of a service running on Amazon ECS.
+func svcDriftConfig(content []byte, env string) (*driftConfig, error) {
+	mft, err := manifest.UnmarshalWorkload(content)
+	if err != nil {
+		return nil, err
+	}
+	switch mft := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		overridden, err := mft.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply the overrides of environment %s: %w", env, err)
+		}
+		return &driftConfig{image: overridden.ImageConfig, task: overridden.TaskConfig}, nil
+	case *manifest.BackendService:
+		overridden, err := mft.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply the overrides of environment %s: %w", env, err)
+		}
+		return &driftConfig{image: overridden.ImageConfig.ServiceImageWithPort, task: overridden.TaskConfig}, nil
+	}
+	return nil, nil
+}
+
+// driftedFields returns the fields of the configuration that differ from the task definition, in the order of the manifest.
+// The main container is the one named after the service, and the variables that Copilot injects aren't compared.
+// Neither is the desired count, as autoscaling changes it, nor the image of the services built from a Dockerfile.
+func driftedFields(cfg *driftConfig, taskDef *awsecs.TaskDefinition, svc string) []*describe.AppDrift {
+	var drift []*describe.AppDrift
+	compare := func(field, inManifest, deployed string) {
+		if inManifest != deployed {
+			drift = append(drift, &describe.AppDrift{Field: field, Manifest: inManifest, Deployed: deployed})
+		}
+	}
+	compareMaps := func(field string, inManifest, deployed map[string]string) {
+		keys := make(map[string]bool)
+		for key := range inManifest {
+			keys[key] = true
+		}
+		for key := range deployed {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			compare(field+"."+key, inManifest[key], deployed[key])
+		}
+	}
+	if cfg.image.Location != nil {
+		var image string
+		for _, container := range taskDef.ContainerDefinitions {
+			if aws.StringValue(container.Name) == svc {
+				image = aws.StringValue(container.Image)
+			}
+		}
+		compare("image.location", aws.StringValue(cfg.image.Location), image)
+	}
+	if cfg.image.Port != nil {
+		var port string
+		for _, container := range taskDef.ContainerDefinitions {
+			if aws.StringValue(container.Name) == svc && len(container.PortMappings) != 0 {
+				port = fmt.Sprintf("%d", aws.Int64Value(container.PortMappings[0].ContainerPort))
+			}
+		}
+		compare("image.port", fmt.Sprintf("%d", aws.Uint16Value(cfg.image.Port)), port)
+	}
+	if cfg.task.CPU != nil {
+		compare("cpu", fmt.Sprintf("%d", aws.IntValue(cfg.task.CPU)), aws.StringValue(taskDef.Cpu))
+	}
+	if cfg.task.Memory != nil {
+		compare("memory", fmt.Sprintf("%d", aws.IntValue(cfg.task.Memory)), aws.StringValue(taskDef.Memory))
+	}
+	variables := make(map[string]string)
+	for _, variable := range taskDef.EnvironmentVariables() {
+		if variable.Container == svc && !strings.HasPrefix(variable.Name, copilotVariablePrefix) {
+			variables[variable.Name] = variable.Value
+		}
+	}
+	compareMaps("variables", cfg.task.Variables, variables)
+	secrets := make(map[string]string)
+	for _, secret := range taskDef.Secrets() {
+		if secret.Container == svc {
+			secrets[secret.Name] = secret.ValueFrom
+		}
+	}
+	compareMaps("secrets", cfg.task.Secrets, secrets)
+	return drift
+}
+
+// sinceLastDeploy sets the fields that the last deployment of each service running on Amazon ECS changed, concurrently,
+// from the differences between its active task definition and the revision registered before it.
+// The first deployment of a service has no previous revision, so all of its fields are changes.
+func (o *showAppOpts) sinceLastDeploy(envs []*config.Environment, deployments []*describe.AppDeployment) {
+	envsByName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		envsByName[env.Name] = env
+	}
+	errs := make([]error, len(deployments))
+	o.forEachPooled(len(deployments), func(i int) error {
+		deployment := deployments[i]
+		env, reachable := envsByName[deployment.Environment]
+		// App Runner services don't have task definitions.
+		if !reachable || deployment.TaskDefinition == describe.TaskDefinitionNotApplicable {
+			return nil
+		}
+		current, err := o.taskDefinition(env, deployment.Service)
+		if err != nil {
+			errs[i] = err
+			return nil
+		}
+		var previous *awsecs.TaskDefinition
+		if revision := aws.Int64Value(current.Revision); revision > 1 {
+			getter, err := o.newTaskDefGetter(env)
+			if err != nil {
+				errs[i] = fmt.Errorf("create task definition client for environment %s: %w", env.Name, err)
+				return nil
+			}
+			name := fmt.Sprintf("%s:%d", aws.StringValue(current.Family), revision-1)
+			previous, err = getter.TaskDefinition(name)
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+			deployment.PreviousTaskDefinition = name
+		}
+		deployment.Changes = changedFields(previous, current, deployment.Service)
+		return nil
+	})
+	// The warnings are added once all the deployments are compared so that they're in the order of the deployments.
+	for i, deployment := range deployments {
+		if errs[i] != nil {
+			o.partialf(describe.WarningSeverityWarning, "Couldn't compare the last deployment of service %s in environment %s with the previous one: %v", deployment.Service, deployment.Environment, errs[i])
+		}
+	}
+}
+
+// changedFields returns the fields of the current task definition of the service that differ from the previous one,
+// sorted by field. The previous task definition is nil if there's none.
+func changedFields(previous, current *awsecs.TaskDefinition, svc string) []*describe.AppChange {
+	previousFields := taskDefFields(previous, svc)
+	currentFields := taskDefFields(current, svc)
+	keys := make(map[string]bool)
+	for key := range previousFields {
+		keys[key] = true
+	}
+	for key := range currentFields {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	var changes []*describe.AppChange
+	for _, key := range sorted {
+		if previousFields[key] != currentFields[key] {
+			changes = append(changes, &describe.AppChange{Field: key, Previous: previousFields[key], Current: currentFields[key]})
+		}
+	}
+	return changes
+}
+
+// taskDefFields returns the values of the task definition of the service by the path of their field in the manifest.
+// The fields of the containers other than the main one, named after the service, are under "sidecars.<container>",
+// and the variables injected by Copilot are left out.
+func taskDefFields(taskDef *awsecs.TaskDefinition, svc string) map[string]string {
+	fields := make(map[string]string)
+	if taskDef == nil {
+		return fields
+	}
+	set := func(field, value string) {
+		if value != "" {
+			fields[field] = value
+		}
+	}
+	set("cpu", aws.StringValue(taskDef.Cpu))
+	set("memory", aws.StringValue(taskDef.Memory))
+	prefix := func(container string) string {
+		if container == svc {
+			return ""
+		}
+		return fmt.Sprintf("sidecars.%s.", container)
+	}
+	for _, image := range taskDef.Images() {
+		set(prefix(image.Container)+"image.location", image.Image)
+	}
+	for _, variable := range taskDef.EnvironmentVariables() {
+		if !strings.HasPrefix(variable.Name, copilotVariablePrefix) {
+			set(prefix(variable.Container)+"variables."+variable.Name, variable.Value)
+		}
+	}
+	for _, secret := range taskDef.Secrets() {
+		set(prefix(secret.Container)+"secrets."+secret.Name, secret.ValueFrom)
+	}
+	return fields
+}
+
+// checkSessionRegion notes the environments that aren't in the region of the default session, as the calls to them
+// are made with sessions in their own regions and their failures can be confused with the ones of the default session.
+// With --strict, describing them must be confirmed instead, for example with --yes.
+func (o *showAppOpts) checkSessionRegion(envs []*config.Environment) error {
+	if o.sessRegion == "" {
+		return nil
+	}
+	var names, regions []string
+	isRegion := make(map[string]bool)
+	for _, env := range envs {
+		if env.Region == "" || env.Region == o.sessRegion {
+			continue
+		}
+		names = append(names, env.Name)
+		if !isRegion[env.Region] {
+			isRegion[env.Region] = true
+			regions = append(regions, env.Region)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	subject := fmt.Sprintf("Environments %s are", english.WordSeries(names, "and"))
+	if len(names) == 1 {
+		subject = fmt.Sprintf("Environment %s is", names[0])
+	}
+	msg := fmt.Sprintf("%s in %s rather than in the region %s of your session", subject, english.WordSeries(regions, "and"), o.sessRegion)
+	if !o.isStrict {
+		o.warnf(describe.WarningSeverityInfo, "%s: the calls to them are made with sessions in their own regions", msg)
+		return nil
+	}
+	ok, err := o.prompt.Confirm(fmt.Sprintf("%s. Describe them with sessions in their own regions?", msg), fmt.Sprintf("With --%s, describing environments in other regions than the one of your session must be confirmed, like with --%s.", strictFlag, yesFlag))
+	if err != nil {
+		return fmt.Errorf("confirm describing the environments in other regions than %s: %w", o.sessRegion, err)
+	}
+	if !ok {
+		return fmt.Errorf("describing the environments in other regions than the region %s of your session must be confirmed with --%s: %s", o.sessRegion, strictFlag, english.WordSeries(names, "and"))
+	}
+	return nil
+}
+
+// checkEnvNameCollisions flags the environments whose names only differ by case, like "Prod" and "prod",
+// as it's easy to target one of them when meaning the other.
+func (o *showAppOpts) checkEnvNameCollisions(envs []*config.Environment) {
+	var folded []string
+	namesByFolded := make(map[string][]string)
+	for _, env := range envs {
+		key := strings.ToLower(env.Name)
+		if _, ok := namesByFolded[key]; !ok {
+			folded = append(folded, key)
+		}
+		namesByFolded[key] = append(namesByFolded[key], env.Name)
+	}
+	for _, key := range folded {
+		if names := namesByFolded[key]; len(names) > 1 {
+			o.warnf(describe.WarningSeverityError, "Environments %s of application %s have names that only differ by case", english.WordSeries(names, "and"), o.name)
+		}
+	}
+}
+
+// checkTopology notes when the environments are in more than topologyMaxRegions regions or in several continents,
+// as the latency between them may not suit a latency-sensitive application.
+func (o *showAppOpts) checkTopology(envs []*config.Environment) {
+	var regions []string
+	isRegion := make(map[string]bool)
+	var continents []string
+	regionsByContinent := make(map[string][]string)
+	for _, env := range envs {
+		if env.Region == "" || isRegion[env.Region] {
+			continue
+		}
+		isRegion[env.Region] = true
+		regions = append(regions, env.Region)
+		continent, ok := regionContinents[strings.SplitN(env.Region, "-", 2)[0]]
+		if !ok {
+			continue
+		}
+		if _, ok := regionsByContinent[continent]; !ok {
+			continents = append(continents, continent)
+		}
+		regionsByContinent[continent] = append(regionsByContinent[continent], env.Region)
+	}
+	if len(regions) > topologyMaxRegions {
+		o.warnf(describe.WarningSeverityInfo, "The environments of application %s span %d regions: %s", o.name, len(regions), strings.Join(regions, ", "))
+	}
+	if len(continents) > 1 {
+		var spans []string
+		for _, continent := range continents {
+			spans = append(spans, fmt.Sprintf("%s (%s)", continent, strings.Join(regionsByContinent[continent], ", ")))
+		}
+		o.warnf(describe.WarningSeverityInfo, "The environments of application %s span %d continents: %s", o.name, len(continents), strings.Join(spans, ", "))
+	}
+}
+
+// manuallyDeployed returns the names of the deployed services whose stacks aren't deployed by any of the pipelines,
+// and flags them as they may drift from the source. An application without pipelines isn't checked.
+func (o *showAppOpts) manuallyDeployed(svcs []*config.Workload, pipelines []*codepipeline.Pipeline, deployments []*describe.AppDeployment) []string {
+	if len(pipelines) == 0 {
+		return nil
+	}
+	pipelineStacks := make(map[string]bool)
+	for _, pipeline := range pipelines {
+		for _, stage := range pipeline.Stages {
+			for _, stackName := range stage.StackNames {
+				pipelineStacks[stackName] = true
+			}
+		}
+	}
+	isDeployed := make(map[string]bool)
+	isPipelined := make(map[string]bool)
+	for _, deployment := range deployments {
+		isDeployed[deployment.Service] = true
+		if pipelineStacks[stack.NameForService(o.name, deployment.Environment, deployment.Service)] {
+			isPipelined[deployment.Service] = true
+		}
+	}
+	var manual []string
+	for _, svc := range svcs {
+		if !isDeployed[svc.Name] || isPipelined[svc.Name] {
+			continue
+		}
+		o.warnf(describe.WarningSeverityInfo, "Service %s isn't deployed by any pipeline and may be deployed manually", svc.Name)
+		manual = append(manual, svc.Name)
+	}
+	return manual
+}
+
+// pipelineStages returns the stages of each pipeline with the services of the application that they deploy,
+// matched by the names of the stacks deployed by the actions of the stages. The stacks of the environments
+// and of the other applications are left out.
+func (o *showAppOpts) pipelineStages(envs []*config.Environment, svcs []*config.Workload, pipelines []*codepipeline.Pipeline) []*describe.AppPipelineStages {
+	svcStacks := make(map[string]workloadInEnv)
+	for _, env := range envs {
+		for _, svc := range svcs {
+			svcStacks[stack.NameForService(o.name, env.Name, svc.Name)] = workloadInEnv{env: env.Name, workload: svc.Name}
+		}
+	}
+	var structures []*describe.AppPipelineStages
+	for _, pipeline := range pipelines {
+		structure := &describe.AppPipelineStages{
+			Pipeline: pipeline.Name,
+			Stages:   []*describe.AppPipelineStage{},
+		}
+		for _, stage := range pipeline.Stages {
+			pipelineStage := &describe.AppPipelineStage{
+				Name:     stage.Name,
+				Category: stage.Category,
+			}
+			for _, deploy := range stage.Deploys {
+				deployed, ok := svcStacks[deploy.StackName]
+				if !ok {
+					continue
+				}
+				pipelineStage.Deploys = append(pipelineStage.Deploys, &describe.AppPipelineDeploy{
+					Service:     deployed.workload,
+					Environment: deployed.env,
+					RunOrder:    deploy.RunOrder,
+				})
+			}
+			structure.Stages = append(structure.Stages, pipelineStage)
+		}
+		structures = append(structures, structure)
+	}
+	return structures
+}
+
+// deployedSvcs returns the services with a stack in the environment.
+func (o *showAppOpts) deployedSvcs(env *config.Environment, svcs []*config.Workload) ([]*config.Workload, error) {
+	stacks, err := o.stacks(env)
+	if err != nil {
+		return nil, err
+	}
+	stackNames := make(map[string]bool)
+	for _, s := range stacks {
+		stackNames[aws.StringValue(s.StackName)] = true
+	}
+	var deployed []*config.Workload
+	for _, svc := range svcs {
+		if stackNames[stack.NameForService(o.name, env.Name, svc.Name)] {
+			deployed = append(deployed, svc)
+		}
+	}
+	return deployed, nil
+}
+
+// filterWorkloads returns the workloads for which keep returns true.
+func filterWorkloads(wls []*config.Workload, keep func(wl *config.Workload) bool) []*config.Workload {
+	var filtered []*config.Workload
+	for _, wl := range wls {
+		if keep(wl) {
+			filtered = append(filtered, wl)
+		}
+	}
+	return filtered
+}
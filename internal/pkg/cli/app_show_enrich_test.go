@@ -0,0 +1,2566 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowAppOpts_MaxConcurrency(t *testing.T) {
+	testCases := map[string]struct {
+		inSerial       bool
+		inParallelEnvs int
+
+		wantedLimit    int
+		wantedAdaptive bool
+	}{
+		"adapts the default limit if neither flag is set": {
+			wantedLimit:    defaultMaxConcurrency,
+			wantedAdaptive: true,
+		},
+		"makes one call at a time with --serial": {
+			inSerial: true,
+
+			wantedLimit: 1,
+		},
+		"fixes the limit with --parallel-envs": {
+			inParallelEnvs: 12,
+
+			wantedLimit: 12,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			vars := showAppVars{
+				shouldRunSerially: tc.inSerial,
+				parallelEnvs:      tc.inParallelEnvs,
+			}
+			opts := &showAppOpts{showAppVars: vars}
+			throttled := 0
+			limiter := newShowAppLimiter(vars, func() int { return throttled })
+
+			// WHEN
+			throttled = 3
+			limiter.acquire()
+			limiter.release()
+			limit, _ := limiter.Limit()
+
+			// THEN
+			require.Equal(t, tc.wantedLimit, opts.maxConcurrency())
+			if tc.wantedAdaptive {
+				require.Equal(t, tc.wantedLimit/2, limit, "expected the limit to back off once the calls are throttled")
+			} else {
+				require.Equal(t, tc.wantedLimit, limit, "expected the limit to stay fixed even if the calls are throttled")
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_EnvLastDeployedAt(t *testing.T) {
+	mockTime := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	opts := &showAppOpts{
+		envStacks: map[string][]cloudformation.StackDescription{
+			"test": {
+				{StackName: aws.String("my-app-test"), CreationTime: aws.Time(mockTime)},
+				{StackName: aws.String("my-app-test-api"), CreationTime: aws.Time(mockTime), LastUpdatedTime: aws.Time(mockTime.Add(48 * time.Hour))},
+				{StackName: aws.String("my-app-test-web"), CreationTime: aws.Time(mockTime.Add(24 * time.Hour))},
+			},
+			"prod": {
+				{StackName: aws.String("my-app-prod"), CreationTime: aws.Time(mockTime)},
+			},
+		},
+	}
+
+	deployedAt := opts.envLastDeployedAt([]*config.Environment{{Name: "test"}, {Name: "prod"}, {Name: "staging"}})
+
+	require.Equal(t, map[string]time.Time{
+		"test": mockTime.Add(48 * time.Hour),
+		"prod": mockTime,
+	}, deployedAt)
+}
+
+func TestShowAppOpts_CheckEnvNameCollisions(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs []*config.Environment
+
+		wantedWarnings []*describe.AppWarning
+	}{
+		"does not flag distinct names": {
+			inEnvs: []*config.Environment{
+				{Name: "test"},
+				{Name: "prod"},
+				{Name: "prod-eu"},
+			},
+		},
+		"flags the names that only differ by case": {
+			inEnvs: []*config.Environment{
+				{Name: "Prod"},
+				{Name: "test"},
+				{Name: "prod"},
+				{Name: "Test"},
+				{Name: "PROD"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityError, Message: "Environments Prod, prod and PROD of application my-app have names that only differ by case"},
+				{Severity: describe.WarningSeverityError, Message: "Environments test and Test of application my-app have names that only differ by case"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+			}
+
+			// WHEN
+			opts.checkEnvNameCollisions(tc.inEnvs)
+
+			// THEN
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_CheckTopology(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvs []*config.Environment
+
+		wantedWarnings []*describe.AppWarning
+	}{
+		"does not note environments in a few regions of the same continent": {
+			inEnvs: []*config.Environment{
+				{Name: "test", Region: "us-west-2"},
+				{Name: "staging", Region: "us-west-2"},
+				{Name: "prod", Region: "us-east-1"},
+				{Name: "canada", Region: "ca-central-1"},
+			},
+		},
+		"notes environments in too many regions": {
+			inEnvs: []*config.Environment{
+				{Name: "test", Region: "us-west-2"},
+				{Name: "staging", Region: "us-west-1"},
+				{Name: "prod", Region: "us-east-1"},
+				{Name: "dr", Region: "us-east-2"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "The environments of application my-app span 4 regions: us-west-2, us-west-1, us-east-1, us-east-2"},
+			},
+		},
+		"notes environments in several continents": {
+			inEnvs: []*config.Environment{
+				{Name: "test", Region: "us-west-2"},
+				{Name: "prod", Region: "eu-west-1"},
+				{Name: "prod-us", Region: "us-east-1"},
+				{Name: "local", Region: "xx-local-1"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "The environments of application my-app span 4 regions: us-west-2, eu-west-1, us-east-1, xx-local-1"},
+				{Severity: describe.WarningSeverityInfo, Message: "The environments of application my-app span 2 continents: North America (us-west-2, us-east-1), Europe (eu-west-1)"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+			}
+
+			// WHEN
+			opts.checkTopology(tc.inEnvs)
+
+			// THEN
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_MarkDanglingEnvs(t *testing.T) {
+	envs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	pipelines := []*codepipeline.Pipeline{
+		{
+			Name: "pipeline-main",
+			Stages: []*codepipeline.Stage{
+				{Name: "Source", Category: "Source"},
+				{Name: "DeployTo-test", Category: "Deploy"},
+				{Name: "DeployTo-prod", Category: "Deploy"},
+			},
+		},
+		{
+			Name: "pipeline-release",
+			Stages: []*codepipeline.Stage{
+				{Name: "Source", Category: "Source"},
+				{Name: "DeployTo-staging", Category: "Deploy"},
+				{Name: "DeployTo-prod", Category: "Deploy"},
+				{Name: "DeployTo-staging", Category: "Deploy"},
+				{Name: "DeployTo-dr", Category: "Deploy"},
+			},
+		},
+	}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{name: "my-app"},
+	}
+
+	opts.markDanglingEnvs(envs, pipelines)
+
+	require.Nil(t, pipelines[0].DanglingEnvs)
+	require.Equal(t, []string{"staging", "dr"}, pipelines[1].DanglingEnvs)
+	require.Equal(t, []*describe.AppWarning{
+		{Severity: describe.WarningSeverityWarning, Message: "Pipeline pipeline-release deploys to environment staging, which isn't in the application"},
+		{Severity: describe.WarningSeverityWarning, Message: "Pipeline pipeline-release deploys to environment dr, which isn't in the application"},
+	}, opts.warnings)
+}
+
+func TestShowAppOpts_PipelineArtifactStores(t *testing.T) {
+	type artifactMocks struct {
+		stores  *mocks.MockpipelineArtifactStoreGetter
+		buckets *mocks.MockbucketConfigGetter
+	}
+	testCases := map[string]struct {
+		inPipelineSource string
+		setupMocks       func(m artifactMocks)
+
+		wantedStores   map[string]*codepipeline.ArtifactStore
+		wantedWarnings []*describe.AppWarning
+	}{
+		"skips the workflows of GitHub Actions": {
+			inPipelineSource: appShowPipelineSourceGitHubActions,
+			setupMocks:       func(m artifactMocks) {},
+			wantedStores:     map[string]*codepipeline.ArtifactStore{},
+		},
+		"sets the encryption and retention of the artifacts, and flags the unencrypted ones": {
+			setupMocks: func(m artifactMocks) {
+				m.stores.EXPECT().ArtifactStore("release").Return(&codepipeline.ArtifactStore{Bucket: "my-app-bucket", EncryptionKey: "alias/release"}, nil)
+				m.buckets.EXPECT().BucketEncryption("my-app-bucket").Return("", nil)
+				m.buckets.EXPECT().BucketExpirationDays("my-app-bucket").Return(aws.Int64(30), nil)
+				m.stores.EXPECT().ArtifactStore("legacy").Return(&codepipeline.ArtifactStore{Bucket: "legacy-bucket"}, nil)
+				m.buckets.EXPECT().BucketEncryption("legacy-bucket").Return("", nil)
+				m.buckets.EXPECT().BucketExpirationDays("legacy-bucket").Return(nil, nil)
+			},
+			wantedStores: map[string]*codepipeline.ArtifactStore{
+				"release": {Bucket: "my-app-bucket", EncryptionKey: "alias/release", Encrypted: aws.Bool(true), ExpirationDays: aws.Int64(30)},
+				"legacy":  {Bucket: "legacy-bucket", Encrypted: aws.Bool(false)},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "The artifacts of pipeline legacy are not encrypted: neither the pipeline nor its bucket legacy-bucket sets an encryption key"},
+			},
+		},
+		"warns if the settings can't be retrieved": {
+			setupMocks: func(m artifactMocks) {
+				m.stores.EXPECT().ArtifactStore("release").Return(nil, errors.New("some error"))
+				m.stores.EXPECT().ArtifactStore("legacy").Return(&codepipeline.ArtifactStore{Bucket: "legacy-bucket"}, nil)
+				m.buckets.EXPECT().BucketEncryption("legacy-bucket").Return("", errors.New("access denied"))
+				m.buckets.EXPECT().BucketExpirationDays("legacy-bucket").Return(nil, errors.New("access denied"))
+			},
+			wantedStores: map[string]*codepipeline.ArtifactStore{
+				"legacy": {Bucket: "legacy-bucket"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the artifact store of pipeline release: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the encryption of artifact bucket legacy-bucket of pipeline legacy: access denied"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the lifecycle rules of artifact bucket legacy-bucket of pipeline legacy: access denied"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := artifactMocks{
+				stores:  mocks.NewMockpipelineArtifactStoreGetter(ctrl),
+				buckets: mocks.NewMockbucketConfigGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", pipelineSource: tc.inPipelineSource},
+				artifacts:   m.stores,
+				newBucketConfigGetter: func(region string) (bucketConfigGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.buckets, nil
+				},
+			}
+			pipelines := []*codepipeline.Pipeline{
+				{Name: "release", Region: "us-west-2"},
+				{Name: "legacy", Region: "us-west-2"},
+			}
+
+			// WHEN
+			opts.pipelineArtifactStores(pipelines)
+
+			// THEN
+			stores := make(map[string]*codepipeline.ArtifactStore)
+			for _, pipeline := range pipelines {
+				if pipeline.ArtifactStore != nil {
+					stores[pipeline.Name] = pipeline.ArtifactStore
+				}
+			}
+			require.Equal(t, tc.wantedStores, stores)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_CostControls(t *testing.T) {
+	type costMocks struct {
+		budgets  *mocks.MockbudgetLister
+		monitors *mocks.MockanomalyMonitorLister
+	}
+	testCases := map[string]struct {
+		setupMocks func(m costMocks)
+
+		wanted         *describe.AppCostControls
+		wantedWarnings []*describe.AppWarning
+	}{
+		"lists the budgets and monitors of the application, and flags the budgets over their limit": {
+			setupMocks: func(m costMocks) {
+				m.budgets.EXPECT().BudgetsWithTag("123456789012", deploy.AppTagKey, "my-app").Return([]*budgets.Budget{
+					{Name: "monthly", TimeUnit: "MONTHLY", Unit: "USD", Limit: 100, Actual: 42.5, Forecast: aws.Float64(90)},
+					{Name: "daily", TimeUnit: "DAILY", Unit: "USD", Limit: 10, Actual: 12},
+				}, nil)
+				m.monitors.EXPECT().AnomalyMonitorsWithTag(deploy.AppTagKey, "my-app").Return([]*costexplorer.AnomalyMonitor{
+					{Name: "my-app", ARN: "arn:aws:ce::123456789012:anomalymonitor/abc", LastEvaluated: "2021-06-01"},
+				}, nil)
+			},
+			wanted: &describe.AppCostControls{
+				Budgets: []*describe.AppBudget{
+					{Name: "monthly", Period: "MONTHLY", Unit: "USD", Limit: 100, Actual: 42.5, Forecast: aws.Float64(90)},
+					{Name: "daily", Period: "DAILY", Unit: "USD", Limit: 10, Actual: 12},
+				},
+				AnomalyMonitors: []*describe.AppAnomalyMonitor{
+					{Name: "my-app", ARN: "arn:aws:ce::123456789012:anomalymonitor/abc", LastEvaluated: "2021-06-01"},
+				},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Budget daily of application my-app is over its limit: 12.00 of 10.00 USD spent"},
+			},
+		},
+		"warns if the cost controls can't be listed": {
+			setupMocks: func(m costMocks) {
+				m.budgets.EXPECT().BudgetsWithTag("123456789012", deploy.AppTagKey, "my-app").Return(nil, errors.New("access denied"))
+				m.monitors.EXPECT().AnomalyMonitorsWithTag(deploy.AppTagKey, "my-app").Return(nil, errors.New("some error"))
+			},
+			wanted: &describe.AppCostControls{
+				Budgets:         []*describe.AppBudget{},
+				AnomalyMonitors: []*describe.AppAnomalyMonitor{},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't list the budgets of application my-app: access denied"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't list the cost anomaly monitors of application my-app: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := costMocks{
+				budgets:  mocks.NewMockbudgetLister(ctrl),
+				monitors: mocks.NewMockanomalyMonitorLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				budgets:     m.budgets,
+				monitors:    m.monitors,
+			}
+
+			// WHEN
+			got := opts.costControls(&config.Application{Name: "my-app", AccountID: "123456789012"})
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_CheckSessionRegion(t *testing.T) {
+	mockEnvs := []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "staging", Region: "us-east-1"},
+		{Name: "prod", Region: "us-east-1"},
+	}
+	const confirmation = "Environments staging and prod are in us-east-1 rather than in the region us-west-2 of your session. Describe them with sessions in their own regions?"
+	testCases := map[string]struct {
+		inEnvs       []*config.Environment
+		inSessRegion string
+		inStrict     bool
+		setupMocks   func(m *mocks.Mockprompter)
+
+		wantedWarnings []*describe.AppWarning
+		wantedError    error
+	}{
+		"does nothing if the region of the session is unknown": {
+			setupMocks: func(m *mocks.Mockprompter) {},
+		},
+		"does nothing if all the environments are in the region of the session": {
+			inEnvs:       []*config.Environment{{Name: "test", Region: "us-west-2"}, {Name: "local"}},
+			inSessRegion: "us-west-2",
+			inStrict:     true,
+			setupMocks:   func(m *mocks.Mockprompter) {},
+		},
+		"notes the environments in other regions": {
+			inSessRegion: "eu-west-1",
+			setupMocks:   func(m *mocks.Mockprompter) {},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Environments test, staging and prod are in us-west-2 and us-east-1 rather than in the region eu-west-1 of your session: the calls to them are made with sessions in their own regions"},
+			},
+		},
+		"notes a single environment in another region": {
+			inEnvs:       []*config.Environment{{Name: "test", Region: "us-west-2"}},
+			inSessRegion: "us-east-1",
+			setupMocks:   func(m *mocks.Mockprompter) {},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Environment test is in us-west-2 rather than in the region us-east-1 of your session: the calls to them are made with sessions in their own regions"},
+			},
+		},
+		"describes the environments in other regions once confirmed with --strict": {
+			inSessRegion: "us-west-2",
+			inStrict:     true,
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(confirmation, gomock.Any()).Return(true, nil)
+			},
+		},
+		"errors if describing the environments in other regions isn't confirmed with --strict": {
+			inSessRegion: "us-west-2",
+			inStrict:     true,
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(confirmation, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errors.New("describing the environments in other regions than the region us-west-2 of your session must be confirmed with --strict: staging and prod"),
+		},
+		"errors if the confirmation fails with --strict": {
+			inSessRegion: "us-west-2",
+			inStrict:     true,
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(confirmation, gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("confirm describing the environments in other regions than us-west-2: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompter)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", isStrict: tc.inStrict},
+				prompt:      mockPrompter,
+				sessRegion:  tc.inSessRegion,
+			}
+
+			envs := mockEnvs
+			if tc.inEnvs != nil {
+				envs = tc.inEnvs
+			}
+
+			// WHEN
+			err := opts.checkSessionRegion(envs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_ManuallyDeployed(t *testing.T) {
+	mockSvcs := []*config.Workload{{Name: "api"}, {Name: "web"}, {Name: "worker"}, {Name: "draft"}}
+	mockDeployments := []*describe.AppDeployment{
+		{Service: "api", Environment: "test"},
+		{Service: "api", Environment: "prod"},
+		{Service: "web", Environment: "test"},
+		{Service: "worker", Environment: "test"},
+	}
+	testCases := map[string]struct {
+		inPipelines []*codepipeline.Pipeline
+
+		wantedManual   []string
+		wantedWarnings []*describe.AppWarning
+	}{
+		"skips an application without pipelines": {},
+		"flags the deployed services that no pipeline deploys": {
+			inPipelines: []*codepipeline.Pipeline{
+				{
+					Name: "pipeline-my-app",
+					Stages: []*codepipeline.Stage{
+						{Name: "Source", Category: "Source"},
+						{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-api"}},
+					},
+				},
+				{
+					Name: "deploy",
+					Stages: []*codepipeline.Stage{
+						{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-web"}},
+					},
+				},
+			},
+			wantedManual: []string{"worker"},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Service worker isn't deployed by any pipeline and may be deployed manually"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+			}
+
+			// WHEN
+			manual := opts.manuallyDeployed(mockSvcs, tc.inPipelines, mockDeployments)
+
+			// THEN
+			require.Equal(t, tc.wantedManual, manual)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_PipelineStages(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	mockSvcs := []*config.Workload{{Name: "api"}, {Name: "db"}}
+	testCases := map[string]struct {
+		inPipelines []*codepipeline.Pipeline
+
+		wantedStages []*describe.AppPipelineStages
+	}{
+		"skips an application without pipelines": {},
+		"matches the stacks deployed by each stage to the services": {
+			inPipelines: []*codepipeline.Pipeline{
+				{
+					Name: "pipeline-my-app",
+					Stages: []*codepipeline.Stage{
+						{Name: "Source", Category: "Source"},
+						{Name: "DeployTo-test", Category: "Deploy", Deploys: []*codepipeline.StageDeploy{
+							{StackName: "my-app-test-db", RunOrder: 1},
+							{StackName: "my-app-test-api", RunOrder: 2},
+						}},
+						{Name: "DeployTo-prod", Category: "Deploy", Deploys: []*codepipeline.StageDeploy{
+							{StackName: "my-app-prod-api", RunOrder: 1},
+							{StackName: "my-app-prod", RunOrder: 1},
+							{StackName: "other-app-prod-api", RunOrder: 1},
+						}},
+					},
+				},
+				{Name: "empty"},
+			},
+			wantedStages: []*describe.AppPipelineStages{
+				{
+					Pipeline: "pipeline-my-app",
+					Stages: []*describe.AppPipelineStage{
+						{Name: "Source", Category: "Source"},
+						{Name: "DeployTo-test", Category: "Deploy", Deploys: []*describe.AppPipelineDeploy{
+							{Service: "db", Environment: "test", RunOrder: 1},
+							{Service: "api", Environment: "test", RunOrder: 2},
+						}},
+						{Name: "DeployTo-prod", Category: "Deploy", Deploys: []*describe.AppPipelineDeploy{
+							{Service: "api", Environment: "prod", RunOrder: 1},
+						}},
+					},
+				},
+				{Pipeline: "empty", Stages: []*describe.AppPipelineStage{}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+			}
+
+			// WHEN
+			stages := opts.pipelineStages(mockEnvs, mockSvcs, tc.inPipelines)
+
+			// THEN
+			require.Equal(t, tc.wantedStages, stages)
+		})
+	}
+}
+
+func TestShowAppOpts_Owner(t *testing.T) {
+	testCases := map[string]struct {
+		inTags        map[string]string
+		inOwnerTagKey string
+		inStrict      bool
+
+		wantedOwner    string
+		wantedWarnings []*describe.AppWarning
+	}{
+		"reads the owner tag by default": {
+			inTags:      map[string]string{"owner": "platform-team"},
+			wantedOwner: "platform-team",
+		},
+		"reads the owner from the tag key": {
+			inTags:        map[string]string{"owner": "platform-team", "team": "payments"},
+			inOwnerTagKey: "team",
+			wantedOwner:   "payments",
+		},
+		"is unowned without the tag": {
+			inTags:        map[string]string{"owner": "platform-team"},
+			inOwnerTagKey: "team",
+			wantedOwner:   "unowned",
+		},
+		"warns about an unowned app with strict": {
+			inStrict:    true,
+			wantedOwner: "unowned",
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Application my-app has no owner tag to tell who owns it"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:        "my-app",
+					ownerTagKey: tc.inOwnerTagKey,
+					isStrict:    tc.inStrict,
+				},
+			}
+
+			// WHEN
+			owner := opts.owner(&config.Application{Name: "my-app", Tags: tc.inTags})
+
+			// THEN
+			require.Equal(t, tc.wantedOwner, owner)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_Alarms(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}}
+	mockSvcs := []*config.Workload{
+		{Name: "api", Type: "Load Balanced Web Service"},
+		{Name: "worker", Type: "Worker Service"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedAlarms   map[string][]*describe.AppAlarm
+		wantedWarnings []*describe.AppWarning
+	}{
+		"lists the alarms of the service stack and of its addons stack": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::CloudWatch::Alarm"), PhysicalResourceId: aws.String("my-app-test-api-HighCPU")},
+					{LogicalResourceId: aws.String("AddonsStack"), ResourceType: aws.String("AWS::CloudFormation::Stack"), PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test-api-AddonsStack/1234")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test-api-AddonsStack/1234").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::CloudWatch::Alarm"), PhysicalResourceId: aws.String("my-app-test-api-5xx")},
+				}, nil)
+				m.alarmGetter.EXPECT().AlarmStatus([]string{"my-app-test-api-HighCPU", "my-app-test-api-5xx"}).Return([]cloudwatch.AlarmStatus{
+					{Name: "my-app-test-api-HighCPU", Type: "Metric", Metric: "CPUUtilization", Threshold: aws.Float64(80)},
+					{Name: "my-app-test-api-5xx", Type: "Composite"},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-worker").Return(nil, nil)
+			},
+			wantedAlarms: map[string][]*describe.AppAlarm{
+				"api": {
+					{Name: "my-app-test-api-5xx"},
+					{Name: "my-app-test-api-HighCPU", Metric: "CPUUtilization", Threshold: aws.Float64(80)},
+				},
+			},
+		},
+		"flags the public-facing services without alarms": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return(nil, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-worker").Return(nil, nil)
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Public-facing service api in environment test has no alarms"},
+			},
+		},
+		"warns if the alarms can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::CloudWatch::Alarm"), PhysicalResourceId: aws.String("my-app-test-api-HighCPU")},
+				}, nil)
+				m.alarmGetter.EXPECT().AlarmStatus([]string{"my-app-test-api-HighCPU"}).Return(nil, errors.New("some error"))
+				m.stackResources.EXPECT().StackResources("my-app-test-worker").Return(nil, errors.New("some error"))
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the alarms of service api in environment test: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the alarms of service worker in environment test: get resources of service worker in environment test: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				stackResources: mocks.NewMockstackResourcesGetter(ctrl),
+				alarmGetter:    mocks.NewMockalarmStatusGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				failing:     make(map[workloadInEnv]bool),
+				resources:   make(map[workloadInEnv][]*cloudformation.StackResource),
+				newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+					return m.stackResources, nil
+				},
+				newAlarmGetter: func(_ *config.Environment) (alarmStatusGetter, error) {
+					return m.alarmGetter, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "worker", Environment: "test"},
+			}
+
+			// WHEN
+			opts.alarms(mockEnvs, mockSvcs, deployments)
+
+			// THEN
+			alarms := make(map[string][]*describe.AppAlarm)
+			for _, deployment := range deployments {
+				if deployment.Alarms != nil {
+					alarms[deployment.Service] = deployment.Alarms
+				}
+			}
+			if tc.wantedAlarms == nil {
+				tc.wantedAlarms = make(map[string][]*describe.AppAlarm)
+			}
+			require.Equal(t, tc.wantedAlarms, alarms)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_Storage(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedStorage  map[string][]*describe.AppVolume
+		wantedWarnings []*describe.AppWarning
+	}{
+		"lists the EFS volumes of the services and nothing for the stateless ones": {
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(&awsecs.TaskDefinition{}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-db").Return(&awsecs.TaskDefinition{
+					Volumes: []*ecs.Volume{
+						{
+							Name: aws.String("data"),
+							EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+								FileSystemId: aws.String("fs-1234"),
+								AuthorizationConfig: &ecs.EFSAuthorizationConfig{
+									AccessPointId: aws.String("fsap-5678"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedStorage: map[string][]*describe.AppVolume{
+				"db": {
+					{Name: "data", FileSystemID: "fs-1234", AccessPointID: "fsap-5678"},
+				},
+			},
+		},
+		"warns if the task definition can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(nil, errors.New("some error"))
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-db").Return(&awsecs.TaskDefinition{}, nil)
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the storage of service api in environment test: get task definition of service api in environment test: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				taskDefGetter: mocks.NewMocktaskDefinitionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				taskDefs:    make(map[workloadInEnv]*awsecs.TaskDefinition),
+				newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+					return m.taskDefGetter, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "db", Environment: "test"},
+				{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+			}
+
+			// WHEN
+			opts.storage(mockEnvs, deployments)
+
+			// THEN
+			storage := make(map[string][]*describe.AppVolume)
+			for _, deployment := range deployments {
+				if deployment.Storage != nil {
+					storage[deployment.Service] = deployment.Storage
+				}
+			}
+			if tc.wantedStorage == nil {
+				tc.wantedStorage = make(map[string][]*describe.AppVolume)
+			}
+			require.Equal(t, tc.wantedStorage, storage)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_DeploymentControllers(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}}
+	mockResources := func(svc string) []*cloudformation.StackResource {
+		return []*cloudformation.StackResource{
+			{ResourceType: aws.String("AWS::ECS::TaskDefinition"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/my-app-test-" + svc + ":1")},
+			{ResourceType: aws.String("AWS::ECS::Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789:service/my-app-test-Cluster/my-app-test-" + svc)},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedControllers map[string]string
+		wantedOffline     map[string]string
+		wantedWarnings    []*describe.AppWarning
+	}{
+		"sets the controller of the rolling and blue/green deployments": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return(mockResources("api"), nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-web").Return(mockResources("web"), nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-api").Return(&awsecs.Service{}, nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-web").Return(&awsecs.Service{
+					DeploymentController: &ecs.DeploymentController{Type: aws.String(ecs.DeploymentControllerTypeCodeDeploy)},
+					TaskSets: []*ecs.TaskSet{
+						{StabilityStatus: aws.String(ecs.StabilityStatusSteadyState)},
+						{StabilityStatus: aws.String(ecs.StabilityStatusStabilizing)},
+					},
+				}, nil)
+			},
+			wantedControllers: map[string]string{
+				"api": "ECS",
+				"web": "CODE_DEPLOY (IN_PROGRESS)",
+			},
+		},
+		"marks the services scaled to zero as offline": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return(mockResources("api"), nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-web").Return(mockResources("web"), nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-api").Return(&awsecs.Service{DesiredCount: aws.Int64(0)}, nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-web").Return(&awsecs.Service{DesiredCount: aws.Int64(2)}, nil)
+			},
+			wantedControllers: map[string]string{
+				"api": "ECS",
+				"web": "ECS",
+			},
+			wantedOffline: map[string]string{
+				"api": describe.ServiceScaledToZero,
+			},
+		},
+		"warns if the service can't be described": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test-api").Return(nil, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-web").Return(mockResources("web"), nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-web").Return(nil, errors.New("some error"))
+			},
+			wantedControllers: map[string]string{
+				"api": "unknown",
+				"web": "unknown",
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the deployment controller of service api in environment test: stack my-app-test-api has no Amazon ECS service"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the deployment controller of service web in environment test: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				stackResources: mocks.NewMockstackResourcesGetter(ctrl),
+				ecsServices:    mocks.NewMockecsServiceDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				resources:   make(map[workloadInEnv][]*cloudformation.StackResource),
+				newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+					return m.stackResources, nil
+				},
+				newECSServiceDescriber: func(_ *config.Environment) (ecsServiceDescriber, error) {
+					return m.ecsServices, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "web", Environment: "test"},
+				{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+			}
+
+			// WHEN
+			opts.deploymentControllers(mockEnvs, deployments)
+
+			// THEN
+			controllers := make(map[string]string)
+			for _, deployment := range deployments {
+				if deployment.DeploymentController == "" {
+					continue
+				}
+				controllers[deployment.Service] = deployment.DeploymentController
+				if deployment.DeploymentState != "" {
+					controllers[deployment.Service] += " (" + deployment.DeploymentState + ")"
+				}
+			}
+			require.Equal(t, tc.wantedControllers, controllers)
+			offline := make(map[string]string)
+			for _, deployment := range deployments {
+				if deployment.Offline != "" {
+					offline[deployment.Service] = deployment.Offline
+				}
+			}
+			if tc.wantedOffline == nil {
+				tc.wantedOffline = make(map[string]string)
+			}
+			require.Equal(t, tc.wantedOffline, offline)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_OfflineServices(t *testing.T) {
+	// GIVEN
+	opts := &showAppOpts{}
+	deployments := []*describe.AppDeployment{
+		{Service: "api", Environment: "test", Offline: describe.ServiceScaledToZero},
+		{Service: "api", Environment: "prod"},
+		{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+		{Service: "frontend", Environment: "prod", TaskDefinition: describe.TaskDefinitionNotApplicable},
+	}
+	appRunnerSvcs := []*describe.AppRunnerService{
+		{Service: "frontend", Environment: "test", Status: "PAUSED"},
+		{Service: "frontend", Environment: "prod", Status: "RUNNING"},
+	}
+
+	// WHEN
+	opts.offlineServices(deployments, appRunnerSvcs)
+
+	// THEN
+	require.Equal(t, describe.ServicePaused, deployments[2].Offline)
+	require.Empty(t, deployments[3].Offline)
+	require.Equal(t, []*describe.AppWarning{
+		{Severity: describe.WarningSeverityWarning, Message: "Service api in environment test is scaled to zero and doesn't serve any traffic"},
+		{Severity: describe.WarningSeverityWarning, Message: "Service frontend in environment test is paused and doesn't serve any traffic"},
+	}, opts.warnings)
+	require.Empty(t, opts.failing, "expected the offline services not to be failing")
+}
+
+const serviceConnectTemplate = `Parameters:
+  AppName:
+    Type: String
+  EnvName:
+    Type: String
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      Cluster:
+        Fn::ImportValue: !Sub '${AppName}-${EnvName}-ClusterId'
+      ServiceConnectConfiguration:
+        Enabled: true
+        Namespace: !Sub '${EnvName}.${AppName}.local'
+        Services:
+          - PortName: api-http
+            ClientAliases:
+              - Port: 8080
+          - PortName: api-grpc
+            DiscoveryName: grpc
+            ClientAliases:
+              - DnsName: grpc.internal
+                Port: 9090
+              - Port: 9091
+`
+
+func TestShowAppOpts_ServiceConnect(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wanted         []*describe.AppServiceConnect
+		wantedWarnings []*describe.AppWarning
+	}{
+		"reads the configuration of the services that enabled service connect": {
+			setupMocks: func(m showAppMocks) {
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-api").Return(serviceConnectTemplate, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-web").Return(`Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      ServiceConnectConfiguration:
+        Enabled: true`, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-worker").Return(`Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      ServiceConnectConfiguration: !If
+        - IsConnected
+        - Enabled: true
+        - !Ref AWS::NoValue`, nil)
+			},
+			wanted: []*describe.AppServiceConnect{
+				{
+					Service:     "api",
+					Environment: "test",
+					Namespace:   "test.my-app.local",
+					Endpoints: []*describe.AppServiceConnectEndpoint{
+						{PortName: "api-http", Aliases: []string{"api-http:8080"}},
+						{PortName: "api-grpc", Aliases: []string{"grpc.internal:9090", "grpc:9091"}},
+					},
+				},
+				{Service: "web", Environment: "test"},
+			},
+		},
+		"skips the services that disabled service connect": {
+			setupMocks: func(m showAppMocks) {
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-api").Return(`Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      ServiceConnectConfiguration:
+        Enabled: false
+        Namespace: test.my-app.local`, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-web").Return("Resources: {}", nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-worker").Return("", nil)
+			},
+		},
+		"warns if the template can't be read": {
+			setupMocks: func(m showAppMocks) {
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-api").Return("", errors.New("some error"))
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-web").Return("Resources: [", nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-worker").Return(serviceConnectTemplate, nil)
+			},
+			wanted: []*describe.AppServiceConnect{
+				{
+					Service:     "worker",
+					Environment: "test",
+					Namespace:   "test.my-app.local",
+					Endpoints: []*describe.AppServiceConnectEndpoint{
+						{PortName: "api-http", Aliases: []string{"api-http:8080"}},
+						{PortName: "api-grpc", Aliases: []string{"grpc.internal:9090", "grpc:9091"}},
+					},
+				},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the Service Connect configuration of service api in environment test: get template of stack my-app-test-api: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the Service Connect configuration of service web in environment test: read Service Connect configuration in template of stack my-app-test-web: yaml: line 1: did not find expected node content"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				templateGetter: mocks.NewMockstackTemplateGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				newTemplateGetter: func(_ *config.Environment) (stackTemplateGetter, error) {
+					return m.templateGetter, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "web", Environment: "test"},
+				{Service: "worker", Environment: "test"},
+				{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+			}
+
+			// WHEN
+			got := opts.serviceConnect(mockEnvs, deployments)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_InsecureEndpoints(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	mockSvcs := []*config.Workload{
+		{Name: "api", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "web", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "worker", Type: manifest.BackendServiceType},
+	}
+	mockDeployments := []*describe.AppDeployment{
+		{Service: "api", Environment: "test"},
+		{Service: "api", Environment: "prod"},
+		{Service: "web", Environment: "test"},
+		{Service: "worker", Environment: "test"},
+	}
+	httpsParam := func(enabled string) []*sdkcloudformation.Parameter {
+		return []*sdkcloudformation.Parameter{
+			{ParameterKey: aws.String("HTTPSEnabled"), ParameterValue: aws.String(enabled)},
+			{ParameterKey: aws.String("RulePath"), ParameterValue: aws.String("api")},
+		}
+	}
+	testCases := map[string]struct {
+		inEnvStacks map[string][]cloudformation.StackDescription
+
+		wanted         []*describe.AppInsecureEndpoint
+		wantedWarnings []*describe.AppWarning
+	}{
+		"flags the load balanced services that only serve HTTP": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {
+					{StackName: aws.String("my-app-test"), Outputs: []*sdkcloudformation.Output{
+						{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("my-lb.us-west-2.elb.amazonaws.com")},
+					}},
+					{StackName: aws.String("my-app-test-api"), Parameters: httpsParam("false")},
+					{StackName: aws.String("my-app-test-web")},
+					{StackName: aws.String("my-app-test-worker"), Parameters: httpsParam("false")},
+				},
+				"prod": {
+					{StackName: aws.String("my-app-prod-api"), Parameters: httpsParam("true")},
+				},
+			},
+			wanted: []*describe.AppInsecureEndpoint{
+				{Service: "api", Environment: "test", URL: "http://my-lb.us-west-2.elb.amazonaws.com/api"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Service api in environment test is public but only serves HTTP: its load balancer has no HTTPS listener nor redirect to HTTPS"},
+			},
+		},
+		"leaves the url out if the load balancer isn't in the outputs of the environment stack": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"prod": {
+					{StackName: aws.String("my-app-prod-api"), Parameters: httpsParam("false")},
+				},
+			},
+			wanted: []*describe.AppInsecureEndpoint{
+				{Service: "api", Environment: "prod"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Service api in environment prod is public but only serves HTTP: its load balancer has no HTTPS listener nor redirect to HTTPS"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				envStacks:   tc.inEnvStacks,
+			}
+
+			// WHEN
+			got := opts.insecureEndpoints(mockEnvs, mockSvcs, mockDeployments)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_Endpoints(t *testing.T) {
+	// GIVEN
+	opts := &showAppOpts{
+		showAppVars: showAppVars{name: "my-app"},
+		envStacks: map[string][]cloudformation.StackDescription{
+			"test": {
+				{StackName: aws.String("my-app-test"), Outputs: []*sdkcloudformation.Output{
+					{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("my-lb.us-west-2.elb.amazonaws.com")},
+				}},
+				{StackName: aws.String("my-app-test-api"), Parameters: []*sdkcloudformation.Parameter{
+					{ParameterKey: aws.String("HTTPSEnabled"), ParameterValue: aws.String("false")},
+					{ParameterKey: aws.String("RulePath"), ParameterValue: aws.String("api")},
+				}},
+			},
+			"prod": {
+				{StackName: aws.String("my-app-prod"), Outputs: []*sdkcloudformation.Output{
+					{OutputKey: aws.String("PublicLoadBalancerDNSName"), OutputValue: aws.String("my-prod-lb.us-west-2.elb.amazonaws.com")},
+					{OutputKey: aws.String("EnvironmentSubdomain"), OutputValue: aws.String("prod.my-app.example.com")},
+				}},
+				{StackName: aws.String("my-app-prod-api"), Parameters: []*sdkcloudformation.Parameter{
+					{ParameterKey: aws.String("HTTPSEnabled"), ParameterValue: aws.String("true")},
+					{ParameterKey: aws.String("RulePath"), ParameterValue: aws.String("/")},
+				}},
+			},
+		},
+	}
+	svcs := []*config.Workload{
+		{Name: "api", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "frontend", Type: manifest.RequestDrivenWebServiceType},
+		{Name: "worker", Type: manifest.BackendServiceType},
+	}
+	deployments := []*describe.AppDeployment{
+		{Service: "api", Environment: "test"},
+		{Service: "api", Environment: "prod"},
+		{Service: "frontend", Environment: "prod"},
+		{Service: "worker", Environment: "test"},
+	}
+	appRunnerSvcs := []*describe.AppRunnerService{
+		{Service: "frontend", Environment: "prod", URL: "abc.us-west-2.awsapprunner.com", CustomDomains: []*describe.AppRunnerCustomDomain{
+			{DomainName: "example.com", Status: "ACTIVE"},
+		}},
+	}
+
+	// WHEN
+	opts.endpoints(svcs, deployments, appRunnerSvcs)
+
+	// THEN
+	require.Equal(t, []*describe.AppEndpoint{
+		{URL: "http://my-lb.us-west-2.elb.amazonaws.com/api", Kind: describe.EndpointLoadBalancer},
+	}, deployments[0].Endpoints)
+	require.Equal(t, []*describe.AppEndpoint{
+		{URL: "https://api.prod.my-app.example.com", Kind: describe.EndpointCustomDomain},
+		{URL: "https://my-prod-lb.us-west-2.elb.amazonaws.com", Kind: describe.EndpointLoadBalancer},
+	}, deployments[1].Endpoints, "expected the domain and the load balancer not to be collapsed into one endpoint")
+	require.Equal(t, []*describe.AppEndpoint{
+		{URL: "https://abc.us-west-2.awsapprunner.com", Kind: describe.EndpointAppRunner},
+		{URL: "https://example.com", Kind: describe.EndpointCustomDomain},
+	}, deployments[2].Endpoints)
+	require.Empty(t, deployments[3].Endpoints)
+}
+
+func TestAllocationValue(t *testing.T) {
+	testCases := map[string]struct {
+		inValue string
+		inUnit  string
+
+		wanted string
+	}{
+		"keeps CPU units as is":              {inValue: "1024", inUnit: "vCPU", wanted: "1024"},
+		"converts vCPUs to CPU units":        {inValue: "0.25 vCPU", inUnit: "vCPU", wanted: "256"},
+		"converts GB to MiB":                 {inValue: "2 GB", inUnit: "GB", wanted: "2048"},
+		"is unknown if empty":                {inValue: "", wanted: "unknown"},
+		"keeps a value that can't be parsed": {inValue: "many GB", inUnit: "GB", wanted: "many GB"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, allocationValue(tc.inValue, tc.inUnit))
+		})
+	}
+}
+
+func TestShowAppOpts_EnvNamespaces(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	testCases := map[string]struct {
+		inEnvStacks map[string][]cloudformation.StackDescription
+
+		wanted map[string]*describe.AppEnvNamespace
+	}{
+		"reads the namespace from the outputs of the environment stacks": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {
+					{StackName: aws.String("my-app-test-api"), Outputs: []*sdkcloudformation.Output{
+						{OutputKey: aws.String("ServiceDiscoveryNamespaceID"), OutputValue: aws.String("ns-api")},
+					}},
+					{StackName: aws.String("my-app-test"), Outputs: []*sdkcloudformation.Output{
+						{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1234")},
+						{OutputKey: aws.String("ServiceDiscoveryNamespaceID"), OutputValue: aws.String("ns-1234")},
+					}},
+				},
+				"prod": {{StackName: aws.String("my-app-prod")}},
+			},
+			wanted: map[string]*describe.AppEnvNamespace{
+				"test": {Name: "my-app.local", ID: "ns-1234"},
+			},
+		},
+		"returns nil if no environment stack has a namespace": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {{StackName: aws.String("my-app-test")}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				envStacks:   tc.inEnvStacks,
+			}
+
+			// WHEN
+			got := opts.envNamespaces(mockEnvs)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestShowAppOpts_TerminationProtection(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod", Prod: true}, {Name: "staging", Prod: true}}
+	testCases := map[string]struct {
+		inEnvStacks map[string][]cloudformation.StackDescription
+		setupMocks  func(m showAppMocks)
+
+		wanted         map[string]bool
+		wantedWarnings []*describe.AppWarning
+	}{
+		"flags the production environments without termination protection": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {
+					{StackName: aws.String("my-app-test")},
+					{StackName: aws.String("my-app-test-api"), EnableTerminationProtection: aws.Bool(true)},
+				},
+				"prod":    {{StackName: aws.String("my-app-prod"), EnableTerminationProtection: aws.Bool(false)}},
+				"staging": {{StackName: aws.String("my-app-staging"), EnableTerminationProtection: aws.Bool(true)}},
+			},
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(&cloudformation.StackDescription{
+					EnableTerminationProtection: aws.Bool(true),
+				}, nil)
+			},
+			wanted: map[string]bool{
+				"my-app-infrastructure-roles": true,
+				"my-app-test":                 false,
+				"my-app-prod":                 false,
+				"my-app-staging":              true,
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Termination protection is disabled on stack my-app-prod of production environment prod"},
+			},
+		},
+		"skips the stacks that don't exist": {
+			inEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {{StackName: aws.String("my-app-test")}},
+			},
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+			wanted: map[string]bool{
+				"my-app-test": false,
+			},
+		},
+		"warns if the stack of the application can't be described": {
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, errors.New("some error"))
+			},
+			wanted: map[string]bool{},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the termination protection of stack my-app-infrastructure-roles: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				appStacks: mocks.NewMockstackDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				appStacks:   m.appStacks,
+				envStacks:   tc.inEnvStacks,
+			}
+
+			// WHEN
+			got := opts.terminationProtection(mockEnvs)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestIsAccountUnreachableErr(t *testing.T) {
+	testCases := map[string]struct {
+		inErr  error
+		wanted bool
+	}{
+		"false for an error that isn't from AWS": {
+			inErr: errors.New("some error"),
+		},
+		"false for a missing permission on a resource": {
+			inErr: fmt.Errorf("list stacks in environment test: %w", awserr.New("AccessDenied", "User: arn:aws:iam::123456789012:user/alice is not authorized to perform: cloudformation:DescribeStacks", nil)),
+		},
+		"true if the role can't be assumed": {
+			inErr:  fmt.Errorf("list stacks in environment test: %w", awserr.New("AccessDenied", "User: arn:aws:iam::123456789012:user/alice is not authorized to perform: sts:AssumeRole", nil)),
+			wanted: true,
+		},
+		"true if the credentials aren't valid in the account": {
+			inErr:  awserr.New("InvalidClientTokenId", "The security token included in the request is invalid.", nil),
+			wanted: true,
+		},
+		"true if the region is disabled in the account": {
+			inErr:  awserr.New("RegionDisabledException", "STS is not activated in this region for account 111111111111.", nil),
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, isAccountUnreachableErr(tc.inErr))
+		})
+	}
+}
+
+func TestIsTransientErr(t *testing.T) {
+	testCases := map[string]struct {
+		inErr  error
+		wanted bool
+	}{
+		"false for an error that isn't from AWS": {
+			inErr: errors.New("some error"),
+		},
+		"false for a missing permission": {
+			inErr: awserr.New("AccessDeniedException", "User is not authorized to perform: ssm:GetParameter", nil),
+		},
+		"true if the request is throttled": {
+			inErr:  fmt.Errorf("get application my-app: %w", awserr.New("ThrottlingException", "Rate exceeded", nil)),
+			wanted: true,
+		},
+		"true if the request timed out": {
+			inErr:  awserr.New("RequestTimeout", "The request timed out", nil),
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, isTransientErr(tc.inErr))
+		})
+	}
+}
+
+func TestShowAppOpts_DomainConflicts(t *testing.T) {
+	mockSvcs := []*config.Workload{
+		{Name: "api", Type: "Load Balanced Web Service"},
+		{Name: "frontend", Type: "Request-Driven Web Service"},
+		{Name: "web", Type: "Request-Driven Web Service"},
+	}
+	mockDeployments := []*describe.AppDeployment{
+		{Service: "api", Environment: "test"},
+		{Service: "frontend", Environment: "test"},
+		{Service: "web", Environment: "test"},
+	}
+	testCases := map[string]struct {
+		inDomain        string
+		inAppRunnerSvcs []*describe.AppRunnerService
+
+		wantedConflicts []*describe.AppDomainConflict
+		wantedWarnings  []*describe.AppWarning
+		wantedFailing   map[workloadInEnv]bool
+	}{
+		"no conflicts if every domain is claimed once": {
+			inDomain: "example.com",
+			inAppRunnerSvcs: []*describe.AppRunnerService{
+				{Service: "frontend", Environment: "test", CustomDomains: []*describe.AppRunnerCustomDomain{{DomainName: "www.example.com"}}},
+				{Service: "frontend", Environment: "prod", CustomDomains: []*describe.AppRunnerCustomDomain{{DomainName: "staging.example.com"}}},
+			},
+		},
+		"flags the custom domains claimed by several services": {
+			inAppRunnerSvcs: []*describe.AppRunnerService{
+				{Service: "frontend", Environment: "test", CustomDomains: []*describe.AppRunnerCustomDomain{{DomainName: "www.example.com"}, {DomainName: "shop.example.com"}}},
+				{Service: "web", Environment: "test", CustomDomains: []*describe.AppRunnerCustomDomain{{DomainName: "WWW.example.com."}}},
+			},
+			wantedConflicts: []*describe.AppDomainConflict{
+				{Domain: "www.example.com", Claims: []*describe.AppDomainClaim{
+					{Service: "frontend", Environment: "test"},
+					{Service: "web", Environment: "test"},
+				}},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityError, Message: "Domain www.example.com is claimed by several services, only one of them receives its traffic: frontend in environment test, web in environment test"},
+			},
+			wantedFailing: map[workloadInEnv]bool{
+				{env: "test", workload: "frontend"}: true,
+				{env: "test", workload: "web"}:      true,
+			},
+		},
+		"flags a custom domain that is the domain of a load balanced service": {
+			inDomain: "example.com",
+			inAppRunnerSvcs: []*describe.AppRunnerService{
+				{Service: "frontend", Environment: "test", CustomDomains: []*describe.AppRunnerCustomDomain{{DomainName: "api.test.my-app.example.com"}}},
+			},
+			wantedConflicts: []*describe.AppDomainConflict{
+				{Domain: "api.test.my-app.example.com", Claims: []*describe.AppDomainClaim{
+					{Service: "api", Environment: "test"},
+					{Service: "frontend", Environment: "test"},
+				}},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityError, Message: "Domain api.test.my-app.example.com is claimed by several services, only one of them receives its traffic: api in environment test, frontend in environment test"},
+			},
+			wantedFailing: map[workloadInEnv]bool{
+				{env: "test", workload: "api"}:      true,
+				{env: "test", workload: "frontend"}: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				failing:     make(map[workloadInEnv]bool),
+			}
+
+			// WHEN
+			conflicts := opts.domainConflicts(&config.Application{Name: "my-app", Domain: tc.inDomain}, mockSvcs, mockDeployments, tc.inAppRunnerSvcs)
+
+			// THEN
+			require.Equal(t, tc.wantedConflicts, conflicts)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+			if tc.wantedFailing == nil {
+				tc.wantedFailing = make(map[workloadInEnv]bool)
+			}
+			require.Equal(t, tc.wantedFailing, opts.failing)
+		})
+	}
+}
+
+func TestShowAppOpts_WebACLs(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	mockSvcs := []*config.Workload{
+		{Name: "api", Type: "Load Balanced Web Service"},
+		{Name: "web", Type: "Load Balanced Web Service"},
+		{Name: "frontend", Type: "Request-Driven Web Service"},
+		{Name: "worker", Type: "Worker Service"},
+	}
+	mockLBARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-app-test/1234"
+	testCases := map[string]struct {
+		inStrict   bool
+		setupMocks func(m showAppMocks)
+
+		wantedACLs     map[string]string
+		wantedWarnings []*describe.AppWarning
+		wantedFailing  map[workloadInEnv]bool
+	}{
+		"sets the web ACL of the load balancer shared by the services of each environment": {
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String(mockLBARN)},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource(mockLBARN).Return("my-acl", nil)
+				m.stackResources.EXPECT().StackResources("my-app-prod").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String("arn:prod-lb")},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource("arn:prod-lb").Return("", nil)
+			},
+			wantedACLs: map[string]string{
+				"api/test": "my-acl",
+				"web/test": "my-acl",
+				"api/prod": describe.WebACLNone,
+			},
+		},
+		"flags the public-facing services without a web ACL in strict mode": {
+			inStrict: true,
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String(mockLBARN)},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource(mockLBARN).Return("", nil)
+				m.stackResources.EXPECT().StackResources("my-app-prod").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String("arn:prod-lb")},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource("arn:prod-lb").Return("my-acl", nil)
+			},
+			wantedACLs: map[string]string{
+				"api/test": describe.WebACLNone,
+				"web/test": describe.WebACLNone,
+				"api/prod": "my-acl",
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Public-facing service api in environment test has no WAF web ACL"},
+				{Severity: describe.WarningSeverityWarning, Message: "Public-facing service web in environment test has no WAF web ACL"},
+			},
+			wantedFailing: map[workloadInEnv]bool{
+				{env: "test", workload: "api"}: true,
+				{env: "test", workload: "web"}: true,
+			},
+		},
+		"warns if the web ACL can't be retrieved": {
+			inStrict: true,
+			setupMocks: func(m showAppMocks) {
+				m.stackResources.EXPECT().StackResources("my-app-test").Return(nil, errors.New("some error"))
+				m.stackResources.EXPECT().StackResources("my-app-prod").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String("arn:prod-lb")},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource("arn:prod-lb").Return("", errors.New("some error"))
+			},
+			wantedACLs: map[string]string{
+				"api/test": describe.WebACLUnknown,
+				"web/test": describe.WebACLUnknown,
+				"api/prod": describe.WebACLUnknown,
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the web ACL of the load balancer in environment test: get resources of environment stack my-app-test: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the web ACL of the load balancer in environment prod: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				stackResources: mocks.NewMockstackResourcesGetter(ctrl),
+				webACLs:        mocks.NewMockwebACLGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", isStrict: tc.inStrict},
+				failing:     make(map[workloadInEnv]bool),
+				newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+					return m.stackResources, nil
+				},
+				newWebACLGetter: func(_ *config.Environment) (webACLGetter, error) {
+					return m.webACLs, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "web", Environment: "test"},
+				{Service: "frontend", Environment: "test"},
+				{Service: "worker", Environment: "test"},
+				{Service: "api", Environment: "prod"},
+			}
+
+			// WHEN
+			opts.webACLs(mockEnvs, mockSvcs, deployments)
+
+			// THEN
+			acls := make(map[string]string)
+			for _, deployment := range deployments {
+				if deployment.WebACL != "" {
+					acls[deployment.Service+"/"+deployment.Environment] = deployment.WebACL
+				}
+			}
+			require.Equal(t, tc.wantedACLs, acls)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+			if tc.wantedFailing == nil {
+				tc.wantedFailing = make(map[workloadInEnv]bool)
+			}
+			require.Equal(t, tc.wantedFailing, opts.failing)
+		})
+	}
+}
+
+func TestShowAppOpts_JobRuns(t *testing.T) {
+	mockNow := time.Date(2021, time.June, 8, 0, 0, 0, 0, time.UTC)
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	mockJobs := []*config.Workload{{Name: "report", Type: "Scheduled Job"}}
+	mockStateMachine := "arn:aws:states:us-west-2:123456789012:stateMachine:my-app-test-report"
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedJobs     []*describe.AppJob
+		wantedWarnings []*describe.AppWarning
+		wantedErr      error
+	}{
+		"errors if fail to list the jobs": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListJobs("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list jobs in application my-app: some error"),
+		},
+		"lists the recent runs of the jobs deployed in each environment": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListJobs("my-app").Return(mockJobs, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app", "copilot-environment": "test"}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-report")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app", "copilot-environment": "prod"}).Return(nil, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-report").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::Events::Rule"), PhysicalResourceId: aws.String("my-app-test-report-Rule")},
+					{ResourceType: aws.String("AWS::StepFunctions::StateMachine"), PhysicalResourceId: aws.String(mockStateMachine)},
+				}, nil)
+				m.executions.EXPECT().RecentExecutions(mockStateMachine, mockNow.Add(-7*24*time.Hour), 5).Return([]stepfunctions.Execution{
+					{Status: "RUNNING", StartDate: mockNow.Add(-time.Hour)},
+					{Status: "FAILED", StartDate: mockNow.Add(-25 * time.Hour), StopDate: mockNow.Add(-24 * time.Hour)},
+				}, nil)
+			},
+			wantedJobs: []*describe.AppJob{
+				{
+					Name:        "report",
+					Environment: "test",
+					RecentRuns: []*describe.AppJobRun{
+						{StartedAt: mockNow.Add(-time.Hour), Status: "RUNNING"},
+						{StartedAt: mockNow.Add(-25 * time.Hour), Status: "FAILED"},
+					},
+				},
+			},
+		},
+		"warns if the runs of a job can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListJobs("my-app").Return(mockJobs, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-report")},
+					{StackName: aws.String("my-app-prod-report")},
+				}, nil).Times(2)
+				m.stackResources.EXPECT().StackResources("my-app-test-report").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::StepFunctions::StateMachine"), PhysicalResourceId: aws.String(mockStateMachine)},
+				}, nil)
+				m.executions.EXPECT().RecentExecutions(mockStateMachine, gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+				m.stackResources.EXPECT().StackResources("my-app-prod-report").Return(nil, nil)
+			},
+			wantedJobs: []*describe.AppJob{
+				{Name: "report", Environment: "test", RecentRuns: []*describe.AppJobRun{}},
+				{Name: "report", Environment: "prod", RecentRuns: []*describe.AppJobRun{}},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the recent runs of job report in environment test: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				storeSvc:       mocks.NewMockstore(ctrl),
+				stackLister:    mocks.NewMockstackLister(ctrl),
+				stackResources: mocks.NewMockstackResourcesGetter(ctrl),
+				executions:     mocks.NewMockjobExecutionLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				store:       m.storeSvc,
+				envStacks:   make(map[string][]cloudformation.StackDescription),
+				resources:   make(map[workloadInEnv][]*cloudformation.StackResource),
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m.stackLister, nil
+				},
+				newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+					return m.stackResources, nil
+				},
+				newExecutionLister: func(_ *config.Environment) (jobExecutionLister, error) {
+					return m.executions, nil
+				},
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			jobs, err := opts.jobRuns(mockEnvs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJobs, jobs)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_ArtifactBuckets(t *testing.T) {
+	mockApp := &config.Application{Name: "my-app"}
+	mockEnvs := []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "prod", Region: "us-west-2"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedBuckets  []*describe.AppArtifactBucket
+		wantedWarnings []*describe.AppWarning
+	}{
+		"warns if the regional resources can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return(nil, errors.New("some error"))
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't retrieve the artifact buckets of application my-app: some error"},
+			},
+		},
+		"maps each environment to the bucket of its region": {
+			setupMocks: func(m showAppMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return([]*stack.AppRegionalResources{
+					{Region: "us-west-2", S3Bucket: "my-app-us-west-2-bucket"},
+					{Region: "us-east-1", S3Bucket: "my-app-us-east-1-bucket"},
+				}, nil)
+				m.objectCounter.EXPECT().BucketObjectCount("my-app-us-west-2-bucket").Return(int64(42), nil)
+				m.objectCounter.EXPECT().BucketObjectCount("my-app-us-east-1-bucket").Return(int64(0), cloudwatch.ErrNoMetricData)
+			},
+			wantedBuckets: []*describe.AppArtifactBucket{
+				{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "test", ApproximateObjectCount: aws.Int64(42)},
+				{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "prod", ApproximateObjectCount: aws.Int64(42)},
+				{Region: "us-east-1", Bucket: "my-app-us-east-1-bucket"},
+			},
+		},
+		"leaves out the number of objects if it can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return([]*stack.AppRegionalResources{
+					{Region: "us-west-2", S3Bucket: "my-app-us-west-2-bucket"},
+				}, nil)
+				m.objectCounter.EXPECT().BucketObjectCount("my-app-us-west-2-bucket").Return(int64(0), errors.New("some error"))
+			},
+			wantedBuckets: []*describe.AppArtifactBucket{
+				{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "test"},
+				{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "prod"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Couldn't retrieve the number of objects in artifact bucket my-app-us-west-2-bucket: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				appResources:  mocks.NewMockappResourcesGetter(ctrl),
+				objectCounter: mocks.NewMockbucketObjectCounter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars:  showAppVars{name: "my-app"},
+				appResources: m.appResources,
+				newObjectCounter: func(_ string) (bucketObjectCounter, error) {
+					return m.objectCounter, nil
+				},
+			}
+
+			// WHEN
+			buckets := opts.artifactBuckets(mockApp, mockEnvs)
+
+			// THEN
+			require.Equal(t, tc.wantedBuckets, buckets)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_StackFallback(t *testing.T) {
+	testError := errors.New("some error")
+	throttleErr := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	mockStacks := []cloudformation.StackDescription{
+		{
+			StackName:   aws.String("my-app-test"),
+			StackId:     aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/my-app-test/1234"),
+			StackStatus: aws.String("UPDATE_COMPLETE"),
+			Tags: []*sdkcloudformation.Tag{
+				{Key: aws.String("copilot-application"), Value: aws.String("my-app")},
+				{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+			},
+			Outputs: []*sdkcloudformation.Output{
+				{OutputKey: aws.String("EnvironmentManagerRoleARN"), OutputValue: aws.String("arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole")},
+			},
+		},
+		{
+			StackName:   aws.String("my-app-prod-api"),
+			StackId:     aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/my-app-prod-api/5678"),
+			StackStatus: aws.String("CREATE_COMPLETE"),
+			Tags: []*sdkcloudformation.Tag{
+				{Key: aws.String("copilot-application"), Value: aws.String("my-app")},
+				{Key: aws.String("copilot-environment"), Value: aws.String("prod")},
+				{Key: aws.String("copilot-service"), Value: aws.String("api")},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inAllowFallback bool
+		setupMocks      func(m showAppMocks)
+
+		wantedEnvs        []*config.Environment
+		wantedSvcs        []*config.Workload
+		wantedDeployments []*describe.AppDeployment
+		wantedWarnings    []*describe.AppWarning
+		wantedError       error
+	}{
+		"derives the environments and services from the stacks if the config store is throttled": {
+			inAllowFallback: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, throttleErr)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app"}).Return(mockStacks, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-api"), Revision: aws.Int64(2)}, nil)
+			},
+			wantedEnvs: []*config.Environment{
+				{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+			},
+			wantedSvcs: []*config.Workload{{Name: "api"}},
+			wantedDeployments: []*describe.AppDeployment{
+				{Service: "api", Environment: "prod", StackStatus: "CREATE_COMPLETE", TaskDefinition: "my-app-prod-api:2"},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "The config store is unavailable, the environments and services of application my-app are derived from its stacks: get application my-app: ThrottlingException: Rate exceeded"},
+			},
+		},
+		"fails if the stacks can't be listed either": {
+			inAllowFallback: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, throttleErr)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app"}).Return(nil, testError)
+			},
+			wantedError: errors.New("list stacks of application my-app after the config store failed (list environments in application my-app: ThrottlingException: Rate exceeded): some error"),
+		},
+		"doesn't fall back if the error of the config store isn't transient": {
+			inAllowFallback: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+			},
+			wantedError: errors.New("get application my-app: some error"),
+		},
+		"doesn't fall back without --allow-stack-fallback": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, throttleErr)
+			},
+			wantedError: errors.New("get application my-app: ThrottlingException: Rate exceeded"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				storeSvc:      mocks.NewMockstore(ctrl),
+				stackLister:   mocks.NewMockstackLister(ctrl),
+				taskDefGetter: mocks.NewMocktaskDefinitionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:               "my-app",
+					noPipelines:        true,
+					shouldOutputJSON:   true,
+					allowStackFallback: tc.inAllowFallback,
+				},
+				store:     m.storeSvc,
+				tagStacks: m.stackLister,
+				addons:    &fakeAddonsReader{},
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return nil, errors.New("the stacks are listed again")
+				},
+				newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+					return m.taskDefGetter, nil
+				},
+			}
+
+			// WHEN
+			got, err := opts.description()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, got.DerivedFromStacks)
+			require.Equal(t, tc.wantedEnvs, got.Envs)
+			require.Equal(t, tc.wantedSvcs, got.Services)
+			require.Equal(t, tc.wantedDeployments, got.Deployments)
+			require.Equal(t, tc.wantedWarnings, got.Warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_WorkspaceOnlySvcs(t *testing.T) {
+	mockSvcs := []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsAppSvcReader)
+
+		wantedSvcs     []*config.Workload
+		wantedWarnings []*describe.AppWarning
+	}{
+		"returns nothing outside of a workspace": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(nil, errors.New("couldn't find an application associated with this workspace"))
+			},
+		},
+		"returns nothing in the workspace of another application": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "other-app"}, nil)
+			},
+		},
+		"warns if the services of the workspace can't be listed": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't list the services of the workspace: some error"},
+			},
+		},
+		"returns the services that are only in the workspace with the type of their manifest": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.EXPECT().ServiceNames().Return([]string{"api", "worker", "draft"}, nil)
+				m.EXPECT().ReadServiceManifest("worker").Return([]byte("name: worker\ntype: Backend Service\n"), nil)
+				m.EXPECT().ReadServiceManifest("draft").Return(nil, errors.New("some error"))
+			},
+			wantedSvcs: []*config.Workload{
+				{Name: "worker", Type: "Backend Service"},
+				{Name: "draft"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsAppSvcReader(ctrl)
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				wsSvcs:      m,
+			}
+
+			// WHEN
+			svcs := opts.workspaceOnlySvcs(mockSvcs)
+
+			// THEN
+			require.Equal(t, tc.wantedSvcs, svcs)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_Drift(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	const apiManifest = `name: api
+type: Load Balanced Web Service
+image:
+  location: nginx:1.21
+  port: 80
+http:
+  path: '/'
+cpu: 256
+memory: 512
+variables:
+  LOG_LEVEL: info
+environments:
+  prod:
+    cpu: 1024
+`
+	apiTaskDef := func(cpu, logLevel string) *awsecs.TaskDefinition {
+		return &awsecs.TaskDefinition{
+			Cpu:    aws.String(cpu),
+			Memory: aws.String("512"),
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{
+					Name:         aws.String("api"),
+					Image:        aws.String("nginx:1.21"),
+					PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80)}},
+					Environment: []*ecs.KeyValuePair{
+						{Name: aws.String("COPILOT_ENVIRONMENT_NAME"), Value: aws.String("test")},
+						{Name: aws.String("LOG_LEVEL"), Value: aws.String(logLevel)},
+					},
+					Secrets: []*ecs.Secret{
+						{Name: aws.String("GITHUB_TOKEN"), ValueFrom: aws.String("GH_TOKEN")},
+					},
+				},
+				{
+					Name:        aws.String("nginx"),
+					Environment: []*ecs.KeyValuePair{{Name: aws.String("PROXY"), Value: aws.String("on")}},
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedDrift    map[string][]*describe.AppDrift
+		wantedWarnings []*describe.AppWarning
+	}{
+		"warns outside of a workspace of the application": {
+			setupMocks: func(m showAppMocks) {
+				m.wsSvcs.EXPECT().Summary().Return(&workspace.Summary{Application: "other-app"}, nil)
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Couldn't check the drift of the services: not in a workspace of application my-app"},
+			},
+		},
+		"lists the fields that differ from the deployed task definitions with the overrides of each environment": {
+			setupMocks: func(m showAppMocks) {
+				m.wsSvcs.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.wsSvcs.EXPECT().ServiceNames().Return([]string{"api", "report"}, nil)
+				m.wsSvcs.EXPECT().ReadServiceManifest("api").Return([]byte(apiManifest), nil)
+				m.wsSvcs.EXPECT().ReadServiceManifest("report").Return([]byte("name: report\ntype: Scheduled Job\n"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(apiTaskDef("256", "debug"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(apiTaskDef("1024", "info"), nil)
+			},
+			wantedDrift: map[string][]*describe.AppDrift{
+				"test": {
+					{Field: "variables.LOG_LEVEL", Manifest: "info", Deployed: "debug"},
+					{Field: "secrets.GITHUB_TOKEN", Deployed: "GH_TOKEN"},
+				},
+				"prod": {
+					{Field: "secrets.GITHUB_TOKEN", Deployed: "GH_TOKEN"},
+				},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Service api in environment test drifted from its manifest: variables.LOG_LEVEL, secrets.GITHUB_TOKEN"},
+				{Severity: describe.WarningSeverityWarning, Message: "Service api in environment prod drifted from its manifest: secrets.GITHUB_TOKEN"},
+			},
+		},
+		"warns if the deployed configuration can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.wsSvcs.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.wsSvcs.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.wsSvcs.EXPECT().ReadServiceManifest("api").Return([]byte(apiManifest), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(nil, errors.New("some error"))
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(apiTaskDef("512", "info"), nil)
+			},
+			wantedDrift: map[string][]*describe.AppDrift{
+				"prod": {
+					{Field: "cpu", Manifest: "1024", Deployed: "512"},
+					{Field: "secrets.GITHUB_TOKEN", Deployed: "GH_TOKEN"},
+				},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't check the drift of service api in environment test: get task definition of service api in environment test: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Service api in environment prod drifted from its manifest: cpu, secrets.GITHUB_TOKEN"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				wsSvcs:        mocks.NewMockwsAppSvcReader(ctrl),
+				taskDefGetter: mocks.NewMocktaskDefinitionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				wsSvcs:      m.wsSvcs,
+				taskDefs:    make(map[workloadInEnv]*awsecs.TaskDefinition),
+				newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+					return m.taskDefGetter, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "api", Environment: "prod"},
+				{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+			}
+
+			// WHEN
+			opts.drift(mockEnvs, deployments)
+
+			// THEN
+			drift := make(map[string][]*describe.AppDrift)
+			for _, deployment := range deployments {
+				if deployment.Drift != nil {
+					drift[deployment.Environment] = deployment.Drift
+				}
+			}
+			if tc.wantedDrift == nil {
+				tc.wantedDrift = make(map[string][]*describe.AppDrift)
+			}
+			require.Equal(t, tc.wantedDrift, drift)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_SinceLastDeploy(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	apiTaskDef := func(env string, revision int64, image, logLevel string) *awsecs.TaskDefinition {
+		return &awsecs.TaskDefinition{
+			Family:   aws.String(fmt.Sprintf("my-app-%s-api", env)),
+			Revision: aws.Int64(revision),
+			Cpu:      aws.String("256"),
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("api"),
+					Image: aws.String(image),
+					Environment: []*ecs.KeyValuePair{
+						{Name: aws.String("COPILOT_ENVIRONMENT_NAME"), Value: aws.String(env)},
+						{Name: aws.String("LOG_LEVEL"), Value: aws.String(logLevel)},
+					},
+				},
+				{
+					Name:  aws.String("nginx"),
+					Image: aws.String("nginx:1.21"),
+				},
+			},
+		}
+	}
+	type changes struct {
+		previous string
+		changes  []*describe.AppChange
+	}
+	testCases := map[string]struct {
+		setupMocks func(m showAppMocks)
+
+		wantedChanges  map[string]changes
+		wantedWarnings []*describe.AppWarning
+	}{
+		"lists the fields that changed since the previous revision of the task definitions": {
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(apiTaskDef("test", 5, "api:1.3.0", "debug"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api:4").Return(apiTaskDef("test", 4, "api:1.2.0", "info"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(apiTaskDef("prod", 3, "api:1.2.0", "info"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api:2").Return(apiTaskDef("prod", 2, "api:1.2.0", "info"), nil)
+			},
+			wantedChanges: map[string]changes{
+				"test": {
+					previous: "my-app-test-api:4",
+					changes: []*describe.AppChange{
+						{Field: "image.location", Previous: "api:1.2.0", Current: "api:1.3.0"},
+						{Field: "variables.LOG_LEVEL", Previous: "info", Current: "debug"},
+					},
+				},
+				"prod": {previous: "my-app-prod-api:2"},
+			},
+		},
+		"lists all the fields of a first deployment": {
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(apiTaskDef("test", 1, "api:1.0.0", "info"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(apiTaskDef("prod", 2, "api:1.0.0", "info"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api:1").Return(apiTaskDef("prod", 1, "api:1.0.0", "info"), nil)
+			},
+			wantedChanges: map[string]changes{
+				"test": {
+					changes: []*describe.AppChange{
+						{Field: "cpu", Current: "256"},
+						{Field: "image.location", Current: "api:1.0.0"},
+						{Field: "sidecars.nginx.image.location", Current: "nginx:1.21"},
+						{Field: "variables.LOG_LEVEL", Current: "info"},
+					},
+				},
+				"prod": {previous: "my-app-prod-api:1"},
+			},
+		},
+		"warns if the previous revision can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(apiTaskDef("test", 5, "api:1.3.0", "debug"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api:4").Return(nil, errors.New("some error"))
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-api").Return(nil, errors.New("some error"))
+			},
+			wantedChanges: map[string]changes{},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't compare the last deployment of service api in environment test with the previous one: some error"},
+				{Severity: describe.WarningSeverityWarning, Message: "Couldn't compare the last deployment of service api in environment prod with the previous one: get task definition of service api in environment prod: some error"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				taskDefGetter: mocks.NewMocktaskDefinitionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				taskDefs:    make(map[workloadInEnv]*awsecs.TaskDefinition),
+				newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+					return m.taskDefGetter, nil
+				},
+			}
+			deployments := []*describe.AppDeployment{
+				{Service: "api", Environment: "test"},
+				{Service: "api", Environment: "prod"},
+				{Service: "frontend", Environment: "test", TaskDefinition: describe.TaskDefinitionNotApplicable},
+			}
+
+			// WHEN
+			opts.sinceLastDeploy(mockEnvs, deployments)
+
+			// THEN
+			got := make(map[string]changes)
+			for _, deployment := range deployments {
+				if deployment.PreviousTaskDefinition != "" || deployment.Changes != nil {
+					got[deployment.Environment] = changes{previous: deployment.PreviousTaskDefinition, changes: deployment.Changes}
+				}
+			}
+			require.Equal(t, tc.wantedChanges, got)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
+
+func TestShowAppOpts_StackSetEnvs(t *testing.T) {
+	mockEnvs := []*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackSetInstanceLister)
+
+		wantedEnvs []*config.Environment
+		wantedErr  error
+	}{
+		"errors if the instances can't be listed": {
+			setupMocks: func(m *mocks.MockstackSetInstanceLister) {
+				m.EXPECT().InstanceSummaries("my-app-infra").Return(nil, errors.New("list stack instances for stack set my-app-infra: some error"))
+			},
+			wantedErr: errors.New("list stack instances for stack set my-app-infra: some error"),
+		},
+		"returns the instances in the accounts and regions of no environment": {
+			setupMocks: func(m *mocks.MockstackSetInstanceLister) {
+				m.EXPECT().InstanceSummaries("my-app-infra").Return([]stackset.InstanceSummary{
+					{Account: "123456789012", Region: "us-west-2"},
+					{Account: "123456789012", Region: "eu-west-1"},
+					{Account: "210987654321", Region: "us-west-2"},
+				}, nil)
+			},
+			wantedEnvs: []*config.Environment{
+				{Name: "123456789012/eu-west-1", AccountID: "123456789012", Region: "eu-west-1"},
+				{Name: "210987654321/us-west-2", AccountID: "210987654321", Region: "us-west-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackSetInstanceLister(ctrl)
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", stackSetName: "my-app-infra"},
+				stackSets:   m,
+			}
+
+			// WHEN
+			envs, err := opts.stackSetEnvs(mockEnvs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvs, envs)
+		})
+	}
+}
+
+func TestShowAppOpts_BatchStacks(t *testing.T) {
+	mockEnvs := []*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "staging", AccountID: "123456789012", Region: "eu-west-1"},
+		{Name: "prod", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "local"},
+	}
+	envTag := func(env string) []*sdkcloudformation.Tag {
+		return []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String(env)}}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackLister)
+
+		wantedEnvStacks map[string][]cloudformation.StackDescription
+	}{
+		"leaves the environments to be listed on their own if the batch fails": {
+			setupMocks: func(m *mocks.MockstackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app"}).Return(nil, errors.New("some error"))
+			},
+			wantedEnvStacks: map[string][]cloudformation.StackDescription{},
+		},
+		"splits the stacks of the environments in the same account and region by environment": {
+			setupMocks: func(m *mocks.MockstackLister) {
+				m.EXPECT().ListStacksWithTags(map[string]string{"copilot-application": "my-app"}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), Tags: envTag("test")},
+					{StackName: aws.String("my-app-test-api"), Tags: envTag("test")},
+					{StackName: aws.String("my-app-infrastructure-roles")},
+				}, nil)
+			},
+			wantedEnvStacks: map[string][]cloudformation.StackDescription{
+				"test": {
+					{StackName: aws.String("my-app-test"), Tags: envTag("test")},
+					{StackName: aws.String("my-app-test-api"), Tags: envTag("test")},
+				},
+				"prod": nil,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackLister(ctrl)
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app"},
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m, nil
+				},
+				envStacks: make(map[string][]cloudformation.StackDescription),
+			}
+
+			// WHEN
+			opts.batchStacks(mockEnvs)
+
+			// THEN
+			require.Equal(t, tc.wantedEnvStacks, opts.envStacks)
+			require.Equal(t, 1, opts.stackListings)
+		})
+	}
+}
+
+func TestShowAppOpts_CrossAccountRefs(t *testing.T) {
+	mockSvcs := []*config.Workload{{Name: "api"}, {Name: "worker"}, {Name: "web"}}
+	mockAddons := map[string]map[string]string{
+		"api": {
+			"queue.yml": `Resources:
+  Policy:
+    Type: AWS::IAM::ManagedPolicy
+    Properties:
+      PolicyDocument:
+        Statement:
+          - Effect: Allow
+            Action: sqs:SendMessage
+            Resource: !Sub arn:aws:sqs:${AWS::Region}:210987654321:orders
+          - Effect: Allow
+            Action: s3:GetObject
+            Resource: arn:aws:s3:::my-bucket/*
+`,
+			"addons.parameters.yml": `Parameters:
+  AuditAccount: 333333333333
+  OwnAccount: "123456789012"
+`,
+		},
+		"worker": {
+			"table.yml": `Resources:
+  Table:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  Role:
+    Value: arn:aws:iam::123456789012:role/worker
+`,
+		},
+	}
+	testCases := map[string]struct {
+		inEnvs []*config.Environment
+
+		wantedRefs     []*describe.ServiceCrossAccountRefs
+		wantedWarnings []*describe.AppWarning
+	}{
+		"returns nothing if the accounts of the environments are unknown": {
+			inEnvs: []*config.Environment{{Name: "test"}},
+		},
+		"returns the accounts other than the one of the environments": {
+			inEnvs: []*config.Environment{
+				{Name: "test", AccountID: "123456789012"},
+				{Name: "prod", AccountID: "123456789012"},
+			},
+			wantedRefs: []*describe.ServiceCrossAccountRefs{
+				{Service: "api", Accounts: []string{"210987654321", "333333333333"}},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Service api references resources in other accounts than the ones of its environments: 210987654321, 333333333333"},
+			},
+		},
+		"returns every referenced account if the environments are in several accounts": {
+			inEnvs: []*config.Environment{
+				{Name: "test", AccountID: "123456789012"},
+				{Name: "prod", AccountID: "210987654321"},
+			},
+			wantedRefs: []*describe.ServiceCrossAccountRefs{
+				{Service: "api", Accounts: []string{"123456789012", "210987654321", "333333333333"}},
+				{Service: "worker", Accounts: []string{"123456789012"}},
+			},
+			wantedWarnings: []*describe.AppWarning{
+				{Severity: describe.WarningSeverityInfo, Message: "Service api references resources in other accounts than the ones of its environments: 123456789012, 210987654321, 333333333333"},
+				{Severity: describe.WarningSeverityInfo, Message: "Service worker references resources in other accounts than the ones of its environments: 123456789012"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &showAppOpts{
+				addons: &fakeAddonsReader{addons: mockAddons},
+			}
+
+			// WHEN
+			refs := opts.crossAccountRefs(tc.inEnvs, mockSvcs)
+
+			// THEN
+			require.Equal(t, tc.wantedRefs, refs)
+			require.Equal(t, tc.wantedWarnings, opts.warnings)
+		})
+	}
+}
@@ -0,0 +1,412 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeShowAppStore is an in-memory config store holding the applications, environments and services.
+// Methods that "app show" doesn't use are left unimplemented and panic if called.
+type fakeShowAppStore struct {
+	store
+
+	apps []*config.Application
+	envs map[string][]*config.Environment
+	svcs map[string][]*config.Workload
+}
+
+func (s *fakeShowAppStore) GetApplication(appName string) (*config.Application, error) {
+	for _, app := range s.apps {
+		if app.Name == appName {
+			return app, nil
+		}
+	}
+	return nil, &config.ErrNoSuchApplication{
+		ApplicationName: appName,
+	}
+}
+
+func (s *fakeShowAppStore) ListApplications() ([]*config.Application, error) {
+	return s.apps, nil
+}
+
+func (s *fakeShowAppStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	return s.envs[appName], nil
+}
+
+func (s *fakeShowAppStore) ListServices(appName string) ([]*config.Workload, error) {
+	return s.svcs[appName], nil
+}
+
+// fakePipelineGetter returns the pipelines tagged with the application name.
+type fakePipelineGetter struct {
+	pipelines map[string][]*codepipeline.Pipeline
+}
+
+func (g *fakePipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+	for _, pipelines := range g.pipelines {
+		for _, pipeline := range pipelines {
+			if pipeline.Name == pipelineName {
+				return pipeline, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s not found", pipelineName)
+}
+
+func (g *fakePipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+	var names []string
+	for _, pipeline := range g.pipelines[tags[deploy.AppTagKey]] {
+		names = append(names, pipeline.Name)
+	}
+	return names, nil
+}
+
+func (g *fakePipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+	return g.pipelines[tags[deploy.AppTagKey]], nil
+}
+
+// fakeAppSelector always selects the same application and records the prompts it was asked and their choices.
+type fakeAppSelector struct {
+	selected string
+	store    *fakeShowAppStore
+
+	prompts []string
+	choices [][]string
+}
+
+func (s *fakeAppSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	s.prompts = append(s.prompts, prompt)
+	return s.selected, nil
+}
+
+func (s *fakeAppSelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	s.prompts = append(s.prompts, prompt)
+	s.choices = append(s.choices, appNames)
+	return s.selected, nil
+}
+
+func (s *fakeAppSelector) ApplicationChoices(additionalOpts ...string) ([]string, error) {
+	var names []string
+	for _, app := range s.store.apps {
+		names = append(names, app.Name)
+	}
+	return append(names, additionalOpts...), nil
+}
+
+// fakePrompter fails the test if "app show" prompts directly instead of going through the selector.
+type fakePrompter struct {
+	prompter
+
+	t *testing.T
+}
+
+func (p *fakePrompter) Get(message, help string, validator prompt.ValidatorFunc, promptOpts ...prompt.Option) (string, error) {
+	p.t.Fatalf("unexpected prompt %q", message)
+	return "", nil
+}
+
+// fakeStackLister returns the stack of an environment that was provisioned successfully, without any services deployed.
+type fakeStackLister struct{}
+
+func (l *fakeStackLister) ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error) {
+	return []cloudformation.StackDescription{
+		{
+			StackName:   aws.String(stack.NameForEnv(tags[deploy.AppTagKey], tags[deploy.EnvTagKey])),
+			StackStatus: aws.String("CREATE_COMPLETE"),
+		},
+	}, nil
+}
+
+// fakeAddonsReader holds the contents of the addon files of each service in the workspace by file name.
+// The services that are not in the map don't have an addons directory.
+type fakeAddonsReader struct {
+	addons map[string]map[string]string
+}
+
+func (r *fakeAddonsReader) ReadAddonsDir(svcName string) ([]string, error) {
+	files, ok := r.addons[svcName]
+	if !ok {
+		return nil, fmt.Errorf("read addons directory of service %s: %w", svcName, os.ErrNotExist)
+	}
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *fakeAddonsReader) ReadAddon(svcName, fileName string) ([]byte, error) {
+	return []byte(r.addons[svcName][fileName]), nil
+}
+
+// newFakeShowAppOpts wires showAppOpts with in-memory fakes instead of AWS clients.
+func newFakeShowAppOpts(t *testing.T, vars showAppVars, store *fakeShowAppStore, pipelines *fakePipelineGetter, sel *fakeAppSelector) (*showAppOpts, *bytes.Buffer) {
+	b := &bytes.Buffer{}
+	return &showAppOpts{
+		showAppVars: vars,
+		store:       store,
+		w:           b,
+		prompt:      &fakePrompter{t: t},
+		sel:         sel,
+		appChoices:  sel,
+		pipelineSvc: pipelines,
+		addons:      &fakeAddonsReader{},
+
+		namePrompt:     appShowNamePrompt,
+		nameHelpPrompt: appShowNameHelpPrompt,
+
+		newStackLister: func(env *config.Environment) (stackLister, error) {
+			return &fakeStackLister{}, nil
+		},
+		screenWidth: func() int {
+			return 200
+		},
+	}, b
+}
+
+func TestShowApp_Harness(t *testing.T) {
+	store := &fakeShowAppStore{
+		apps: []*config.Application{
+			{Name: "empty", AccountID: "123456789012", Version: "v1.0.0"},
+			{Name: "single", AccountID: "123456789012", Domain: "example.com", Version: "v1.0.0"},
+			{Name: "multi", AccountID: "123456789012", Version: "v1.0.0"},
+		},
+		envs: map[string][]*config.Environment{
+			"single": {
+				{App: "single", Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+			},
+			"multi": {
+				{App: "multi", Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+				{App: "multi", Name: "prod", AccountID: "210987654321", Region: "us-east-1", Prod: true},
+			},
+		},
+		svcs: map[string][]*config.Workload{
+			"single": {
+				{App: "single", Name: "frontend", Type: "Load Balanced Web Service"},
+			},
+			"multi": {
+				{App: "multi", Name: "frontend", Type: "Load Balanced Web Service"},
+				{App: "multi", Name: "backend", Type: "Backend Service"},
+			},
+		},
+	}
+	pipelines := &fakePipelineGetter{
+		pipelines: map[string][]*codepipeline.Pipeline{
+			"multi": {
+				{Name: "pipeline-multi-repo"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		inName        string
+		inSelected    string
+		inJSON        bool
+		inListOnly    bool
+		wantedPrompts []string
+		wantedChoices [][]string
+		wantedContent string
+		wantedError   error
+	}{
+		"empty app": {
+			inName: "empty",
+
+			wantedContent: `About
+
+  Name              empty
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"empty app in JSON": {
+			inName: "empty",
+			inJSON: true,
+
+			wantedContent: `{"name":"empty","owner":"unowned","environments":null,"services":null,"pipelines":null}` + "\n",
+		},
+		"single-env app selected from the prompt": {
+			inSelected: "single",
+
+			wantedPrompts: []string{appShowNamePrompt},
+			wantedContent: `About
+
+  Name              single
+  URI               example.com
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2
+
+Services
+
+  Name              Type
+  ----              ----
+  frontend          Load Balanced Web Service
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"single-env app in JSON": {
+			inName: "single",
+			inJSON: true,
+
+			wantedContent: `{"name":"single","uri":"example.com","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"frontend","type":"Load Balanced Web Service"}],"pipelines":null,"environmentStatuses":{"test":"CREATE_COMPLETE"}}` + "\n",
+		},
+		"multi-env app": {
+			inName: "multi",
+
+			wantedContent: `About
+
+  Name              multi
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2
+  prod              210987654321        us-east-1
+
+Services
+
+  Name              Type
+  ----              ----
+  frontend          Load Balanced Web Service
+  backend           Backend Service
+
+Pipelines
+
+  Name
+  ----
+  pipeline-multi-repo
+`,
+		},
+		"multi-env app in JSON": {
+			inName: "multi",
+			inJSON: true,
+
+			wantedContent: `{"name":"multi","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"us-east-1","accountID":"210987654321","prod":true,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"frontend","type":"Load Balanced Web Service"},{"app":"","name":"backend","type":"Backend Service"}],"pipelines":[{"name":"pipeline-multi-repo","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"}],"pipelineStages":[{"pipeline":"pipeline-multi-repo","stages":[]}],"environmentStatuses":{"prod":"CREATE_COMPLETE","test":"CREATE_COMPLETE"}}` + "\n",
+		},
+		"list the selectable apps without prompting": {
+			inListOnly: true,
+
+			wantedContent: `["empty","single","multi"]` + "\n",
+		},
+		"app selected by a unique prefix": {
+			inName: "sin",
+
+			wantedContent: `About
+
+  Name              single
+  URI               example.com
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2
+
+Services
+
+  Name              Type
+  ----              ----
+  frontend          Load Balanced Web Service
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"prefix matches win over substring matches": {
+			inName: "m",
+			inJSON: true,
+
+			wantedContent: `{"name":"multi","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"us-east-1","accountID":"210987654321","prod":true,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"frontend","type":"Load Balanced Web Service"},{"app":"","name":"backend","type":"Backend Service"}],"pipelines":[{"name":"pipeline-multi-repo","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"}],"pipelineStages":[{"pipeline":"pipeline-multi-repo","stages":[]}],"environmentStatuses":{"prod":"CREATE_COMPLETE","test":"CREATE_COMPLETE"}}` + "\n",
+		},
+		"app selected among the ambiguous matches of a partial name": {
+			inName:     "i",
+			inSelected: "single",
+			inJSON:     true,
+
+			wantedPrompts: []string{appShowNamePrompt},
+			wantedChoices: [][]string{{"single", "multi"}},
+			wantedContent: `{"name":"single","uri":"example.com","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"frontend","type":"Load Balanced Web Service"}],"pipelines":null,"environmentStatuses":{"test":"CREATE_COMPLETE"}}` + "\n",
+		},
+		"app that does not exist": {
+			inName: "missing",
+
+			wantedError: fmt.Errorf("get application missing: couldn't find an application named missing in account  and region "),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			sel := &fakeAppSelector{selected: tc.inSelected, store: store}
+			opts, b := newFakeShowAppOpts(t, showAppVars{
+				name:             tc.inName,
+				shouldOutputJSON: tc.inJSON,
+				shouldListOnly:   tc.inListOnly,
+			}, store, pipelines, sel)
+
+			// WHEN
+			err := opts.Validate()
+			if err == nil {
+				err = opts.Ask()
+			}
+			if err == nil {
+				err = opts.Execute()
+			}
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPrompts, sel.prompts)
+			require.Equal(t, tc.wantedChoices, sel.choices)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
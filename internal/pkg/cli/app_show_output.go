@@ -0,0 +1,702 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/clipboard"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+)
+
+// redactedAccountIDRegexp matches the account IDs within the output, including the ones in ARNs and ECR image URIs.
+var redactedAccountIDRegexp = regexp.MustCompile(`\b\d{12}\b`)
+
+// Format plugins render the json description of the application in the formats of --output that aren't built in.
+// The plugin of a format is the executable appShowFormatPluginPrefix followed by the name of the format, in the
+// appShowPluginsDirName directory of the copilot configuration directory.
+const (
+	appShowFormatPluginPrefix = "copilot-format-"
+	appShowPluginsDirName     = "plugins"
+)
+
+// formatPluginNameRegexp matches the names of the formats that can have a plugin, so that a format can't be a path.
+var formatPluginNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// appShowFormat is a built-in format of --output.
+type appShowFormat struct {
+	name string
+	// forTools is true for the formats read by other tools rather than people, which are never paged
+	// and can't be combined with --explain or --compare-env.
+	forTools bool
+	render   func(o *showAppOpts, description *describe.App, healthy bool) (string, error)
+}
+
+// appShowFormats are the built-in formats of --output, in the order they're listed in the errors.
+var appShowFormats = []appShowFormat{
+	{
+		name: appShowOutputHuman,
+		render: func(o *showAppOpts, description *describe.App, healthy bool) (string, error) {
+			return o.humanString(description, healthy), nil
+		},
+	},
+	{
+		name: appShowOutputJSON,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			out, err := description.JSONString(o.renderOptions(description))
+			if err != nil {
+				return "", fmt.Errorf("get JSON string: %w", err)
+			}
+			return out, nil
+		},
+	},
+	{
+		name:     appShowOutputCSV,
+		forTools: true,
+		render: func(_ *showAppOpts, description *describe.App, _ bool) (string, error) {
+			out, err := description.CSVString()
+			if err != nil {
+				return "", fmt.Errorf("get CSV string: %w", err)
+			}
+			return out, nil
+		},
+	},
+	{
+		name:     appShowOutputOpenMetrics,
+		forTools: true,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			return description.OpenMetricsString(o.now()), nil
+		},
+	},
+	{
+		name:     appShowOutputLines,
+		forTools: true,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			out, err := description.LinesString(o.renderOptions(description))
+			if err != nil {
+				return "", fmt.Errorf("get lines string: %w", err)
+			}
+			return out, nil
+		},
+	},
+	{
+		name:     appShowOutputMarkdown,
+		forTools: true,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			return description.MarkdownString(o.renderOptions(description)), nil
+		},
+	},
+	{
+		name:     appShowOutputGo,
+		forTools: true,
+		render: func(_ *showAppOpts, description *describe.App, _ bool) (string, error) {
+			out, err := description.GoLiteralString()
+			if err != nil {
+				return "", fmt.Errorf("get Go literal string: %w", err)
+			}
+			return out, nil
+		},
+	},
+	{
+		name:     appShowOutputMermaid,
+		forTools: true,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			return description.MermaidString(o.renderOptions(description)), nil
+		},
+	},
+	{
+		name:     appShowOutputDotenv,
+		forTools: true,
+		render: func(o *showAppOpts, description *describe.App, _ bool) (string, error) {
+			return description.DotenvString(o.renderOptions(description)), nil
+		},
+	},
+}
+
+// builtInAppShowFormat returns the built-in format of --output with the name, and false if there is none.
+func builtInAppShowFormat(name string) (appShowFormat, bool) {
+	for _, format := range appShowFormats {
+		if format.name == name {
+			return format, true
+		}
+	}
+	return appShowFormat{}, false
+}
+
+// unsupportedAppShowOutputError returns the error of a format of --output that isn't built in and has no plugin.
+func unsupportedAppShowOutputError(output string) error {
+	names := make([]string, len(appShowFormats))
+	for i, format := range appShowFormats {
+		names[i] = format.name
+	}
+	return fmt.Errorf("unsupported output %q, must be one of %s or %s", output, strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+}
+
+// fmtRedactedAccountID is the token of a redacted account ID, numbered in the order the account IDs appear.
+// It's as long as an account ID so that the tables stay aligned.
+const fmtRedactedAccountID = "account-%04d"
+
+// appShowOutputTarget is a format of --output and the file it's written to.
+type appShowOutputTarget struct {
+	format string
+	path   string // appShowOutputStdout for stdout.
+}
+
+// parseOutputs resolves the values of --output. A single format without a target is the output format of the command,
+// kept in outputFormat. Otherwise, each value is a format followed by "=" and the file to write it to, or "-" for stdout.
+// A value without a target is written to stdout.
+func (o *showAppOpts) parseOutputs() error {
+	if len(o.outputs) == 0 {
+		return nil
+	}
+	if len(o.outputs) == 1 && !strings.Contains(o.outputs[0], appShowOutputTargetSep) {
+		o.outputFormat = o.outputs[0]
+		return nil
+	}
+	for _, output := range o.outputs {
+		parts := strings.SplitN(output, appShowOutputTargetSep, 2)
+		target := appShowOutputTarget{
+			format: parts[0],
+			path:   appShowOutputStdout,
+		}
+		if len(parts) == 2 {
+			target.path = parts[1]
+		}
+		if target.path == "" {
+			return fmt.Errorf("--%s %s: missing the file to write the output to, use %s for stdout", outputFlag, output, appShowOutputStdout)
+		}
+		o.outputTargets = append(o.outputTargets, target)
+	}
+	return nil
+}
+
+// validateOutputTargets returns an error if a format of --output isn't supported, if two formats are written to
+// the same file, or if several formats are combined with a flag that changes the single output of the command.
+func (o *showAppOpts) validateOutputTargets() error {
+	if err := o.validateExclusiveMode(appShowOutputTargetsMode); err != nil {
+		return err
+	}
+	formatOf := make(map[string]string)
+	for _, target := range o.outputTargets {
+		if _, ok := builtInAppShowFormat(target.format); !ok {
+			if err := o.addFormatPlugin(target.format); err != nil {
+				return err
+			}
+		}
+		path := target.path
+		if path != appShowOutputStdout {
+			path = filepath.Clean(path)
+		}
+		if format, ok := formatOf[path]; ok {
+			return fmt.Errorf("--%s %s and --%s %s cannot be written to the same target %s", outputFlag, format, outputFlag, target.format, target.path)
+		}
+		formatOf[path] = target.format
+	}
+	return nil
+}
+
+// validateOutputFormat validates --output, and turns on --json if it's the requested format.
+func (o *showAppOpts) validateOutputFormat() error {
+	if o.outputFormat == appShowOutputJSON {
+		o.shouldOutputJSON = true
+		return nil
+	}
+	if _, ok := builtInAppShowFormat(o.outputFormat); !ok {
+		if err := o.addFormatPlugin(o.outputFormat); err != nil {
+			return err
+		}
+	}
+	if o.shouldOutputJSON {
+		return fmt.Errorf("--%s %s and --%s cannot be specified together", outputFlag, o.outputFormat, jsonFlag)
+	}
+	if !o.isToolFormat(o.outputFormat) {
+		return nil
+	}
+	if o.shouldExplain {
+		return fmt.Errorf("--%s and --%s %s cannot be specified together", explainFlag, outputFlag, o.outputFormat)
+	}
+	if o.compareEnvs != nil {
+		return fmt.Errorf("--%s and --%s %s cannot be specified together", compareEnvFlag, outputFlag, o.outputFormat)
+	}
+	return nil
+}
+
+// addFormatPlugin finds the plugin of a format of --output that isn't built in, or returns an error if it has none.
+// The built-in formats take precedence, so they're never looked up.
+func (o *showAppOpts) addFormatPlugin(format string) error {
+	path, err := o.formatPluginPath(format)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return unsupportedAppShowOutputError(format)
+	}
+	if o.formatPlugins == nil {
+		o.formatPlugins = make(map[string]string)
+	}
+	o.formatPlugins[format] = path
+	return nil
+}
+
+// formatPluginPath returns the path of the executable plugin of the format in the plugins directory,
+// or an empty string if there is none.
+func (o *showAppOpts) formatPluginPath(format string) (string, error) {
+	if o.pluginsDir == "" || !formatPluginNameRegexp.MatchString(format) {
+		return "", nil
+	}
+	path := filepath.Join(o.pluginsDir, appShowFormatPluginPrefix+format)
+	info, err := o.fs.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("stat format plugin %s: %w", path, err)
+	}
+	if info.IsDir() || info.Mode()&0111 == 0 {
+		return "", fmt.Errorf("format plugin %s of output %q is not an executable file", path, format)
+	}
+	return path, nil
+}
+
+// isToolFormat returns true if the format of --output is read by other tools rather than people,
+// like the formats rendered by a plugin.
+func (o *showAppOpts) isToolFormat(format string) bool {
+	if f, ok := builtInAppShowFormat(format); ok {
+		return f.forTools
+	}
+	return o.isFormatPlugin(format)
+}
+
+// isFormatPlugin returns true if the format of --output is rendered by a plugin.
+func (o *showAppOpts) isFormatPlugin(format string) bool {
+	_, ok := o.formatPlugins[format]
+	return ok
+}
+
+// runFormatPlugin pipes the json description of the application to the plugin of the format, and returns what
+// the plugin wrote to its stdout. The error of a failed plugin includes what it wrote to its stderr.
+func (o *showAppOpts) runFormatPlugin(format string, description *describe.App) (string, error) {
+	in, err := description.JSONString(o.renderOptions(description))
+	if err != nil {
+		return "", fmt.Errorf("get JSON string: %w", err)
+	}
+	path := o.formatPlugins[format]
+	var stdout, stderr bytes.Buffer
+	if err := o.formatter.Run(path, nil, command.Stdin(strings.NewReader(in)), command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("run format plugin %s of output %q: %w: %s", path, format, err, msg)
+		}
+		return "", fmt.Errorf("run format plugin %s of output %q: %w", path, format, err)
+	}
+	return stdout.String(), nil
+}
+
+// writeJSONSchema writes the JSON Schema of the json output for the format version, without any AWS API call.
+func (o *showAppOpts) writeJSONSchema() error {
+	schema, err := describe.AppJSONSchema(o.formatVersion)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, schema)
+	return nil
+}
+
+// formatPluginsDir returns the directory of the format plugins in the user's configuration directory.
+func formatPluginsDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find the directory of the format plugins: %w", err)
+	}
+	return filepath.Join(dir, "copilot", appShowPluginsDirName), nil
+}
+
+// writeOutput writes the description in the single output format of the command.
+func (o *showAppOpts) writeOutput(description *describe.App, healthy bool) error {
+	format := o.outputFormat
+	switch {
+	case o.shouldOutputJSON:
+		format = appShowOutputJSON
+	case format == "":
+		format = appShowOutputHuman
+	}
+	var out string
+	var err error
+	if format == appShowOutputHuman && o.outputTemplate != nil {
+		var b bytes.Buffer
+		if err := o.outputTemplate.Execute(&b, description); err != nil {
+			return fmt.Errorf("execute output template file %s: %w", o.outputTemplateFile, err)
+		}
+		out = b.String()
+	} else if out, err = o.formatString(format, description, healthy); err != nil {
+		return err
+	}
+	out = o.redact(out)
+	done := o.startPhase("render output")
+	if err := o.render(out); err != nil {
+		return err
+	}
+	done()
+	if o.shouldCopy {
+		o.copy(out)
+	}
+	return nil
+}
+
+// formatString renders the description in a format of --output, built in or rendered by its plugin.
+func (o *showAppOpts) formatString(format string, description *describe.App, healthy bool) (string, error) {
+	if f, ok := builtInAppShowFormat(format); ok {
+		return f.render(o, description, healthy)
+	}
+	return o.runFormatPlugin(format, description)
+}
+
+// humanString returns the human readable output of the description, or the dashboard with --dashboard.
+func (o *showAppOpts) humanString(description *describe.App, healthy bool) string {
+	switch {
+	case o.shouldShowDashboard:
+		return description.DashboardString()
+	case o.shouldOnlyFailing && healthy:
+		return fmt.Sprintf(fmtAppShowHealthy, color.HighlightUserInput(o.name))
+	default:
+		return description.HumanString(o.renderOptions(description))
+	}
+}
+
+// renderOptions returns how the description is rendered with the flags of the command.
+func (o *showAppOpts) renderOptions(description *describe.App) describe.AppRenderOptions {
+	return describe.AppRenderOptions{
+		ShowResources:      o.shouldOutputResources,
+		ShowTags:           o.shouldShowTags,
+		ShowPipelineStages: o.isVerbose,
+		Width:              o.tableWidth(),
+		IndentJSON:         o.shouldPrettyPrint,
+		OmitJSON:           o.omitFields,
+		FormatVersion:      o.formatVersion,
+		EnvSort:            o.sortEnvs,
+		HideLegend:         o.noLegend,
+		Sources:            o.sources(description),
+	}
+}
+
+// watch describes the application every --watch-interval until the command is interrupted, and renders each refresh
+// in place of the previous one. With --diff, the lines that changed since the previous refresh are highlighted,
+// and followed by the fields of the description that changed as found by describe.App.Diff.
+func (o *showAppOpts) watch() error {
+	var previous *describe.App
+	var previousOut string
+	for {
+		description, err := o.description()
+		if err != nil {
+			return err
+		}
+		healthy := len(o.failing) == 0 && len(description.Warnings) == 0
+		if o.shouldOnlyFailing {
+			o.onlyFailing(description)
+		}
+		out := o.redact(o.humanString(description, healthy))
+		refresh := out
+		if o.shouldWatchDiff && previous != nil {
+			diffs, err := description.Diff(previous)
+			if err != nil {
+				return fmt.Errorf("compare with the previous refresh: %w", err)
+			}
+			changes := &describe.AppRefreshChanges{
+				Differences: diffs,
+				Width:       o.tableWidth(),
+			}
+			refresh = describe.HighlightChangedLines(out, previousOut) + o.redact(changes.HumanString())
+		}
+		if o.isInterrupted() {
+			// The description is likely missing the values whose calls were aborted.
+			return nil
+		}
+		if o.isTerminal() {
+			fmt.Fprint(o.w, clearScreen)
+		}
+		fmt.Fprint(o.w, refresh)
+		previous, previousOut = description, out
+		if !o.wait(o.watchInterval) {
+			return nil
+		}
+	}
+}
+
+// writeOutputTargets writes the description in each format of --output to its target, so that the application is
+// only described once. The outputs written to a file have no colors.
+func (o *showAppOpts) writeOutputTargets(description *describe.App, healthy bool) error {
+	if o.isInterrupted() {
+		return nil
+	}
+	done := o.startPhase("render output")
+	defer done()
+	for _, target := range o.outputTargets {
+		out, err := o.formatString(target.format, description, healthy)
+		if err != nil {
+			return err
+		}
+		out = o.redact(out)
+		if target.path != appShowOutputStdout {
+			if err := afero.WriteFile(o.fs, target.path, []byte(color.Strip(out)), 0644); err != nil {
+				return fmt.Errorf("write %s output to %s: %w", target.format, target.path, err)
+			}
+			continue
+		}
+		if target.format == appShowOutputHuman {
+			if err := o.render(out); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Fprint(o.w, out)
+	}
+	return nil
+}
+
+// writeValidation writes the problems found while describing the application instead of its description.
+// It returns an error if any of them is of error severity.
+func (o *showAppOpts) writeValidation(description *describe.App) error {
+	if err := o.render(o.redact(description.ValidationString())); err != nil {
+		return err
+	}
+	var count int
+	for _, warning := range description.Warnings {
+		if warning.AtLeast(describe.WarningSeverityError) {
+			count++
+		}
+	}
+	if count != 0 {
+		return &errValidateOnlyProblems{count: count}
+	}
+	return nil
+}
+
+// writeBaselineDiff writes the fields of the description that differ from the baseline,
+// and returns an error if any differ.
+func (o *showAppOpts) writeBaselineDiff(description *describe.App) error {
+	diffs, err := description.Diff(o.baseline)
+	if err != nil {
+		return fmt.Errorf("compare with baseline file %s: %w", o.diffBaseline, err)
+	}
+	diff := describe.NewAppBaselineDiff(o.name, o.diffBaseline, diffs)
+	diff.Width = o.tableWidth()
+	var out string
+	if o.shouldOutputJSON {
+		out, err = diff.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+	} else {
+		out = diff.HumanString()
+	}
+	if err := o.render(o.redact(out)); err != nil {
+		return err
+	}
+	if len(diffs) != 0 {
+		return &errBaselineDiffers{path: o.diffBaseline, count: len(diffs)}
+	}
+	return nil
+}
+
+// writeCounts writes the tallies of the application instead of its description.
+func (o *showAppOpts) writeCounts() error {
+	counts, err := o.counts()
+	if err != nil {
+		return err
+	}
+	var out string
+	if o.shouldOutputJSON {
+		out, err = counts.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+	} else {
+		out = counts.HumanString()
+	}
+	return o.render(o.redact(out))
+}
+
+// writeBenchmark writes the wall-clock time of each phase to the diagnostics writer, so that stdout is unaffected.
+func (o *showAppOpts) writeBenchmark(start time.Time) {
+	total := o.now().Sub(start)
+	writer := tabwriter.NewWriter(o.diagW, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "%s\t%s\n", "Phase", "Duration")
+	fmt.Fprintf(writer, "%s\t%s\n", "-----", "--------")
+	for _, phase := range o.phases {
+		fmt.Fprintf(writer, "%s\t%s\n", phase.name, phase.duration.Round(time.Millisecond))
+	}
+	fmt.Fprintf(writer, "%s\t%s\n", "total", total.Round(time.Millisecond))
+	writer.Flush()
+	var batchSize float64
+	if o.stackListings != 0 {
+		batchSize = float64(len(o.envStacks)) / float64(o.stackListings)
+	}
+	limit, throttled := o.maxConcurrency(), 0
+	if o.limiter != nil {
+		limit, throttled = o.limiter.Limit()
+	}
+	fmt.Fprintf(o.diagW, "\nStack listings: %d for %d environments, a batch size of %.1f\n", o.stackListings, len(o.envStacks), batchSize)
+	fmt.Fprintf(o.diagW, "Concurrency: %d calls in flight after %d throttled calls\n", limit, throttled)
+}
+
+// writeAuditedCalls writes the distinct AWS API calls made by the command to the diagnostics writer, so that stdout is unaffected.
+func (o *showAppOpts) writeAuditedCalls() {
+	writer := tabwriter.NewWriter(o.diagW, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Host", "Service", "Operation")
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "----", "-------", "---------")
+	for _, call := range o.calls.Calls() {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", call.Host, call.Service, call.Operation)
+	}
+	writer.Flush()
+}
+
+// writeCallCounts writes a summary of the AWS API calls made by the command to the diagnostics writer, with the number of
+// calls to each service from the most called one, so that stdout is unaffected.
+func (o *showAppOpts) writeCallCounts() {
+	counts := o.calls.ServiceCounts()
+	var total int
+	services := make([]string, 0, len(counts))
+	for service, count := range counts {
+		total += count
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		if counts[services[i]] != counts[services[j]] {
+			return counts[services[i]] > counts[services[j]]
+		}
+		return services[i] < services[j]
+	})
+	summary := fmt.Sprintf("Made %s across %s", english.Plural(total, "AWS API call", ""), english.Plural(len(services), "service", ""))
+	if len(services) == 0 {
+		fmt.Fprintf(o.diagW, "%s\n", summary)
+		return
+	}
+	breakdown := make([]string, len(services))
+	for i, service := range services {
+		breakdown[i] = fmt.Sprintf("%s %d", service, counts[service])
+	}
+	fmt.Fprintf(o.diagW, "%s: %s\n", summary, strings.Join(breakdown, ", "))
+}
+
+// tableWidth returns the width that the tables of the human readable output are truncated to.
+// It returns zero, for no truncation, with --full or --json. Otherwise, --max-width takes precedence
+// over the width of the terminal, which is unreliable in multiplexed terminals and CI logs.
+func (o *showAppOpts) tableWidth() int {
+	if o.shouldShowFull || o.shouldOutputJSON {
+		return 0
+	}
+	if o.isMaxWidthSet {
+		return o.maxWidth
+	}
+	return o.screenWidth()
+}
+
+// redact replaces the account IDs in the output with tokens like account-0001 if --redact is set.
+// Each distinct account ID gets its own token, the same wherever and whenever it appears during the command,
+// so that the resources of the same account can still be told apart from the ones of other accounts.
+func (o *showAppOpts) redact(out string) string {
+	if !o.shouldRedact {
+		return out
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return redactedAccountIDRegexp.ReplaceAllStringFunc(out, func(id string) string {
+		if token, ok := o.redactedIDs[id]; ok {
+			return token
+		}
+		if o.redactedIDs == nil {
+			o.redactedIDs = make(map[string]string)
+		}
+		token := fmt.Sprintf(fmtRedactedAccountID, len(o.redactedIDs)+1)
+		o.redactedIDs[id] = token
+		return token
+	})
+}
+
+// render writes the output, through the pager if it was requested for human readable output on a terminal.
+func (o *showAppOpts) render(out string) error {
+	if o.isInterrupted() {
+		// The output is likely missing the values whose calls were aborted.
+		return nil
+	}
+	if !o.shouldPage || o.shouldOutputJSON || o.isToolFormat(o.outputFormat) || !o.isTerminal() {
+		fmt.Fprint(o.w, out)
+		return nil
+	}
+	if err := o.pager.Page(out); err != nil {
+		return fmt.Errorf("page output: %w", err)
+	}
+	if o.teeW != nil {
+		// The pager writes to the terminal directly rather than to stdout.
+		fmt.Fprint(o.teeW, out)
+	}
+	return nil
+}
+
+// copy writes the output to the clipboard without its colors.
+// The output is already rendered, so failing to copy it is only reported as a warning.
+func (o *showAppOpts) copy(out string) {
+	err := o.clipboard.Copy(color.Strip(out))
+	if errors.Is(err, clipboard.ErrUnavailable) {
+		log.Warningln("Couldn't copy the output because no clipboard is available on this system.")
+		return
+	}
+	if err != nil {
+		log.Warningf("Couldn't copy the output: %v\n", err)
+	}
+}
+
+// writeTemplates writes the deployed template of each stack of the application to a file named after the stack in the templates directory.
+func (o *showAppOpts) writeTemplates(envs []*config.Environment) error {
+	type envStack struct {
+		env  *config.Environment
+		name string
+	}
+	var stacks []envStack
+	for _, env := range envs {
+		descs, err := o.stacks(env)
+		if err != nil {
+			return err
+		}
+		for _, desc := range descs {
+			stacks = append(stacks, envStack{env: env, name: aws.StringValue(desc.StackName)})
+		}
+	}
+	err := forEachConcurrently(len(stacks), o.maxConcurrency(), func(i int) error {
+		env, name := stacks[i].env, stacks[i].name
+		getter, err := o.newTemplateGetter(env)
+		if err != nil {
+			return fmt.Errorf("create stack client for environment %s: %w", env.Name, err)
+		}
+		body, err := getter.TemplateBody(name)
+		if err != nil {
+			return fmt.Errorf("get template of stack %s: %w", name, err)
+		}
+		path := filepath.Join(o.templatesDir, fmt.Sprintf(fmtStackTemplateFileName, name))
+		if err := afero.WriteFile(o.fs, path, []byte(body), 0644); err != nil {
+			return fmt.Errorf("write template of stack %s to %s: %w", name, path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Successf("Wrote the templates of %d stacks to %s.\n", len(stacks), o.templatesDir)
+	return nil
+}
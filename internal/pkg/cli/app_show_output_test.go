@@ -0,0 +1,622 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowAppOpts_Benchmark(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
+	mockStackLister := mocks.NewMockstackLister(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{
+		Name:      "my-app",
+		AccountID: "123456789012",
+		Version:   "v1.0.0",
+	}, nil)
+	mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+	mockStore.EXPECT().ListServices("my-app").Return(nil, nil)
+	mockPLSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+	mockStackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+
+	// Every reading of the clock advances it by a second.
+	clock := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	b, diag := &bytes.Buffer{}, &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:             "my-app",
+			shouldOutputJSON: true,
+			shouldBenchmark:  true,
+		},
+		store:       mockStore,
+		w:           b,
+		diagW:       diag,
+		pipelineSvc: mockPLSvc,
+		addons:      &fakeAddonsReader{},
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return mockStackLister, nil
+		},
+		now: func() time.Time {
+			clock = clock.Add(time.Second)
+			return clock
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"test":"unknown"}}`+"\n", b.String(), "expected the output to be unaffected")
+	require.Equal(t, `Phase                 Duration
+-----                 --------
+read config store     1s
+list pipelines        1s
+describe deployments  1s
+render output         1s
+total                 9s
+
+Stack listings: 1 for 1 environments, a batch size of 1.0
+Concurrency: 5 calls in flight after 0 throttled calls
+`, diag.String())
+}
+
+func TestShowAppOpts_TableWidth(t *testing.T) {
+	testCases := map[string]struct {
+		inMaxWidth      int
+		inIsMaxWidthSet bool
+		inFull          bool
+		inJSON          bool
+
+		wanted int
+	}{
+		"uses the width of the terminal without --max-width": {
+			wanted: 120,
+		},
+		"clamps to a --max-width narrower than the terminal": {
+			inMaxWidth:      40,
+			inIsMaxWidthSet: true,
+
+			wanted: 40,
+		},
+		"clamps to a --max-width wider than the terminal": {
+			inMaxWidth:      200,
+			inIsMaxWidthSet: true,
+
+			wanted: 200,
+		},
+		"disables the truncation with a zero --max-width": {
+			inIsMaxWidthSet: true,
+
+			wanted: 0,
+		},
+		"does not truncate the json output": {
+			inMaxWidth:      40,
+			inIsMaxWidthSet: true,
+			inJSON:          true,
+
+			wanted: 0,
+		},
+		"does not truncate with full": {
+			inFull: true,
+
+			wanted: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					maxWidth:         tc.inMaxWidth,
+					shouldShowFull:   tc.inFull,
+					shouldOutputJSON: tc.inJSON,
+				},
+				isMaxWidthSet: tc.inIsMaxWidthSet,
+				screenWidth: func() int {
+					return 120
+				},
+			}
+
+			require.Equal(t, tc.wanted, opts.tableWidth())
+		})
+	}
+}
+
+func TestShowAppOpts_Redact(t *testing.T) {
+	testCases := map[string]struct {
+		inRedact bool
+		inOut    string
+
+		wanted string
+	}{
+		"leaves the output as is without redact": {
+			inOut: "arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole",
+
+			wanted: "arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole",
+		},
+		"masks the account IDs in ARNs and image URIs": {
+			inRedact: true,
+			inOut:    "arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole 123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+
+			wanted: "arn:aws:iam::account-0001:role/my-app-test-EnvManagerRole account-0001.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+		},
+		"gives distinct tokens to the account IDs that share their last digits": {
+			inRedact: true,
+			inOut:    "111111119012 222222229012 111111119012",
+
+			wanted: "account-0001 account-0002 account-0001",
+		},
+		"leaves the numbers that aren't account IDs": {
+			inRedact: true,
+			inOut:    "1234567890123 my-app-test-api:12 1622505600.000",
+
+			wanted: "1234567890123 my-app-test-api:12 1622505600.000",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					shouldRedact: tc.inRedact,
+				},
+			}
+
+			require.Equal(t, tc.wanted, opts.redact(tc.inOut))
+		})
+	}
+}
+
+func TestShowAppOpts_WriteOutputTargets(t *testing.T) {
+	description := &describe.App{
+		Name: "my-app",
+		Envs: []*config.Environment{
+			{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		},
+	}
+	testCases := map[string]struct {
+		inTargets    []appShowOutputTarget
+		inRedact     bool
+		inReadOnlyFs bool
+
+		wantedStdout string
+		wantedFiles  map[string]string
+		wantedError  error
+	}{
+		"writes each format to its target from the same description": {
+			inTargets: []appShowOutputTarget{
+				{format: "json", path: "app.json"},
+				{format: "csv", path: "-"},
+			},
+			inRedact: true,
+
+			wantedStdout: "app,environment,service,type,endpoint,status\n",
+			wantedFiles: map[string]string{
+				"app.json": `{"name":"my-app","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"account-0001","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null}` + "\n",
+			},
+		},
+		"errors if an output can't be written to its file": {
+			inTargets: []appShowOutputTarget{
+				{format: "json", path: "app.json"},
+			},
+			inReadOnlyFs: true,
+
+			wantedError: errors.New("write json output to app.json: operation not permitted"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &bytes.Buffer{}
+			fs := afero.NewMemMapFs()
+			var writeFs afero.Fs = fs
+			if tc.inReadOnlyFs {
+				writeFs = afero.NewReadOnlyFs(fs)
+			}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:         "my-app",
+					shouldRedact: tc.inRedact,
+				},
+				w:             b,
+				fs:            writeFs,
+				outputTargets: tc.inTargets,
+				screenWidth:   func() int { return 80 },
+				now: func() time.Time {
+					return time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+				},
+			}
+
+			// WHEN
+			err := opts.writeOutputTargets(description, true)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStdout, b.String())
+			for path, content := range tc.wantedFiles {
+				actual, err := afero.ReadFile(fs, path)
+				require.NoError(t, err)
+				require.Equal(t, content, string(actual))
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_FormatPlugins(t *testing.T) {
+	const pluginsDir = "/home/user/.config/copilot/plugins"
+	description := &describe.App{Name: "my-app"}
+	testCases := map[string]struct {
+		inOutput  string
+		inJSON    bool
+		inPlugins map[string]os.FileMode
+		setupMock func(m *mocks.Mockrunner)
+
+		wantedStdout   string
+		wantedValidate error
+		wantedError    error
+	}{
+		"pipes the json description to the plugin of the format": {
+			inOutput:  "table",
+			inPlugins: map[string]os.FileMode{"copilot-format-table": 0755},
+			setupMock: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(pluginsDir+"/copilot-format-table", nil, gomock.Any()).
+					DoAndReturn(func(_ string, _ []string, options ...command.Option) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range options {
+							opt(cmd)
+						}
+						in, err := ioutil.ReadAll(cmd.Stdin)
+						if err != nil {
+							return err
+						}
+						_, err = io.WriteString(cmd.Stdout, fmt.Sprintf("table of %s", in))
+						return err
+					})
+			},
+
+			wantedStdout: `table of {"name":"my-app","environments":null,"services":null,"pipelines":null}` + "\n",
+		},
+		"the built-in formats take precedence over the plugins": {
+			inOutput:  "csv",
+			inPlugins: map[string]os.FileMode{"copilot-format-csv": 0755},
+			setupMock: func(m *mocks.Mockrunner) {},
+
+			wantedStdout: "app,environment,service,type,endpoint,status\n",
+		},
+		"errors with the stderr of a failed plugin": {
+			inOutput:  "table",
+			inPlugins: map[string]os.FileMode{"copilot-format-table": 0755},
+			setupMock: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(pluginsDir+"/copilot-format-table", nil, gomock.Any()).
+					DoAndReturn(func(_ string, _ []string, options ...command.Option) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range options {
+							opt(cmd)
+						}
+						io.WriteString(cmd.Stderr, "unexpected end of JSON input\n")
+						return errors.New("exit status 1")
+					})
+			},
+
+			wantedError: errors.New(`run format plugin /home/user/.config/copilot/plugins/copilot-format-table of output "table": exit status 1: unexpected end of JSON input`),
+		},
+		"errors if the format has no plugin": {
+			inOutput:  "table",
+			setupMock: func(m *mocks.Mockrunner) {},
+
+			wantedValidate: errors.New(`unsupported output "table", must be one of human, json, csv, openmetrics, lines, markdown, go, mermaid or dotenv`),
+		},
+		"errors if the plugin isn't executable": {
+			inOutput:  "table",
+			inPlugins: map[string]os.FileMode{"copilot-format-table": 0644},
+			setupMock: func(m *mocks.Mockrunner) {},
+
+			wantedValidate: errors.New(`format plugin /home/user/.config/copilot/plugins/copilot-format-table of output "table" is not an executable file`),
+		},
+		"doesn't look up a plugin for a format that is a path": {
+			inOutput:  "../table",
+			setupMock: func(m *mocks.Mockrunner) {},
+
+			wantedValidate: errors.New(`unsupported output "../table", must be one of human, json, csv, openmetrics, lines, markdown, go, mermaid or dotenv`),
+		},
+		"errors if a plugin format is used with --json": {
+			inOutput:  "table",
+			inJSON:    true,
+			inPlugins: map[string]os.FileMode{"copilot-format-table": 0755},
+			setupMock: func(m *mocks.Mockrunner) {},
+
+			wantedValidate: errors.New("--output table and --json cannot be specified together"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMockrunner(ctrl)
+			tc.setupMock(mockRunner)
+			fs := afero.NewMemMapFs()
+			for name, mode := range tc.inPlugins {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(pluginsDir, name), []byte("#!/bin/sh\n"), mode))
+			}
+			b := &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					outputFormat:     tc.inOutput,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:           b,
+				fs:          fs,
+				formatter:   mockRunner,
+				pluginsDir:  pluginsDir,
+				isTerminal:  func() bool { return false },
+				screenWidth: func() int { return 80 },
+				now: func() time.Time {
+					return time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+				},
+			}
+
+			// WHEN
+			err := opts.validateOutputFormat()
+			if tc.wantedValidate != nil {
+				require.EqualError(t, err, tc.wantedValidate.Error())
+				return
+			}
+			require.NoError(t, err)
+			err = opts.writeOutput(description, true)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStdout, b.String())
+		})
+	}
+}
+
+func TestShowAppOpts_Watch(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{
+		Name:    "my-app",
+		Version: "v1.0.0",
+	}, nil).Times(2)
+	mockStore.EXPECT().ListEnvironments("my-app").Return(nil, nil).Times(2)
+	gomock.InOrder(
+		mockStore.EXPECT().ListServices("my-app").Return(nil, nil),
+		mockStore.EXPECT().ListServices("my-app").Return([]*config.Workload{
+			{Name: "api", Type: "Load Balanced Web Service"},
+		}, nil),
+	)
+
+	var refreshes int
+	b := &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:            "my-app",
+			noPipelines:     true,
+			shouldWatch:     true,
+			shouldWatchDiff: true,
+			watchInterval:   defaultWatchInterval,
+		},
+		store:  mockStore,
+		w:      b,
+		addons: &fakeAddonsReader{},
+		ctx:    ctx,
+		isTerminal: func() bool {
+			return false
+		},
+		screenWidth: func() int {
+			return 0
+		},
+		after: func(d time.Duration) <-chan time.Time {
+			require.Equal(t, defaultWatchInterval, d)
+			refreshes++
+			if refreshes == 2 {
+				// The command is interrupted while waiting for the third refresh.
+				cancel()
+				return nil
+			}
+			elapsed := make(chan time.Time, 1)
+			elapsed <- time.Time{}
+			return elapsed
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.Equal(t, &ErrSilentExit{Code: 130}, err, "expected the watch to stop like any interrupted command")
+	require.Equal(t, 2, refreshes)
+	require.Equal(t, 2, strings.Count(b.String(), "About\n"), "expected the application to be described on each refresh")
+	require.Equal(t, 1, strings.Count(b.String(), "Changes Since Previous Refresh"), "expected the changes to be listed from the second refresh")
+	require.Contains(t, b.String(), `  services          null                [{"app":"","name":"api","type":"Load Balanced Web Service"}]`)
+}
+
+func TestShowAppOpts_AuditCalls(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockCalls := mocks.NewMockcallRecorder(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+	mockCalls.EXPECT().Calls().Return([]sessions.APICall{
+		{Host: "ssm.us-west-2.amazonaws.com", Service: "SSM", Operation: "GetParameter"},
+		{Host: "sts.amazonaws.com", Service: "STS", Operation: "GetCallerIdentity"},
+	})
+
+	b, diag := &bytes.Buffer{}, &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:             "my-app",
+			shouldOutputJSON: true,
+			shouldAuditCalls: true,
+		},
+		store: mockStore,
+		w:     b,
+		diagW: diag,
+		calls: mockCalls,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.EqualError(t, err, "get application my-app: some error")
+	require.Empty(t, b.String(), "expected the output to be unaffected")
+	require.Equal(t, `Host                         Service  Operation
+----                         -------  ---------
+ssm.us-west-2.amazonaws.com  SSM      GetParameter
+sts.amazonaws.com            STS      GetCallerIdentity
+`, diag.String(), "expected the calls to be written even if the command fails")
+}
+
+func TestShowAppOpts_CallCounts(t *testing.T) {
+	testCases := map[string]struct {
+		inCounts map[string]int
+
+		wantedDiag string
+	}{
+		"summarizes the calls to each service from the most called one": {
+			inCounts: map[string]int{
+				"SSM":            6,
+				"CloudFormation": 12,
+				"STS":            2,
+				"CodePipeline":   2,
+			},
+			wantedDiag: "Made 22 AWS API calls across 4 services: CloudFormation 12, SSM 6, CodePipeline 2, STS 2\n",
+		},
+		"summarizes a single call": {
+			inCounts:   map[string]int{"SSM": 1},
+			wantedDiag: "Made 1 AWS API call across 1 service: SSM 1\n",
+		},
+		"summarizes no calls": {
+			inCounts:   map[string]int{},
+			wantedDiag: "Made 0 AWS API calls across 0 services\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockCalls := mocks.NewMockcallRecorder(ctrl)
+			mockStore.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			mockCalls.EXPECT().ServiceCounts().Return(tc.inCounts)
+
+			b, diag := &bytes.Buffer{}, &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					shouldOutputJSON: true,
+					isVerbose:        true,
+				},
+				store: mockStore,
+				w:     b,
+				diagW: diag,
+				calls: mockCalls,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.EqualError(t, err, "get application my-app: some error")
+			require.Empty(t, b.String(), "expected the output to be unaffected")
+			require.Equal(t, tc.wantedDiag, diag.String(), "expected the calls to be summarized even if the command fails")
+		})
+	}
+}
+
+func TestShowAppOpts_WriteBaselineDiff(t *testing.T) {
+	testCases := map[string]struct {
+		inBaseline *describe.App
+		inJSON     bool
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"writes that the application matches the baseline": {
+			inBaseline: &describe.App{Name: "my-app", Owner: "team-a"},
+
+			wantedContent: "Application my-app matches baseline snapshot.json.\n",
+		},
+		"writes the differences as json and errors if any field differs": {
+			inBaseline: &describe.App{Name: "my-app", Owner: "team-b"},
+			inJSON:     true,
+
+			wantedContent: `{"app":"my-app","baseline":"snapshot.json","differences":[{"path":"owner","baseline":"\"team-b\"","live":"\"team-a\""}]}` + "\n",
+			wantedErr:     errors.New("1 field differs from baseline snapshot.json"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					diffBaseline:     "snapshot.json",
+					shouldOutputJSON: tc.inJSON,
+					shouldShowFull:   true,
+				},
+				baseline: tc.inBaseline,
+				w:        b,
+			}
+
+			// WHEN
+			err := opts.writeBaselineDiff(&describe.App{Name: "my-app", Owner: "team-a"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -5,379 +5,6194 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"text/template"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awscloudtrail "github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	awscodestar "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/clipboard"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
 type showAppMocks struct {
-	storeSvc    *mocks.Mockstore
-	prompt      *mocks.Mockprompter
-	sel         *mocks.MockappSelector
-	pipelineSvc *mocks.MockpipelineGetter
+	storeSvc       *mocks.Mockstore
+	prompt         *mocks.Mockprompter
+	sel            *mocks.MockappSelector
+	pipelineSvc    *mocks.MockpipelineGetter
+	stackLister    *mocks.MockstackLister
+	stackResources *mocks.MockstackResourcesGetter
+	taskDefGetter  *mocks.MocktaskDefinitionGetter
+	appRunnerDescr *mocks.MockappRunnerServiceDescriber
+	clipboard      *mocks.MockclipboardWriter
+	pager          *mocks.MockoutputPager
+	certDescr      *mocks.MockcertificateDescriber
+	webACLs        *mocks.MockwebACLGetter
+	ecsServices    *mocks.MockecsServiceDescriber
+	connections    *mocks.MockconnectionGetter
+	builds         *mocks.MockpipelineBuildGetter
+	templateGetter *mocks.MockstackTemplateGetter
+	deployments    *mocks.MockstackDeploymentGetter
+	logRetention   *mocks.MocklogGroupRetentionGetter
+	appResources   *mocks.MockappResourcesGetter
+	objectCounter  *mocks.MockbucketObjectCounter
+	alarmGetter    *mocks.MockalarmStatusGetter
+	executions     *mocks.MockjobExecutionLister
+	appChoices     *mocks.MockappChoiceLister
+	identity       *mocks.MockidentityService
+	clock          *mocks.MockserverClock
+	wsSvcs         *mocks.MockwsAppSvcReader
+	appStacks      *mocks.MockstackDescriber
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		inAppName  string
+		inAppName        string
+		inProfileFromEnv string
+		inJSON           bool
+		inExplain        bool
+		inOnlyFailing    bool
+		inCompareEnvs    []string
+		inIncludeTpls    bool
+		inTemplatesDir   string
+		inReadOnlyFs     bool
+		inStrict         bool
+		inFailOn         string
+		inOutput         string
+		inExists         bool
+		inListOnly       bool
+		inMaxRetries     int
+		inRetryDelay     time.Duration
+		inRetryOnEmpty   int
+		inSerial         bool
+		inHint           bool
+		inParallelEnvs   int
+		inStoreEndpoint  string
+		inDashboard      bool
+		inValidateOnly   bool
+		inTemplateFile   string
+		inOutputs        []string
+		inPromotionCheck []string
+		inPipelineSource string
+		inSortEnvs       string
+		inFormatVersion  string
+		inAssumeYes      bool
+		inAssumeNo       bool
+		inMaxWidth       int
+		inIsMaxWidthSet  bool
+		inFull           bool
+		inNoPipelines    bool
+		inDiffBaseline   string
+		inDoctor         bool
+		inCountOnly      bool
+		inOmit           []string
+		inTee            string
+		inAllowFallback  bool
+		inStrictJSON     bool
+		inWatch          bool
+		inWatchDiff      bool
+		inWatchInterval  time.Duration
+		inJSONSchema     bool
+		setupMocks       func(mocks showAppMocks)
+		setupFs          func(fs afero.Fs)
+
+		wantedAppName       string
+		wantedNameMatches   []string
+		wantedEnvProfiles   map[string]string
+		wantedOutputFormat  string
+		wantedOutputTargets []appShowOutputTarget
+		wantedBaseline      *describe.App
+		wantedOmitFields    []string
+		wantedError         error
+	}{
+		"invalid negative --max-retries": {
+			inAppName:    "my-app",
+			inMaxRetries: -1,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--max-retries must be non-negative, got -1"),
+		},
+		"invalid negative --retry-base-delay": {
+			inAppName:    "my-app",
+			inRetryDelay: -time.Second,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--retry-base-delay must be non-negative, got -1s"),
+		},
+		"invalid negative --retry-on-empty": {
+			inAppName:      "my-app",
+			inRetryOnEmpty: -1,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--retry-on-empty must be non-negative, got -1"),
+		},
+		"invalid negative --parallel-envs": {
+			inAppName:      "my-app",
+			inParallelEnvs: -2,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--parallel-envs must not be negative, got -2"),
+		},
+		"invalid --serial with --parallel-envs": {
+			inAppName:      "my-app",
+			inSerial:       true,
+			inParallelEnvs: 4,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--serial and --parallel-envs cannot be specified together"),
+		},
+		"valid --max-retries and --retry-base-delay": {
+			inAppName:    "my-app",
+			inMaxRetries: 0,
+			inRetryDelay: 2 * time.Second,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			wantedAppName: "my-app",
+		},
+		"invalid --store-endpoint": {
+			inAppName:       "my-app",
+			inStoreEndpoint: "localhost:4566",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`--store-endpoint: endpoint "localhost:4566" must be an absolute URL starting with http:// or https://`),
+		},
+		"requires the name with --exists": {
+			inExists: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--name is required with --exists"),
+		},
+		"invalid --exists with --list-only": {
+			inAppName:  "my-app",
+			inExists:   true,
+			inListOnly: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--exists and --list-only cannot be specified together"),
+		},
+		"does not match the name with --exists": {
+			inAppName: "my-ap",
+			inExists:  true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedAppName: "my-ap",
+		},
+		"valid app name": {
+			inAppName: "my-app",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+			},
+			wantedAppName: "my-app",
+			wantedError:   nil,
+		},
+		"invalid app name": {
+			inAppName: "my-app",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
+		},
+		"throttled config store with --allow-stack-fallback": {
+			inAppName:       "my-app",
+			inAllowFallback: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, awserr.New("ThrottlingException", "Rate exceeded", nil))
+			},
+
+			wantedAppName: "my-app",
+		},
+		"exact app name wins over partial matches": {
+			inAppName: "pay",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("pay").Return(&config.Application{Name: "pay"}, nil)
+				m.storeSvc.EXPECT().ListApplications().Times(0)
+			},
+
+			wantedAppName: "pay",
+		},
+		"selects the only app starting with a partial name": {
+			inAppName: "pay",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("pay").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "pay"})
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "payments"},
+					{Name: "prepay"},
+				}, nil)
+			},
+
+			wantedAppName: "payments",
+		},
+		"selects the only app containing a partial name": {
+			inAppName: "ment",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("ment").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "ment"})
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "payments"},
+					{Name: "orders"},
+				}, nil)
+			},
+
+			wantedAppName: "payments",
+		},
+		"defers to Ask if several apps match a partial name": {
+			inAppName: "pay",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("pay").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "pay"})
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "payments"},
+					{Name: "orders"},
+					{Name: "payroll"},
+				}, nil)
+			},
+
+			wantedNameMatches: []string{"payments", "payroll"},
+		},
+		"errors if no app matches a partial name": {
+			inAppName: "pay",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("pay").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "pay"})
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "orders"},
+				}, nil)
+			},
+
+			wantedError: fmt.Errorf("get application pay: couldn't find an application named pay in account  and region "),
+		},
+		"errors the same way if an app name is suggested": {
+			inAppName: "paymnts",
+			inHint:    true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("paymnts").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "paymnts"})
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "payments"},
+					{Name: "orders"},
+				}, nil)
+			},
+
+			wantedError: fmt.Errorf("get application paymnts: couldn't find an application named paymnts in account  and region "),
+		},
+		"errors if fail to list the apps to match a partial name": {
+			inAppName: "pay",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("pay").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "pay"})
+				m.storeSvc.EXPECT().ListApplications().Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list applications: %w", testError),
+		},
+		"reads environment profiles from a YAML file": {
+			inProfileFromEnv: "profiles.yml",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "profiles.yml", []byte("test: dev-account\nprod: prod-account\n"), 0644)
+			},
+
+			wantedEnvProfiles: map[string]string{
+				"test": "dev-account",
+				"prod": "prod-account",
+			},
+		},
+		"reads environment profiles from a JSON file": {
+			inProfileFromEnv: "profiles.json",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "profiles.json", []byte(`{"test": "dev-account"}`), 0644)
+			},
+
+			wantedEnvProfiles: map[string]string{
+				"test": "dev-account",
+			},
+		},
+		"errors if the output template file does not exist before calling AWS": {
+			inAppName:      "my-app",
+			inTemplateFile: "report.tmpl",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("read output template file report.tmpl: open report.tmpl: file does not exist"),
+		},
+		"errors if the output template file can't be parsed before calling AWS": {
+			inAppName:      "my-app",
+			inTemplateFile: "report.tmpl",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "report.tmpl", []byte("{{range .Envs}}{{.Name}}"), 0644)
+			},
+
+			wantedError: fmt.Errorf("parse output template file report.tmpl: template: report.tmpl:1: unexpected EOF"),
+		},
+		"errors if the output template file is used with json": {
+			inTemplateFile: "report.tmpl",
+			inJSON:         true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output-template-file and --json cannot be specified together"),
+		},
+		"parses the output template file": {
+			inTemplateFile: "report.tmpl",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "report.tmpl", []byte("{{range .Envs}}{{.Name}}{{end}}"), 0644)
+			},
+		},
+		"errors if the baseline file does not exist before calling AWS": {
+			inAppName:      "my-app",
+			inDiffBaseline: "snapshot.json",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("read baseline file snapshot.json: open snapshot.json: file does not exist"),
+		},
+		"errors if the baseline file is not json": {
+			inDiffBaseline: "snapshot.json",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "snapshot.json", []byte("name: my-app"), 0644)
+			},
+
+			wantedError: fmt.Errorf("unmarshal baseline file snapshot.json: invalid character 'a' in literal null (expecting 'u')"),
+		},
+		"errors if the baseline file is used with csv": {
+			inDiffBaseline: "snapshot.json",
+			inOutputs:      []string{"csv"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--diff-baseline and --output cannot be specified together"),
+		},
+		"reads the baseline file": {
+			inDiffBaseline: "snapshot.json",
+			inJSON:         true,
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "snapshot.json", []byte(`{"name":"my-app","owner":"team-a"}`), 0644)
+			},
+
+			wantedBaseline: &describe.App{Name: "my-app", Owner: "team-a"},
+		},
+		"resolves a single --output without a target to the output format": {
+			inOutputs: []string{"csv"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedOutputFormat: "csv",
+		},
+		"resolves the formats of several --output to their targets": {
+			inOutputs: []string{"json=app.json", "human=-", "csv=./out/../app.csv"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedOutputTargets: []appShowOutputTarget{
+				{format: "json", path: "app.json"},
+				{format: "human", path: "-"},
+				{format: "csv", path: "./out/../app.csv"},
+			},
+		},
+		"errors if several --output are written to the same file": {
+			inOutputs: []string{"json=./app.out", "csv=app.out"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output json and --output csv cannot be written to the same target app.out"),
+		},
+		"errors if several --output without a target are written to stdout": {
+			inOutputs: []string{"json", "human"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output json and --output human cannot be written to the same target -"),
+		},
+		"errors if a format of several --output is not supported": {
+			inOutputs: []string{"json=app.json", "table=-"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported output "table", must be one of human, json, csv, openmetrics, lines, markdown, go, mermaid or dotenv`),
+		},
+		"errors if an --output has an empty target": {
+			inOutputs: []string{"json="},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output json=: missing the file to write the output to, use - for stdout"),
+		},
+		"errors if several --output are used with json": {
+			inOutputs: []string{"json=app.json", "human=-"},
+			inJSON:    true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output and --json cannot be specified together"),
+		},
+		"invalid negative --max-width": {
+			inMaxWidth:      -1,
+			inIsMaxWidthSet: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--max-width must be non-negative, got -1"),
+		},
+		"errors if --max-width is used with full": {
+			inMaxWidth:      80,
+			inIsMaxWidthSet: true,
+			inFull:          true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--max-width and --full cannot be specified together"),
+		},
+		"valid zero --max-width": {
+			inIsMaxWidthSet: true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if the pipeline source is not supported": {
+			inPipelineSource: "jenkins",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported pipeline source "jenkins", must be one of codepipeline or github-actions`),
+		},
+		"errors if the pipelines of github-actions are skipped": {
+			inPipelineSource: "github-actions",
+			inNoPipelines:    true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--pipeline-source github-actions and --no-pipelines cannot be specified together"),
+		},
+		"skips the pipelines of the default source": {
+			inPipelineSource: "codepipeline",
+			inNoPipelines:    true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if the environment order is not supported": {
+			inSortEnvs: "region",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported environment order "region" for --sort-envs, must be one of name, recency, prod`),
+		},
+		"valid environment order": {
+			inSortEnvs: "recency",
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if the format version is not supported": {
+			inFormatVersion: "2",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported format version "2" for --format-version, must be one of 1`),
+		},
+		"pins the first format version": {
+			inFormatVersion: "1",
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if both yes and no are assumed": {
+			inAssumeYes: true,
+			inAssumeNo:  true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--yes and --no cannot be specified together"),
+		},
+		"errors if --promotion-check doesn't have two environments": {
+			inPromotionCheck: []string{"staging"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--promotion-check requires exactly two environment names"),
+		},
+		"errors if --promotion-check has the same environment twice": {
+			inPromotionCheck: []string{"prod", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--promotion-check requires two different environments"),
+		},
+		"errors if --promotion-check is used with a csv output": {
+			inPromotionCheck: []string{"staging", "prod"},
+			inOutput:         "csv",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--promotion-check and --output cannot be specified together"),
+		},
+		"errors if --promotion-check is used with compare-env": {
+			inPromotionCheck: []string{"staging", "prod"},
+			inCompareEnvs:    []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--promotion-check and --compare-env cannot be specified together"),
+		},
+		"valid --promotion-check with json": {
+			inPromotionCheck: []string{"staging", "prod"},
+			inJSON:           true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if --doctor is used with --validate-only": {
+			inDoctor:       true,
+			inValidateOnly: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--doctor and --validate-only cannot be specified together"),
+		},
+		"errors if --doctor is used with a markdown output": {
+			inDoctor: true,
+			inOutput: "markdown",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--doctor and --output cannot be specified together"),
+		},
+		"valid --doctor with json": {
+			inDoctor: true,
+			inJSON:   true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if --count-only is used with --doctor": {
+			inCountOnly: true,
+			inDoctor:    true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--count-only and --doctor cannot be specified together"),
+		},
+		"errors if --count-only is used with a csv output": {
+			inCountOnly: true,
+			inOutput:    "csv",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--count-only and --output cannot be specified together"),
+		},
+		"valid --count-only with json": {
+			inCountOnly: true,
+			inJSON:      true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if --diff is used without --watch": {
+			inWatchDiff: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--diff requires --watch"),
+		},
+		"errors if --watch-interval isn't positive": {
+			inWatch:         true,
+			inWatchInterval: -time.Second,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--watch-interval must be positive, got -1s"),
+		},
+		"errors if --watch is used with a json output": {
+			inWatch:         true,
+			inWatchInterval: 10 * time.Second,
+			inJSON:          true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--watch and --json cannot be specified together"),
+		},
+		"errors if --watch is used with --fail-on": {
+			inWatch:         true,
+			inWatchInterval: 10 * time.Second,
+			inFailOn:        "warning",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--watch and --fail-on cannot be specified together"),
+		},
+		"errors if --json-schema is used with an unsupported format version": {
+			inJSONSchema:    true,
+			inFormatVersion: "2",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported format version "2" for --format-version, must be one of 1`),
+		},
+		"errors if --json-schema is used with --watch": {
+			inJSONSchema:    true,
+			inWatch:         true,
+			inWatchInterval: 10 * time.Second,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--json-schema and --watch cannot be specified together"),
+		},
+		"valid --json-schema without validating the application": {
+			inJSONSchema:    true,
+			inAppName:       "my-app",
+			inFormatVersion: "1",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedAppName: "my-app",
+		},
+		"valid --watch with --diff": {
+			inWatch:         true,
+			inWatchDiff:     true,
+			inWatchInterval: 10 * time.Second,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if --omit is used without a json output": {
+			inOmit:   []string{"secrets"},
+			inOutput: "csv",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--omit requires --json or --output json"),
+		},
+		"ignores the unknown paths of --omit": {
+			inOmit:   []string{"secrets", "deployments.rawOutputs", "deployments.storage"},
+			inOutput: "json",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedOmitFields: []string{"secrets", "deployments.storage"},
+		},
+		"valid --omit with a json output target": {
+			inOmit:    []string{"secrets"},
+			inOutputs: []string{"json=-", "csv=report.csv"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedOutputTargets: []appShowOutputTarget{
+				{format: "json", path: "-"},
+				{format: "csv", path: "report.csv"},
+			},
+			wantedOmitFields: []string{"secrets"},
+		},
+		"errors if the environment profiles file does not exist": {
+			inProfileFromEnv: "profiles.yml",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("read environment profiles file profiles.yml: open profiles.yml: file does not exist"),
+		},
+		"errors if the environment profiles file is malformed": {
+			inProfileFromEnv: "profiles.yml",
+
+			setupMocks: func(m showAppMocks) {},
+			setupFs: func(fs afero.Fs) {
+				afero.WriteFile(fs, "profiles.yml", []byte("- test\n- prod\n"), 0644)
+			},
+
+			wantedError: fmt.Errorf("unmarshal environment profiles file profiles.yml: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!seq into map[string]string"),
+		},
+		"errors if explain is used with json": {
+			inJSON:    true,
+			inExplain: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--explain and --json cannot be specified together"),
+		},
+		"errors if explain is used with output json": {
+			inOutput:  "json",
+			inExplain: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--explain and --json cannot be specified together"),
+		},
+		"errors if the output is not supported": {
+			inOutput: "yaml",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported output "yaml", must be one of human, json, csv, openmetrics, lines, markdown, go, mermaid or dotenv`),
+		},
+		"errors if output csv is used with json": {
+			inOutput: "csv",
+			inJSON:   true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--output csv and --json cannot be specified together"),
+		},
+		"errors if output csv is used with explain": {
+			inOutput:  "csv",
+			inExplain: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--explain and --output csv cannot be specified together"),
+		},
+		"errors if output csv is used with compare-env": {
+			inOutput:      "csv",
+			inCompareEnvs: []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env and --output csv cannot be specified together"),
+		},
+		"turns on json with strict-json": {
+			inStrictJSON: true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"errors if strict-json is used with output csv": {
+			inStrictJSON: true,
+			inOutput:     "csv",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--strict-json and --output cannot be specified together"),
+		},
+		"errors if strict-json is used with dashboard": {
+			inStrictJSON: true,
+			inDashboard:  true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--strict-json and --dashboard cannot be specified together"),
+		},
+		"errors if dashboard is used with json": {
+			inDashboard: true,
+			inJSON:      true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--dashboard and --json cannot be specified together"),
+		},
+		"errors if dashboard is used with output csv": {
+			inDashboard: true,
+			inOutput:    "csv",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--dashboard and --output cannot be specified together"),
+		},
+		"errors if dashboard is used with compare-env": {
+			inDashboard:   true,
+			inCompareEnvs: []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--dashboard and --compare-env cannot be specified together"),
+		},
+		"errors if validate-only is used with json": {
+			inValidateOnly: true,
+			inJSON:         true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--validate-only and --json cannot be specified together"),
+		},
+		"errors if validate-only is used with dashboard": {
+			inValidateOnly: true,
+			inDashboard:    true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--validate-only and --dashboard cannot be specified together"),
+		},
+		"errors if output openmetrics is used with explain": {
+			inOutput:  "openmetrics",
+			inExplain: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--explain and --output openmetrics cannot be specified together"),
+		},
+		"errors if output lines is used with compare-env": {
+			inOutput:      "lines",
+			inCompareEnvs: []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env and --output lines cannot be specified together"),
+		},
+		"errors if output markdown is used with explain": {
+			inOutput:  "markdown",
+			inExplain: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--explain and --output markdown cannot be specified together"),
+		},
+		"errors if output go is used with compare-env": {
+			inOutput:      "go",
+			inCompareEnvs: []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env and --output go cannot be specified together"),
+		},
+		"errors if compare-env does not have two environments": {
+			inCompareEnvs: []string{"test"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env requires exactly two environment names"),
+		},
+		"errors if compare-env compares an environment with itself": {
+			inCompareEnvs: []string{"test", "test"},
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env requires two different environments"),
+		},
+		"errors if compare-env is used with only-failing": {
+			inCompareEnvs: []string{"test", "prod"},
+			inOnlyFailing: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env and --only-failing cannot be specified together"),
+		},
+		"errors if fail-on is not a severity": {
+			inFailOn: "critical",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf(`unsupported severity "critical" for --fail-on, must be one of info, warning, error`),
+		},
+		"errors if fail-on is used with strict": {
+			inFailOn: "error",
+			inStrict: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--strict and --fail-on cannot be specified together"),
+		},
+		"errors if compare-env is used with include-templates": {
+			inCompareEnvs:  []string{"test", "prod"},
+			inIncludeTpls:  true,
+			inTemplatesDir: "templates",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--compare-env and --include-templates cannot be specified together"),
+		},
+		"errors if include-templates is used without a templates directory": {
+			inIncludeTpls: true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--include-templates requires --templates-dir"),
+		},
+		"errors if templates-dir is used without include-templates": {
+			inTemplatesDir: "templates",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("--templates-dir requires --include-templates"),
+		},
+		"errors if the templates directory is not writable": {
+			inIncludeTpls:  true,
+			inTemplatesDir: "templates",
+			inReadOnlyFs:   true,
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: fmt.Errorf("create directory templates: operation not permitted"),
+		},
+		"errors if the --tee file can't be opened": {
+			inAppName:    "my-app",
+			inTee:        "app-show.log",
+			inReadOnlyFs: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			wantedError: fmt.Errorf("open --tee file app-show.log: operation not permitted"),
+		},
+		"creates the templates directory": {
+			inIncludeTpls:  true,
+			inTemplatesDir: "templates",
+
+			setupMocks: func(m showAppMocks) {},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := mocks.NewMockstore(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			if tc.inReadOnlyFs {
+				fs = afero.NewReadOnlyFs(fs)
+			}
+
+			mocks := showAppMocks{
+				storeSvc: mockStoreReader,
+				prompt:   mockPrompter,
+			}
+			tc.setupMocks(mocks)
+
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:                tc.inAppName,
+					profileFromEnv:      tc.inProfileFromEnv,
+					shouldOutputJSON:    tc.inJSON,
+					shouldExplain:       tc.inExplain,
+					shouldOnlyFailing:   tc.inOnlyFailing,
+					compareEnvs:         tc.inCompareEnvs,
+					includeTemplates:    tc.inIncludeTpls,
+					templatesDir:        tc.inTemplatesDir,
+					isStrict:            tc.inStrict,
+					failOn:              tc.inFailOn,
+					outputFormat:        tc.inOutput,
+					shouldCheckExists:   tc.inExists,
+					shouldListOnly:      tc.inListOnly,
+					maxRetries:          tc.inMaxRetries,
+					retryBaseDelay:      tc.inRetryDelay,
+					retryOnEmpty:        tc.inRetryOnEmpty,
+					shouldRunSerially:   tc.inSerial,
+					shouldHintNames:     tc.inHint,
+					parallelEnvs:        tc.inParallelEnvs,
+					storeEndpoint:       tc.inStoreEndpoint,
+					shouldShowDashboard: tc.inDashboard,
+					shouldValidateOnly:  tc.inValidateOnly,
+					outputTemplateFile:  tc.inTemplateFile,
+					outputs:             tc.inOutputs,
+					promotionCheck:      tc.inPromotionCheck,
+					pipelineSource:      tc.inPipelineSource,
+					sortEnvs:            tc.inSortEnvs,
+					formatVersion:       tc.inFormatVersion,
+					shouldAssumeYes:     tc.inAssumeYes,
+					shouldAssumeNo:      tc.inAssumeNo,
+					noPipelines:         tc.inNoPipelines,
+					diffBaseline:        tc.inDiffBaseline,
+					maxWidth:            tc.inMaxWidth,
+					shouldShowFull:      tc.inFull,
+					shouldRunDoctor:     tc.inDoctor,
+					shouldCountOnly:     tc.inCountOnly,
+					omitFields:          tc.inOmit,
+					tee:                 tc.inTee,
+					allowStackFallback:  tc.inAllowFallback,
+					isStrictJSON:        tc.inStrictJSON,
+					shouldWatch:         tc.inWatch,
+					shouldWatchDiff:     tc.inWatchDiff,
+					watchInterval:       tc.inWatchInterval,
+					shouldPrintSchema:   tc.inJSONSchema,
+				},
+				store:         mockStoreReader,
+				prompt:        mockPrompter,
+				fs:            fs,
+				isMaxWidthSet: tc.inIsMaxWidthSet,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEnvProfiles, opts.envProfiles)
+				require.Equal(t, tc.wantedAppName, opts.name)
+				require.Equal(t, tc.wantedNameMatches, opts.nameMatches)
+				if tc.inOutputs != nil {
+					require.Equal(t, tc.wantedOutputFormat, opts.outputFormat)
+					require.Equal(t, tc.wantedOutputTargets, opts.outputTargets)
+				}
+				require.Equal(t, tc.wantedBaseline, opts.baseline)
+				require.Equal(t, tc.wantedOmitFields, opts.omitFields)
+				if tc.inStrictJSON {
+					require.True(t, opts.shouldOutputJSON)
+				}
+			}
+			if tc.inIncludeTpls && tc.wantedError == nil {
+				files, err := afero.ReadDir(fs, tc.inTemplatesDir)
+				require.NoError(t, err)
+				require.Empty(t, files, "expected the write check to be cleaned up")
+			}
+		})
+	}
+}
+
+func TestStoreSession(t *testing.T) {
+	testError := errors.New("some error")
+	defaultSession := &session.Session{Config: &aws.Config{Region: aws.String("us-west-2")}}
+	testCases := map[string]struct {
+		inRegion   string
+		setupMocks func(m *mocks.MockregionalSessionProvider)
+
+		wantedRegion string
+		wantedError  error
+	}{
+		"uses the default session without a store region": {
+			setupMocks: func(m *mocks.MockregionalSessionProvider) {},
+
+			wantedRegion: "us-west-2",
+		},
+		"uses a session in the store region": {
+			inRegion: "us-east-1",
+			setupMocks: func(m *mocks.MockregionalSessionProvider) {
+				m.EXPECT().DefaultWithRegion("us-east-1").Return(&session.Session{
+					Config: &aws.Config{Region: aws.String("us-east-1")},
+				}, nil)
+			},
+
+			wantedRegion: "us-east-1",
+		},
+		"errors if fail to create a session in the store region": {
+			inRegion: "us-east-1",
+			setupMocks: func(m *mocks.MockregionalSessionProvider) {
+				m.EXPECT().DefaultWithRegion("us-east-1").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("create session in store region us-east-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessProvider := mocks.NewMockregionalSessionProvider(ctrl)
+			tc.setupMocks(mockSessProvider)
+
+			// WHEN
+			sess, err := storeSession(mockSessProvider, defaultSession, tc.inRegion)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRegion, aws.StringValue(sess.Config.Region))
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_envSession(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inEnvProfiles map[string]string
+		setupMocks    func(m *mocks.MocksessionProvider)
+
+		wantedRegion string
+		wantedError  error
+	}{
+		"uses the mapped profile in the environment's region": {
+			inEnvProfiles: map[string]string{
+				"test": "dev-account",
+			},
+			setupMocks: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().FromProfile("dev-account").Return(&session.Session{
+					Config: &aws.Config{Region: aws.String("us-east-1")},
+				}, nil)
+			},
+
+			wantedRegion: "us-west-2",
+		},
+		"falls back to the environment manager role if the environment is not mapped": {
+			inEnvProfiles: map[string]string{
+				"prod": "prod-account",
+			},
+			setupMocks: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().FromRole("arn:aws:iam::123456789012:role/test-manager", "us-west-2").Return(&session.Session{
+					Config: &aws.Config{Region: aws.String("us-west-2")},
+				}, nil)
+			},
+
+			wantedRegion: "us-west-2",
+		},
+		"errors if fail to create a session from the profile": {
+			inEnvProfiles: map[string]string{
+				"test": "dev-account",
+			},
+			setupMocks: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().FromProfile("dev-account").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("create session from profile dev-account for environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessProvider := mocks.NewMocksessionProvider(ctrl)
+			tc.setupMocks(mockSessProvider)
+
+			opts := &showAppOpts{
+				sessProvider: mockSessProvider,
+				envProfiles:  tc.inEnvProfiles,
+			}
+
+			// WHEN
+			sess, err := opts.envSession(&config.Environment{
+				Name:           "test",
+				Region:         "us-west-2",
+				ManagerRoleARN: "arn:aws:iam::123456789012:role/test-manager",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRegion, aws.StringValue(sess.Config.Region))
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_Ask(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inApp         string
+		inNameMatches []string
+		inOptions     []showAppOption
+		inFirst       bool
+		inJSONSchema  bool
+
+		setupMocks func(mocks showAppMocks)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"with all flags": {
+			inApp: "my-app",
+
+			setupMocks: func(m showAppMocks) {},
+
+			wantedApp:   "my-app",
+			wantedError: nil,
+		},
+		"prompt for all input": {
+			inApp: "",
+
+			setupMocks: func(m showAppMocks) {
+				m.sel.EXPECT().Application(appShowNamePrompt, appShowNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedApp:   "my-app",
+			wantedError: nil,
+		},
+		"prompt with custom text": {
+			inApp: "",
+			inOptions: []showAppOption{
+				withAppNamePrompt("Which product would you like to see?", "A product is a group of services."),
+			},
+
+			setupMocks: func(m showAppMocks) {
+				m.sel.EXPECT().Application("Which product would you like to see?", "A product is a group of services.").Return("my-app", nil)
+			},
+			wantedApp:   "my-app",
+			wantedError: nil,
+		},
+		"prompt among the applications matching a partial name": {
+			inNameMatches: []string{"payments", "payroll"},
+
+			setupMocks: func(m showAppMocks) {
+				m.sel.EXPECT().ApplicationFrom(appShowNamePrompt, appShowNameHelpPrompt, []string{"payments", "payroll"}).Return("payroll", nil)
+			},
+			wantedApp: "payroll",
+		},
+		"selects the only application with first": {
+			inFirst: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appChoices.EXPECT().ApplicationChoices().Return([]string{"my-app"}, nil)
+			},
+			wantedApp: "my-app",
+		},
+		"errors with first if there is no application": {
+			inFirst: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appChoices.EXPECT().ApplicationChoices().Return(nil, nil)
+			},
+			wantedError: errors.New("--first requires exactly one application to select, found none"),
+		},
+		"errors with first if there are several applications": {
+			inFirst: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appChoices.EXPECT().ApplicationChoices().Return([]string{"my-app", "other-app"}, nil)
+			},
+			wantedError: errors.New("--first requires exactly one application to select, found 2: my-app, other-app"),
+		},
+		"errors with first if several applications match a partial name": {
+			inNameMatches: []string{"payments", "payroll"},
+			inFirst:       true,
+
+			setupMocks:  func(m showAppMocks) {},
+			wantedError: errors.New("--first requires exactly one application to select, found 2: payments, payroll"),
+		},
+		"doesn't prompt for the application with json-schema": {
+			inJSONSchema: true,
+
+			setupMocks: func(m showAppMocks) {},
+		},
+		"returns error if failed to select application": {
+			inApp: "",
+
+			setupMocks: func(m showAppMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", testError)
+			},
+
+			wantedError: fmt.Errorf("select application: %w", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := showAppMocks{
+				sel:        mocks.NewMockappSelector(ctrl),
+				appChoices: mocks.NewMockappChoiceLister(ctrl),
+			}
+			tc.setupMocks(mocks)
+
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:              tc.inApp,
+					shouldSelectFirst: tc.inFirst,
+					shouldPrintSchema: tc.inJSONSchema,
+				},
+				sel:         mocks.sel,
+				appChoices:  mocks.appChoices,
+				nameMatches: tc.inNameMatches,
+
+				namePrompt:     appShowNamePrompt,
+				nameHelpPrompt: appShowNameHelpPrompt,
+			}
+			for _, option := range tc.inOptions {
+				option(opts)
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.name, "expected app names to match")
+
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_Execute(t *testing.T) {
+	testAppName := "my-app"
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputJSON      bool
+		shouldOutputResources bool
+		shouldShowSecrets     bool
+		shouldShowTags        bool
+		shouldShowDeployers   bool
+		shouldShowLogging     bool
+		shouldShowDashboard   bool
+		shouldRedact          bool
+		shouldValidateOnly    bool
+		outputTemplate        string
+		isStrict              bool
+		shouldExplain         bool
+		shouldCopy            bool
+		shouldPage            bool
+		isTerminal            bool
+		screenWidth           int
+		shouldShowFull        bool
+		noLegend              bool
+		outputFormat          string
+		failOn                string
+		shouldOnlyFailing     bool
+		compareEnvs           []string
+		inAddons              map[string]map[string]string
+		noPipelines           bool
+		pipelineSource        string
+		maxWidth              int
+		isMaxWidthSet         bool
+		shouldPrettyPrint     bool
+		omitFields            []string
+		shouldScoreHealth     bool
+
 		setupMocks func(mocks showAppMocks)
 
-		wantedError error
+		wantedContent string
+		wantedError   error
+	}{
+		"omits the fields of --omit from the json output": {
+			shouldOutputJSON: true,
+			omitFields:       []string{"environments", "services", "pipelines.stages", "pipelines.createdAt", "pipelines.updatedAt"},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline1"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: "{\"name\":\"my-app\",\"owner\":\"unowned\",\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\"}],\"pipelineStages\":[{\"pipeline\":\"pipeline1\",\"stages\":[]}],\"environmentStatuses\":{\"test\":\"unknown\"}}\n",
+		},
+		"correctly shows json output": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+						Prod:      false,
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-west-1",
+						Prod:      true,
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline1"},
+						{Name: "pipeline2"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil).Times(2)
+			},
+
+			wantedContent: "{\"name\":\"my-app\",\"uri\":\"example.com\",\"owner\":\"unowned\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}],\"pipelineStages\":[{\"pipeline\":\"pipeline1\",\"stages\":[]},{\"pipeline\":\"pipeline2\",\"stages\":[]}],\"environmentStatuses\":{\"prod\":\"unknown\",\"test\":\"unknown\"}}\n",
+		},
+		"correctly shows human output": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-west-1",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline1"},
+						{Name: "pipeline2"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil).Times(2)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  URI               example.com
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+  prod              123456789           us-west-1           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  my-svc            lb-web-svc
+
+Pipelines
+
+  Name
+  ----
+  pipeline1
+  pipeline2
+`,
+		},
+		"truncates the tables to the width of the terminal": {
+			screenWidth: 50,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc-with-a-name-longer-than-the-terminal",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline-my-app-with-a-very-long-repository-name-v2"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+
+Services
+
+  Name                          Type
+  ----                          ----
+  my-svc-with-a-name-longer...  Load Balanced W...
+
+Pipelines
+
+  Name
+  ----
+  pipeline-my-app-with-a-very-long-repository-n...
+`,
+		},
+		"clamps the tables to --max-width regardless of the width of the terminal": {
+			screenWidth:   200,
+			maxWidth:      50,
+			isMaxWidthSet: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc-with-a-name-longer-than-the-terminal",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline-my-app-with-a-very-long-repository-name-v2"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+
+Services
+
+  Name                          Type
+  ----                          ----
+  my-svc-with-a-name-longer...  Load Balanced W...
+
+Pipelines
+
+  Name
+  ----
+  pipeline-my-app-with-a-very-long-repository-n...
+`,
+		},
+		"indents the json with --pretty": {
+			shouldOutputJSON:  true,
+			shouldPrettyPrint: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+			},
+
+			wantedContent: `{
+  "name": "my-app",
+  "owner": "unowned",
+  "environments": null,
+  "services": null,
+  "pipelines": null
+}
+`,
+		},
+		"shows the full tables with --full": {
+			screenWidth:    50,
+			shouldShowFull: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc-with-a-name-longer-than-the-terminal",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return([]*codepipeline.Pipeline{
+						{Name: "pipeline-my-app-with-a-very-long-repository-name-v2"},
+					}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+
+Services
+
+  Name                                         Type
+  ----                                         ----
+  my-svc-with-a-name-longer-than-the-terminal  Load Balanced Web Service
+
+Pipelines
+
+  Name
+  ----
+  pipeline-my-app-with-a-very-long-repository-name-v2
+`,
+		},
+		"annotates the human output with the sources of the values": {
+			shouldExplain: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline1"},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app              (from SSM /copilot/applications/my-app)
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown             (from SSM /copilot/applications/my-app/environments/test)
+
+Services
+
+  Name              Type
+  ----              ----
+  my-svc            lb-web-svc          (from SSM /copilot/applications/my-app/components/my-svc)
+
+Pipelines
+
+  Name
+  ----
+  pipeline1         (from CodePipeline pipeline1)
+
+Legend
+
+  (from ...)        The AWS resource that the value is retrieved from.
+`,
+		},
+		"annotates the pipelines of github-actions with their workflows": {
+			shouldExplain:  true,
+			pipelineSource: "github-actions",
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "deploy"},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app              (from SSM /copilot/applications/my-app)
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+  deploy            (from GitHub Actions workflow deploy)
+
+Legend
+
+  (from ...)        The AWS resource that the value is retrieved from.
+`,
+		},
+		"copies the output to the clipboard": {
+			shouldOutputJSON: true,
+			shouldCopy:       true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+				m.clipboard.EXPECT().Copy(`{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"test":"unknown"}}` + "\n").Return(nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"test":"unknown"}}` + "\n",
+		},
+		"still renders the output if no clipboard is available": {
+			shouldOutputJSON: true,
+			shouldCopy:       true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+				m.clipboard.EXPECT().Copy(gomock.Any()).Return(clipboard.ErrUnavailable)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"test":"unknown"}}` + "\n",
+		},
+		"warns about the missing and malformed fields of a corrupted application record": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					AccountID: "1234",
+					Domain:    "localhost",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":null,"warnings":[{"severity":"warning","message":"application record my-app is missing the \"name\" field"},{"severity":"warning","message":"application record my-app has a malformed \"account\" field: 1234"},{"severity":"warning","message":"application record my-app is missing the \"version\" field"},{"severity":"warning","message":"application record my-app has a malformed \"domain\" field: localhost"}]}` + "\n",
+		},
+		"compares the services deployed in two environments": {
+			shouldOutputJSON: true,
+			compareEnvs:      []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+					{Name: "backend", Type: "Backend Service"},
+					{Name: "api", Type: "Request-Driven Web Service"},
+				}, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{Name: "prod"}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-frontend"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-backend"), StackStatus: aws.String("CREATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-api"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-frontend"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-backend"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-frontend").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("frontend"), Image: aws.String("frontend:v2")},
+					},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-backend").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("backend"), Image: aws.String("backend:v1")},
+					},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-frontend").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("frontend"), Image: aws.String("frontend:v1")},
+					},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-backend").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("backend"), Image: aws.String("backend:v1")},
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"app":"my-app","environments":["test","prod"],"onlyInFirst":[{"name":"api","type":"Request-Driven Web Service"}],"onlyInSecond":null,"differences":[{"service":"frontend","attribute":"image (frontend)","values":{"prod":"frontend:v1","test":"frontend:v2"}}],"matching":[{"name":"backend","type":"Backend Service","images":{"backend":"backend:v1"}}]}` + "\n",
+		},
+		"errors if a compared environment does not exist": {
+			compareEnvs: []string{"test", "prod"},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get environment test: %w", testError),
+		},
+		"warns about pending and unretrievable source connections of the pipelines": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-github", Connection: &codepipeline.SourceConnection{ARN: "arn:aws:codestar-connections:us-west-2:123456789012:connection/github"}},
+					{Name: "pipeline-bitbucket", Connection: &codepipeline.SourceConnection{ARN: "arn:aws:codestar-connections:us-west-2:123456789012:connection/bitbucket"}},
+					{Name: "pipeline-codecommit"},
+				}, nil)
+				m.connections.EXPECT().GetConnection("arn:aws:codestar-connections:us-west-2:123456789012:connection/github").Return(&awscodestar.Connection{
+					ARN:          "arn:aws:codestar-connections:us-west-2:123456789012:connection/github",
+					ProviderType: "GitHub",
+					Status:       "PENDING",
+				}, nil)
+				m.connections.EXPECT().GetConnection("arn:aws:codestar-connections:us-west-2:123456789012:connection/bitbucket").Return(nil, testError)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":[{"name":"pipeline-github","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","connection":{"arn":"arn:aws:codestar-connections:us-west-2:123456789012:connection/github","providerType":"GitHub","status":"PENDING"}},{"name":"pipeline-bitbucket","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","connection":{"arn":"arn:aws:codestar-connections:us-west-2:123456789012:connection/bitbucket"}},{"name":"pipeline-codecommit","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"}],"pipelineStages":[{"pipeline":"pipeline-github","stages":[]},{"pipeline":"pipeline-bitbucket","stages":[]},{"pipeline":"pipeline-codecommit","stages":[]}],"warnings":[{"severity":"warning","message":"The source connection arn:aws:codestar-connections:us-west-2:123456789012:connection/github of pipeline pipeline-github is PENDING: update it in the AWS console so that the pipeline can be triggered"},{"severity":"warning","message":"Couldn't retrieve the source connection of pipeline pipeline-bitbucket: some error"}]}` + "\n",
+		},
+		"scores the health of the application": {
+			shouldOutputJSON:  true,
+			shouldScoreHealth: true,
+			omitFields:        []string{"pipelines", "pipelineStages"},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-repo", Build: &codepipeline.PipelineBuild{Project: "pipeline-repo-BuildProject"}},
+					{Name: "pipeline-other", Build: &codepipeline.PipelineBuild{Project: "pipeline-other-BuildProject"}},
+				}, nil)
+				m.builds.EXPECT().LastBuild(gomock.Any()).Return(&codepipeline.BuildExecution{Status: "Failed"}, nil)
+				m.builds.EXPECT().LastBuild(gomock.Any()).Return(&codepipeline.BuildExecution{Status: "Succeeded"}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"healthScore":50}` + "\n",
+		},
+		"links to the last build of the pipelines": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline-repo", Build: &codepipeline.PipelineBuild{Project: "pipeline-repo-BuildProject"}},
+					{Name: "pipeline-other", Build: &codepipeline.PipelineBuild{Project: "pipeline-other-BuildProject"}},
+					{Name: "pipeline-github-actions"},
+				}, nil)
+				m.builds.EXPECT().LastBuild(&codepipeline.Pipeline{Name: "pipeline-repo", Build: &codepipeline.PipelineBuild{Project: "pipeline-repo-BuildProject"}}).Return(&codepipeline.BuildExecution{
+					ID:     "pipeline-repo-BuildProject:1f2e3d",
+					Status: "Failed",
+					URL:    "https://example.com/builds/1f2e3d",
+				}, nil)
+				m.builds.EXPECT().LastBuild(&codepipeline.Pipeline{Name: "pipeline-other", Build: &codepipeline.PipelineBuild{Project: "pipeline-other-BuildProject"}}).Return(nil, testError)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":[{"name":"pipeline-repo","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","build":{"project":"pipeline-repo-BuildProject","last":{"id":"pipeline-repo-BuildProject:1f2e3d","status":"Failed","url":"https://example.com/builds/1f2e3d"}}},{"name":"pipeline-other","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","build":{"project":"pipeline-other-BuildProject"}},{"name":"pipeline-github-actions","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"}],"pipelineStages":[{"pipeline":"pipeline-repo","stages":[]},{"pipeline":"pipeline-other","stages":[]},{"pipeline":"pipeline-github-actions","stages":[]}],"warnings":[{"severity":"warning","message":"Couldn't retrieve the last build of pipeline pipeline-other: some error"}]}` + "\n",
+		},
+		"pages the human output on a terminal": {
+			shouldPage: true,
+			isTerminal: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.pager.EXPECT().Page(`About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`).Return(nil)
+			},
+		},
+		"does not page if the output is not a terminal": {
+			shouldPage: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"does not page json output": {
+			shouldOutputJSON: true,
+			shouldPage:       true,
+			isTerminal:       true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":null}` + "\n",
+		},
+		"returns error if fail to page the output": {
+			shouldPage: true,
+			isTerminal: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.pager.EXPECT().Page(gomock.Any()).Return(testError)
+			},
+
+			wantedError: fmt.Errorf("page output: %w", testError),
+		},
+		"warns if the certificate of a custom domain expires within 30 days": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-front"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "front",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "back",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("HTTPSCert"),
+						PhysicalResourceId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/1234"),
+					},
+				}, nil)
+				m.certDescr.EXPECT().CertificateExpiry("arn:aws:acm:us-west-2:123456789012:certificate/1234").Return(time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC), nil)
+			},
+
+			wantedContent: `{"name":"my-app","uri":"example.com","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"front","type":"Load Balanced Web Service"},{"app":"","name":"back","type":"Backend Service"}],"pipelines":null,"environmentStatuses":{"test":"UPDATE_COMPLETE"},"deployments":[{"service":"front","environment":"test","stackStatus":"UPDATE_COMPLETE","certExpiry":"2021-06-15T00:00:00Z","taskDefinition":"my-app-test-front:1"},{"service":"back","environment":"test","stackStatus":"UPDATE_COMPLETE","taskDefinition":"my-app-test-back:1"}],"warnings":[{"severity":"warning","message":"The certificate for the custom domain in environment test expires on 2021-06-15"}]}` + "\n",
+		},
+		"reports an unknown certificate expiry if fail to resolve the certificate": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-front"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "front",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "back",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("HTTPSCert"),
+						PhysicalResourceId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/1234"),
+					},
+				}, nil)
+				m.certDescr.EXPECT().CertificateExpiry("arn:aws:acm:us-west-2:123456789012:certificate/1234").Return(time.Time{}, testError)
+			},
+
+			wantedContent: `{"name":"my-app","uri":"example.com","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"front","type":"Load Balanced Web Service"},{"app":"","name":"back","type":"Backend Service"}],"pipelines":null,"environmentStatuses":{"test":"UPDATE_COMPLETE"},"deployments":[{"service":"front","environment":"test","stackStatus":"UPDATE_COMPLETE","certExpiry":"unknown","taskDefinition":"my-app-test-front:1"},{"service":"back","environment":"test","stackStatus":"UPDATE_COMPLETE","taskDefinition":"my-app-test-back:1"}]}` + "\n",
+		},
+		"shows only the failing environments and services": {
+			shouldOnlyFailing: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-front"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-front"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "front",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "back",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						AccountID: "123456789",
+						Region:    "us-west-2",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-east-1",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline1", Stages: []*codepipeline.Stage{
+						{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-front", "my-app-test-back"}},
+					}},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-back"), StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  prod              123456789           us-east-1           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  back              Backend Service
+
+Pipelines
+
+  Name
+  ----
+
+Warnings
+
+  error             The last deployment of service back in environment prod was rolled back: stack my-app-prod-back is in UPDATE_ROLLBACK_COMPLETE
+
+Legend
+
+  error             A failure of the application's resources, like a rolled back deployment.
+`,
+		},
+		"shows only the failing deployments in json": {
+			shouldOutputJSON:  true,
+			shouldOnlyFailing: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-front"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-front"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "front",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "back",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						AccountID: "123456789",
+						Region:    "us-west-2",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-east-1",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline1", Stages: []*codepipeline.Stage{
+						{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-front", "my-app-test-back"}},
+					}},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-back"), StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"prod","region":"us-east-1","accountID":"123456789","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"back","type":"Backend Service"}],"pipelines":null,"environmentStatuses":{"prod":"unknown"},"deployments":[{"service":"back","environment":"prod","stackStatus":"UPDATE_ROLLBACK_COMPLETE","taskDefinition":"my-app-prod-back:1"}],"warnings":[{"severity":"error","message":"The last deployment of service back in environment prod was rolled back: stack my-app-prod-back is in UPDATE_ROLLBACK_COMPLETE"}]}` + "\n",
+		},
+		"prints a single line if nothing is failing": {
+			shouldOnlyFailing: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-back").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-back"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-front"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-front").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-front"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "front",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "back",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						AccountID: "123456789",
+						Region:    "us-west-2",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-east-1",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{Name: "pipeline1", Stages: []*codepipeline.Stage{
+						{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-front", "my-app-test-back"}},
+					}},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-front"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-back"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: "All the environments, services and pipelines of application my-app are healthy.\n",
+		},
+		"correctly shows secrets": {
+			shouldShowSecrets: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+					{
+						Name: "my-worker",
+						Type: "backend-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("CREATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{
+							Name: aws.String("my-svc"),
+							Secrets: []*ecs.Secret{
+								{
+									Name:      aws.String("GITHUB_TOKEN"),
+									ValueFrom: aws.String("GH_TOKEN_SSM"),
+								},
+								{
+									Name:      aws.String("DB_PASSWORD"),
+									ValueFrom: aws.String("arn:aws:secretsmanager:us-west-2:123456789:secret:db-password"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  URI               example.com
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2
+
+Services
+
+  Name              Type
+  ----              ----
+  my-svc            lb-web-svc
+  my-worker         backend-svc
+
+Pipelines
+
+  Name
+  ----
+
+Secrets
+
+  Service           Environment         Name                Source              Value From
+  -------           -----------         ----                ------              ----------
+  my-svc            test                DB_PASSWORD         Secrets Manager     arn:aws:secretsmanager:us-west-2:123456789:secret:db-password
+    "                 "                 GITHUB_TOKEN        SSM                 GH_TOKEN_SSM
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"returns error if fail to get task definition for secrets": {
+			shouldShowSecrets: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(nil, testError).Times(2)
+			},
+
+			wantedError: fmt.Errorf("get task definition of service my-svc in environment test: %w", testError),
+		},
+		"skips App Runner services when showing secrets": {
+			shouldOutputJSON:  true,
+			shouldShowSecrets: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-rdws","type":"Request-Driven Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"deployments":[{"service":"my-rdws","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"N/A"}]}` + "\n",
+		},
+		"skips the pipelines with no pipelines": {
+			shouldOutputJSON: true,
+			noPipelines:      true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "123456789012", Version: "v1.0.0"}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Times(0)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":null,"pipelinesSkipped":true}` + "\n",
+		},
+		"shows the dependencies of the services and warns about cycles": {
+			noLegend: true,
+			inAddons: map[string]map[string]string{
+				"api": {
+					"db-addon.yml": `Resources:
+  Table:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  UsersURL:
+    Value:
+      Fn::ImportValue: !Sub ${App}-${Env}-users-DiscoveryServiceARN`,
+					"README.md": "not an addon",
+				},
+				"users": {
+					"queue.yaml": `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      Tags:
+        - Key: api
+          Value: !ImportValue
+            Fn::Sub: ${App}-${Env}-api-DiscoveryServiceARN
+        - Key: vpc
+          Value:
+            Fn::ImportValue: !Sub ${App}-${Env}-VpcId`,
+				},
+			},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "123456789012", Version: "v1.0.0"}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{Name: "api", Type: "Backend Service"},
+					{Name: "users", Type: "Backend Service"},
+					{Name: "web", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+  api               Backend Service
+  users             Backend Service
+  web               Load Balanced Web Service
+
+Pipelines
+
+  Name
+  ----
+
+Dependencies
+
+  Service           Depends On
+  -------           ----------
+  api               db-addon, users
+  users             api, queue
+
+Warnings
+
+  warning           Services depend on each other in a cycle and can't be deployed one after the other: api -> users -> api
+`,
+		},
+		"shows the tags of the services that differ from the tags of the app": {
+			shouldShowTags: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-api").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-api"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-frontend").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-frontend"), Revision: aws.Int64(2)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Tags:      map[string]string{"team": "platform"},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{Name: "api", Type: "Backend Service"},
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "123456789"},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{
+						StackName:   aws.String("my-app-test-api"),
+						StackStatus: aws.String("CREATE_COMPLETE"),
+						Tags: []*sdkcloudformation.Tag{
+							{Key: aws.String("copilot-application"), Value: aws.String("my-app")},
+							{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							{Key: aws.String("copilot-service"), Value: aws.String("api")},
+							{Key: aws.String("team"), Value: aws.String("platform")},
+						},
+					},
+					{
+						StackName:   aws.String("my-app-test-frontend"),
+						StackStatus: aws.String("UPDATE_COMPLETE"),
+						Tags: []*sdkcloudformation.Tag{
+							{Key: aws.String("copilot-service"), Value: aws.String("frontend")},
+							{Key: aws.String("team"), Value: aws.String("web")},
+							{Key: aws.String("owner"), Value: aws.String("jane")},
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+  Tags              team=platform
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  api               Backend Service
+  frontend          Load Balanced Web Service
+
+Pipelines
+
+  Name
+  ----
+
+Service Tags
+
+  Service           Environment         Tags
+  -------           -----------         ----
+  frontend          test                owner=jane, team=web
+`,
+		},
+		"includes the tags of the environments that differ from the tags of the app": {
+			shouldOutputJSON: true,
+			shouldShowTags:   true,
+			noPipelines:      true,
+			omitFields:       []string{"environmentStatuses"},
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Tags:      map[string]string{"team": "platform"},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "123456789"},
+					{Name: "prod", Region: "us-west-2", AccountID: "123456789"},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{
+						StackName: aws.String("my-app-test"),
+						Tags: []*sdkcloudformation.Tag{
+							{Key: aws.String("copilot-application"), Value: aws.String("my-app")},
+							{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							{Key: aws.String("team"), Value: aws.String("platform")},
+						},
+					},
+					{
+						StackName: aws.String("my-app-prod"),
+						Tags: []*sdkcloudformation.Tag{
+							{Key: aws.String("copilot-environment"), Value: aws.String("prod")},
+							{Key: aws.String("team"), Value: aws.String("platform")},
+							{Key: aws.String("environment"), Value: aws.String("prod")},
+							{Key: aws.String("cost-center"), Value: aws.String("1234")},
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"us-west-2","accountID":"123456789","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"pipelinesSkipped":true,"tags":{"team":"platform"},"environmentTags":{"prod":{"cost-center":"1234","environment":"prod"}}}` + "\n",
+		},
+		"correctly shows App Runner specifics with resources": {
+			shouldOutputResources: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(&cloudformation.StackDescription{EnableTerminationProtection: aws.Bool(true)}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-svc").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::ECS::Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789:service/my-app-test-Cluster/my-app-test-my-svc")},
+				}, nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&awsecs.Service{}, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-my-svc").Return(serviceConnectTemplate, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789:loadbalancer/app/my-app-test/1234")},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource("arn:aws:elasticloadbalancing:us-west-2:123456789:loadbalancer/app/my-app-test/1234").Return("my-acl", nil)
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1), Cpu: aws.String("256"), Memory: aws.String("512")}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-prod-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("prod")}}},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+					{
+						ResourceType:       aws.String("AWS::AppRunner::Service"),
+						PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234"),
+					},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-prod-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::AppRunner::Service"),
+						PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678"),
+					},
+				}, nil)
+				m.appRunnerDescr.EXPECT().DescribeService("arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234").Return(&apprunner.Service{
+					ARN:    "arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234",
+					Status: "RUNNING",
+					URL:    "abc.us-west-2.awsapprunner.com",
+					CPU:    "1024",
+					Memory: "2048",
+				}, nil)
+				m.appRunnerDescr.EXPECT().DescribeService("arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678").Return(&apprunner.Service{
+					ARN:    "arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678",
+					Status: "OPERATION_IN_PROGRESS",
+					URL:    "def.us-west-2.awsapprunner.com",
+					CPU:    "1 vCPU",
+					Memory: "2 GB",
+					CustomDomains: []apprunner.CustomDomain{
+						{DomainName: "example.com", Status: "ACTIVE"},
+						{DomainName: "www.example.com", Status: "PENDING_CERTIFICATE_DNS_VALIDATION"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+  Allocation        2304/4608
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2           unknown
+  prod              123456789           us-west-2           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  my-svc            Load Balanced Web Service
+  my-rdws           Request-Driven Web Service
+
+Pipelines
+
+  Name
+  ----
+
+Endpoints
+
+  Service           Environment         Endpoints
+  -------           -----------         ---------
+  my-rdws           test                https://abc.us-west-2.awsapprunner.com
+    "               prod                https://def.us-west-2.awsapprunner.com, https://example.com, https://www.example.com
+
+Task Definitions
+
+  Service           Environment         Task Definition       CPU/Memory          Deployment Controller
+  -------           -----------         ---------------       ----------          ---------------------
+  my-rdws           test                N/A                   1024/2048           -
+    "               prod                N/A                   1024/2048           -
+  my-svc            test                my-app-test-my-svc:1  256/512             ECS
+
+Web ACLs
+
+  Service           Environment         Web ACL
+  -------           -----------         -------
+  my-svc            test                my-acl
+
+Service Connect
+
+  Environment       Namespace           Service             Endpoints                                     Reaches
+  -----------       ---------           -------             ---------                                     -------
+  test              test.my-app.local   my-svc              api-http:8080, grpc.internal:9090, grpc:9091  -
+
+Termination Protection
+
+  Stack                        Termination Protection
+  -----                        ----------------------
+  my-app-infrastructure-roles  enabled
+
+App Runner Services
+
+  Service           Environment         Status                 Custom Domains                                                              Service ARN
+  -------           -----------         ------                 --------------                                                              -----------
+  my-rdws           test                RUNNING                -                                                                           arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234
+    "               prod                OPERATION_IN_PROGRESS  example.com (ACTIVE), www.example.com (PENDING_CERTIFICATE_DNS_VALIDATION)  arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678
+
+Warnings
+
+  info              Public-facing service my-svc in environment test has no alarms
+  info              Public-facing service my-rdws in environment test has no alarms
+  info              Public-facing service my-rdws in environment prod has no alarms
+
+Legend
+
+  info              An expected transient state, like a service that is still being created.
+  "                 The same value as in the row above.
+`,
+		},
+		"shows a row for each deployment with csv": {
+			shouldOutputResources: true,
+			outputFormat:          "csv",
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.stackResources.EXPECT().StackResources("my-app-test-my-svc").Return([]*cloudformation.StackResource{
+					{ResourceType: aws.String("AWS::ECS::Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789:service/my-app-test-Cluster/my-app-test-my-svc")},
+				}, nil)
+				m.ecsServices.EXPECT().Service("my-app-test-Cluster", "my-app-test-my-svc").Return(&awsecs.Service{}, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-test-my-svc").Return(serviceConnectTemplate, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test").Return([]*cloudformation.StackResource{
+					{LogicalResourceId: aws.String("PublicLoadBalancer"), PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789:loadbalancer/app/my-app-test/1234")},
+				}, nil)
+				m.webACLs.EXPECT().WebACLForResource("arn:aws:elasticloadbalancing:us-west-2:123456789:loadbalancer/app/my-app-test/1234").Return("", nil)
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-prod-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("prod")}}},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+					{
+						ResourceType:       aws.String("AWS::AppRunner::Service"),
+						PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234"),
+					},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-prod-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::AppRunner::Service"),
+						PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678"),
+					},
+				}, nil)
+				m.appRunnerDescr.EXPECT().DescribeService("arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234").Return(&apprunner.Service{
+					ARN:    "arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234",
+					Status: "RUNNING",
+					URL:    "abc.us-west-2.awsapprunner.com",
+				}, nil)
+				m.appRunnerDescr.EXPECT().DescribeService("arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678").Return(&apprunner.Service{
+					ARN:    "arn:aws:apprunner:us-west-2:123456789:service/my-app-prod-my-rdws/5678",
+					Status: "OPERATION_IN_PROGRESS",
+					URL:    "def.us-west-2.awsapprunner.com",
+					CustomDomains: []apprunner.CustomDomain{
+						{DomainName: "example.com", Status: "ACTIVE"},
+						{DomainName: "www.example.com", Status: "PENDING_CERTIFICATE_DNS_VALIDATION"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `app,environment,service,type,endpoint,status
+my-app,test,my-svc,Load Balanced Web Service,,CREATE_COMPLETE
+my-app,test,my-rdws,Request-Driven Web Service,https://abc.us-west-2.awsapprunner.com,CREATE_COMPLETE
+my-app,prod,my-rdws,Request-Driven Web Service,https://def.us-west-2.awsapprunner.com https://example.com https://www.example.com,CREATE_COMPLETE
+`,
+		},
+		"shows who last deployed to each environment with show-deployers": {
+			shouldOutputJSON:    true,
+			shouldShowDeployers: true,
+			noPipelines:         true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789:stack/my-app-test/1"), StackStatus: aws.String("UPDATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-test-my-svc"), StackId: aws.String("arn:aws:cloudformation:us-west-2:123456789:stack/my-app-test-my-svc/2"), StackStatus: aws.String("UPDATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("prod")}}},
+				}, nil)
+				m.deployments.EXPECT().LastStackDeployment("arn:aws:cloudformation:us-west-2:123456789:stack/my-app-test/1").Return(&awscloudtrail.StackDeployment{
+					Initiator: "arn:aws:iam::123456789:user/alice",
+					Time:      time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.deployments.EXPECT().LastStackDeployment("arn:aws:cloudformation:us-west-2:123456789:stack/my-app-test-my-svc/2").Return(&awscloudtrail.StackDeployment{
+					Initiator: "arn:aws:sts::123456789:assumed-role/pipeline-role/1234 via codepipeline.amazonaws.com",
+					Time:      time.Date(2021, time.May, 2, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.deployments.EXPECT().LastStackDeployment("my-app-prod").Return(nil, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"us-west-2","accountID":"123456789","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-svc","type":"Load Balanced Web Service"}],"pipelines":null,"pipelinesSkipped":true,"environmentStatuses":{"prod":"CREATE_COMPLETE","test":"UPDATE_COMPLETE"},"lastDeployedBy":{"prod":"unknown","test":"arn:aws:sts::123456789:assumed-role/pipeline-role/1234 via codepipeline.amazonaws.com"},"deployments":[{"service":"my-svc","environment":"test","stackStatus":"UPDATE_COMPLETE","taskDefinition":"my-app-test-my-svc:1"}]}
+`,
+		},
+		"shows the logging configuration with show-logging": {
+			shouldOutputJSON:  true,
+			shouldShowLogging: true,
+			noPipelines:       true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{
+					Family:   aws.String("my-app-test-my-svc"),
+					Revision: aws.Int64(1),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{
+							Name: aws.String("my-svc"),
+							LogConfiguration: &ecs.LogConfiguration{
+								LogDriver: aws.String("awslogs"),
+								Options: map[string]*string{
+									"awslogs-group": aws.String("/copilot/my-app-test-my-svc"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-worker").Return(&awsecs.TaskDefinition{
+					Family:   aws.String("my-app-test-my-worker"),
+					Revision: aws.Int64(2),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{Name: aws.String("my-worker")},
+					},
+				}, nil)
+				m.logRetention.EXPECT().LogGroupRetention("/copilot/my-app-test-my-svc").Return(30, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+					{
+						Name: "my-worker",
+						Type: "Backend Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-my-worker"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-svc","type":"Load Balanced Web Service"},{"app":"","name":"my-worker","type":"Backend Service"}],"pipelines":null,"pipelinesSkipped":true,"environmentStatuses":{"test":"UPDATE_COMPLETE"},"deployments":[{"service":"my-svc","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"my-app-test-my-svc:1","logging":"enabled","logGroup":"/copilot/my-app-test-my-svc","logRetention":"30 days"},{"service":"my-worker","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"my-app-test-my-worker:2","logging":"disabled"}],"warnings":[{"severity":"warning","message":"Service my-worker in environment test doesn't ship its logs: its main container has no log configuration"}]}
+`,
+		},
+		"renders the deployments as a tree with dashboard": {
+			shouldShowDashboard: true,
+			noPipelines:         true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `my-app  1 healthy / 0 degraded / 0 failing
+
+my-app
+└── test  UPDATE_COMPLETE
+    └── my-svc  CREATE_COMPLETE
+`,
+		},
+		"only writes the problems and errors on error severity with validate-only": {
+			shouldValidateOnly: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return([]*codepipeline.Pipeline{
+					{
+						Name: "pipeline-my-app",
+						Stages: []*codepipeline.Stage{
+							{Name: "Source", Category: "Source"},
+							{Name: "DeployTo-test", Category: "Deploy", StackNames: []string{"my-app-test-my-svc"}},
+							{Name: "DeployTo-prod", Category: "Deploy"},
+						},
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_ROLLBACK_FAILED")},
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String("copilot-service"), Value: aws.String("my-svc")},
+					}},
+					{StackName: aws.String("my-app-test-old-svc"), StackStatus: aws.String("CREATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String("copilot-service"), Value: aws.String("old-svc")},
+					}},
+				}, nil)
+			},
+
+			wantedContent: `Found 3 problems in application my-app: error: 1, warning: 2, info: 0.
+
+  error             Environment test is in a failed state: stack my-app-test is in UPDATE_ROLLBACK_FAILED
+  warning           Pipeline pipeline-my-app deploys to environment prod, which isn't in the application
+  warning           Stack my-app-test-old-svc in environment test belongs to service old-svc, which isn't in the application
+`,
+			wantedError: errors.New("found 1 problem of severity error with --validate-only"),
+		},
+		"renders the description with the output template": {
+			outputTemplate: "{{.Name}}:{{range .Deployments}} {{.Service}}@{{.Environment}}={{.StackStatus}}{{end}}\n",
+			noPipelines:    true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: "my-app: my-svc@test=CREATE_COMPLETE\n",
+		},
+		"masks the account IDs with redact": {
+			shouldOutputJSON: true,
+			shouldRedact:     true,
+			noPipelines:      true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					{
+						Name:      "prod",
+						Region:    "us-west-2",
+						AccountID: "210987654321",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil).Times(2)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"us-west-2","accountID":"account-0001","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"us-west-2","accountID":"account-0002","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"pipelinesSkipped":true,"environmentStatuses":{"prod":"unknown","test":"unknown"}}
+`,
+		},
+		"writes the metrics with the same timestamp with openmetrics": {
+			outputFormat: "openmetrics",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `# TYPE copilot_app_environments gauge
+# HELP copilot_app_environments Number of environments of the application.
+copilot_app_environments{app="my-app"} 1 1622505600.000
+# TYPE copilot_app_services gauge
+# HELP copilot_app_services Number of services of the application.
+copilot_app_services{app="my-app"} 1 1622505600.000
+# TYPE copilot_app_jobs gauge
+# HELP copilot_app_jobs Number of jobs of the application.
+copilot_app_jobs{app="my-app"} 0 1622505600.000
+# TYPE copilot_app_deployment_status gauge
+# HELP copilot_app_deployment_status Deployment of a service in an environment, labeled with the status of its stack.
+copilot_app_deployment_status{app="my-app",environment="test",service="my-svc",type="Load Balanced Web Service",status="CREATE_COMPLETE"} 1 1622505600.000
+# TYPE copilot_app_warnings gauge
+# HELP copilot_app_warnings Number of warnings found while describing the application, by severity.
+copilot_app_warnings{app="my-app",severity="info"} 0 1622505600.000
+copilot_app_warnings{app="my-app",severity="warning"} 0 1622505600.000
+copilot_app_warnings{app="my-app",severity="error"} 0 1622505600.000
+# EOF
+`,
+		},
+		"writes the summary lines with lines": {
+			outputFormat: "lines",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `["App: my-app","Envs: test","Services: my-svc (LBWS)"]
+`,
+		},
+		"writes the markdown tables with markdown": {
+			outputFormat: "markdown",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `## my-app
+
+### Environments
+
+| Name | AccountID | Region | Status |
+| --- | --- | --- | --- |
+| test | 123456789 | us-west-2 | unknown |
+
+### Services
+
+| Name | Type |
+| --- | --- |
+| my-svc | Load Balanced Web Service |
+
+### Pipelines
+
+(skipped)
+`,
+		},
+		"writes the Mermaid flowchart with mermaid": {
+			outputFormat: "mermaid",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `graph TD
+  app["my-app"]
+  subgraph env0["test"]
+    env0_svc0["my-svc"]
+  end
+  app --> env0
+`,
+		},
+		"writes the env file with dotenv": {
+			outputFormat: "dotenv",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `APP_NAME=my-app
+APP_DOMAIN=
+APP_OWNER=unowned
+APP_ENV_COUNT=1
+APP_ENVS=test
+APP_SERVICE_COUNT=1
+APP_SERVICES=my-svc
+APP_WARNING_COUNT=0
+`,
+		},
+		"writes the Go literal with go": {
+			outputFormat: "go",
+			noPipelines:  true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `describe.App{
+	Name:  "my-app",
+	Owner: "unowned",
+	Envs: []*config.Environment{
+		{
+			Name:      "test",
+			Region:    "us-west-2",
+			AccountID: "123456789",
+		},
+	},
+	Services: []*config.Workload{
+		{
+			Name: "my-svc",
+			Type: "Load Balanced Web Service",
+		},
+	},
+	PipelinesSkipped: true,
+	EnvStatuses: map[string]string{
+		"test": "unknown",
+	},
+	Deployments: []*describe.AppDeployment{
+		{
+			Service:        "my-svc",
+			Environment:    "test",
+			StackStatus:    "CREATE_COMPLETE",
+			TaskDefinition: "my-app-test-my-svc:1",
+		},
+	},
+}
+`,
+		},
+		"includes warnings in json output": {
+			shouldOutputJSON:      true,
+			shouldOutputResources: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-rdws","type":"Request-Driven Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"deployments":[{"service":"my-rdws","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"N/A","cpu":"unknown","memory":"unknown"}],"allocation":{"cpu":0,"memory":0,"unknown":1},"warnings":[{"severity":"info","message":"App Runner service for my-rdws in environment test is not created yet"}]}` + "\n",
+		},
+		"highlights warnings in human output": {
+			shouldOutputResources: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+  Allocation        unknown
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test                                                      unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  my-rdws           Request-Driven Web Service
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition     CPU/Memory
+  -------           -----------         ---------------     ----------
+  my-rdws           test                N/A                 unknown
+
+Warnings
+
+  info              App Runner service for my-rdws in environment test is not created yet
+
+Legend
+
+  info              An expected transient state, like a service that is still being created.
+`,
+		},
+		"omits the legend with --no-legend": {
+			shouldOutputResources: true,
+			noLegend:              true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+  Allocation        unknown
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test                                                      unknown
+
+Services
+
+  Name              Type
+  ----              ----
+  my-rdws           Request-Driven Web Service
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition     CPU/Memory
+  -------           -----------         ---------------     ----------
+  my-rdws           test                N/A                 unknown
+
+Warnings
+
+  info              App Runner service for my-rdws in environment test is not created yet
+`,
+		},
+		"returns error after rendering if warnings are found with strict": {
+			shouldOutputJSON:      true,
+			shouldOutputResources: true,
+			isStrict:              true,
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Tags:      map[string]string{"owner": "platform-team"},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"platform-team","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-rdws","type":"Request-Driven Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"deployments":[{"service":"my-rdws","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"N/A","cpu":"unknown","memory":"unknown"}],"allocation":{"cpu":0,"memory":0,"unknown":1},"warnings":[{"severity":"info","message":"App Runner service for my-rdws in environment test is not created yet"}]}` + "\n",
+			wantedError:   errors.New("found 1 warning with --strict"),
+		},
+		"returns error after rendering if warnings reach the fail-on severity": {
+			shouldOutputJSON:      true,
+			shouldOutputResources: true,
+			failOn:                "info",
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-rdws","type":"Request-Driven Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"deployments":[{"service":"my-rdws","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"N/A","cpu":"unknown","memory":"unknown"}],"allocation":{"cpu":0,"memory":0,"unknown":1},"warnings":[{"severity":"info","message":"App Runner service for my-rdws in environment test is not created yet"}]}` + "\n",
+			wantedError:   errors.New("found 1 warning of severity info or higher with --fail-on"),
+		},
+		"does not fail on warnings below the fail-on severity": {
+			shouldOutputJSON:      true,
+			shouldOutputResources: true,
+			failOn:                "warning",
+
+			setupMocks: func(m showAppMocks) {
+				m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("my-app-test-my-rdws-InstanceRole"),
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-rdws","type":"Request-Driven Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"deployments":[{"service":"my-rdws","environment":"test","stackStatus":"CREATE_COMPLETE","taskDefinition":"N/A","cpu":"unknown","memory":"unknown"}],"allocation":{"cpu":0,"memory":0,"unknown":1},"warnings":[{"severity":"info","message":"App Runner service for my-rdws in environment test is not created yet"}]}` + "\n",
+		},
+		"returns error if fail to describe App Runner service": {
+			shouldOutputResources: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-rdws",
+						Type: "Request-Driven Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-rdws"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackResources.EXPECT().StackResources("my-app-test-my-rdws").Return([]*cloudformation.StackResource{
+					{
+						ResourceType:       aws.String("AWS::AppRunner::Service"),
+						PhysicalResourceId: aws.String("arn:aws:apprunner:us-west-2:123456789:service/my-app-test-my-rdws/1234"),
+					},
+				}, nil)
+				m.appRunnerDescr.EXPECT().DescribeService(gomock.Any()).Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("describe App Runner service my-rdws in environment test: %w", testError),
+		},
+		"warns about service stacks that were rolled back": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-prod-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-prod-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("my-app-test-my-svc").Return(&awsecs.TaskDefinition{Family: aws.String("my-app-test-my-svc"), Revision: aws.Int64(1)}, nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-my-svc"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-my-svc"), StackStatus: aws.String("UPDATE_ROLLBACK_FAILED")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-svc","type":"Load Balanced Web Service"}],"pipelines":null,"environmentStatuses":{"prod":"unknown","test":"unknown"},"deployments":[{"service":"my-svc","environment":"test","stackStatus":"UPDATE_COMPLETE","taskDefinition":"my-app-test-my-svc:1"},{"service":"my-svc","environment":"prod","stackStatus":"UPDATE_ROLLBACK_FAILED","taskDefinition":"my-app-prod-my-svc:1"}],"warnings":[{"severity":"error","message":"The last deployment of service my-svc in environment prod was rolled back: stack my-app-prod-my-svc is in UPDATE_ROLLBACK_FAILED"}]}` + "\n",
+		},
+		"highlights the environments that are not provisioned successfully": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "123456789"},
+					{Name: "staging", Region: "us-west-2", AccountID: "123456789"},
+					{Name: "prod", Region: "us-west-2", AccountID: "123456789"},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}}},
+					{StackName: aws.String("my-app-staging"), StackStatus: aws.String("CREATE_IN_PROGRESS"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("staging")}}},
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("ROLLBACK_COMPLETE"), Tags: []*sdkcloudformation.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("prod")}}},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Owner             unowned
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2
+  staging           123456789           us-west-2           CREATE_IN_PROGRESS
+  prod              123456789           us-west-2           ROLLBACK_COMPLETE
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Warnings
+
+  warning           Environment staging is still being provisioned: stack my-app-staging is in CREATE_IN_PROGRESS
+  error             Environment prod is in a failed state: stack my-app-prod is in ROLLBACK_COMPLETE
+
+Legend
+
+  error             A failure of the application's resources, like a rolled back deployment.
+  warning           An issue that needs attention but doesn't affect the running services yet.
+`,
+		},
+		"includes the raw status of the environments in json output": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"test":"UPDATE_ROLLBACK_COMPLETE"},"warnings":[{"severity":"error","message":"Environment test is in a failed state: stack my-app-test is in UPDATE_ROLLBACK_COMPLETE"}]}` + "\n",
+		},
+		"warns if fail to list the stacks in an environment": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "Load Balanced Web Service",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, testError)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":[{"app":"","name":"my-svc","type":"Load Balanced Web Service"}],"pipelines":null,"environmentStatuses":{"test":"unknown"},"warnings":[{"severity":"warning","message":"Couldn't retrieve the services deployed in environment test: list stacks in environment test: some error"}]}` + "\n",
+		},
+		"annotates the environments whose account is unreachable": {
+			shouldOutputJSON:  true,
+			shouldShowSecrets: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test", AccountID: "111111111111"},
+					{Name: "prod", AccountID: "222222222222"},
+				}, nil)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return(nil, awserr.New("AccessDenied", "User: arn:aws:iam::123456789012:user/alice is not authorized to perform: sts:AssumeRole", nil))
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+			},
+
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":[{"app":"","name":"test","region":"","accountID":"111111111111","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},{"app":"","name":"prod","region":"","accountID":"222222222222","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"environmentStatuses":{"prod":"CREATE_COMPLETE","test":"account unreachable"},"warnings":[{"severity":"warning","message":"Environment test is unreachable, its account 111111111111 may have been closed or suspended: list stacks in environment test: AccessDenied: User: arn:aws:iam::123456789012:user/alice is not authorized to perform: sts:AssumeRole. Check that the account is active and that the role of the environment can be assumed, or remove the environment from the application if its account was closed"}]}` + "\n",
+		},
+		"returns error if fail to get application": {
+			shouldOutputJSON: false,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
+		},
+		"returns error if fail to list environment": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list environments in application %s: %w", "my-app", testError),
+		},
+		"returns error if fail to list services": {
+			shouldOutputJSON: false,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-west-1",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("list services in application %s: %w", "my-app", testError),
+		},
+		"returns error if fail to list pipelines": {
+			shouldOutputJSON: false,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "v1.0.0",
+					Domain:    "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+					{
+						Name:      "prod",
+						AccountID: "123456789",
+						Region:    "us-west-1",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("list pipelines in application %s: %w", "my-app", testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStoreReader := mocks.NewMockstore(ctrl)
+			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
+			mockStackLister := mocks.NewMockstackLister(ctrl)
+			mockTaskDefGetter := mocks.NewMocktaskDefinitionGetter(ctrl)
+			mockStackResources := mocks.NewMockstackResourcesGetter(ctrl)
+			mockAppRunner := mocks.NewMockappRunnerServiceDescriber(ctrl)
+			mockClipboard := mocks.NewMockclipboardWriter(ctrl)
+			mockPager := mocks.NewMockoutputPager(ctrl)
+			mockCertDescr := mocks.NewMockcertificateDescriber(ctrl)
+			mockWebACLs := mocks.NewMockwebACLGetter(ctrl)
+			mockECSServices := mocks.NewMockecsServiceDescriber(ctrl)
+			mockTemplateGetter := mocks.NewMockstackTemplateGetter(ctrl)
+			mockConnections := mocks.NewMockconnectionGetter(ctrl)
+			mockBuilds := mocks.NewMockpipelineBuildGetter(ctrl)
+			mockDeployments := mocks.NewMockstackDeploymentGetter(ctrl)
+			mockLogRetention := mocks.NewMocklogGroupRetentionGetter(ctrl)
+			mockAppResources := mocks.NewMockappResourcesGetter(ctrl)
+			mockObjectCounter := mocks.NewMockbucketObjectCounter(ctrl)
+			mockAppStacks := mocks.NewMockstackDescriber(ctrl)
+
+			mocks := showAppMocks{
+				storeSvc:       mockStoreReader,
+				pipelineSvc:    mockPLSvc,
+				stackLister:    mockStackLister,
+				stackResources: mockStackResources,
+				taskDefGetter:  mockTaskDefGetter,
+				appRunnerDescr: mockAppRunner,
+				clipboard:      mockClipboard,
+				pager:          mockPager,
+				certDescr:      mockCertDescr,
+				webACLs:        mockWebACLs,
+				ecsServices:    mockECSServices,
+				templateGetter: mockTemplateGetter,
+				connections:    mockConnections,
+				builds:         mockBuilds,
+				deployments:    mockDeployments,
+				logRetention:   mockLogRetention,
+				appResources:   mockAppResources,
+				objectCounter:  mockObjectCounter,
+				appStacks:      mockAppStacks,
+			}
+			tc.setupMocks(mocks)
+
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					shouldOutputJSON:      tc.shouldOutputJSON,
+					shouldOutputResources: tc.shouldOutputResources,
+					shouldShowSecrets:     tc.shouldShowSecrets,
+					shouldShowTags:        tc.shouldShowTags,
+					shouldShowDeployers:   tc.shouldShowDeployers,
+					shouldShowLogging:     tc.shouldShowLogging,
+					shouldShowDashboard:   tc.shouldShowDashboard,
+					shouldRedact:          tc.shouldRedact,
+					shouldValidateOnly:    tc.shouldValidateOnly,
+					isStrict:              tc.isStrict,
+					shouldExplain:         tc.shouldExplain,
+					shouldCopy:            tc.shouldCopy,
+					shouldPage:            tc.shouldPage,
+					shouldOnlyFailing:     tc.shouldOnlyFailing,
+					shouldShowFull:        tc.shouldShowFull,
+					noLegend:              tc.noLegend,
+					noPipelines:           tc.noPipelines,
+					pipelineSource:        tc.pipelineSource,
+					maxWidth:              tc.maxWidth,
+					shouldPrettyPrint:     tc.shouldPrettyPrint,
+					outputFormat:          tc.outputFormat,
+					failOn:                tc.failOn,
+					compareEnvs:           tc.compareEnvs,
+					omitFields:            tc.omitFields,
+					shouldScoreHealth:     tc.shouldScoreHealth,
+					name:                  testAppName,
+				},
+				store:        mockStoreReader,
+				w:            b,
+				pipelineSvc:  mockPLSvc,
+				connections:  mockConnections,
+				builds:       mockBuilds,
+				appResources: mockAppResources,
+				appStacks:    mockAppStacks,
+				addons:       &fakeAddonsReader{addons: tc.inAddons},
+				clipboard:    mockClipboard,
+				pager:        mockPager,
+				isTerminal: func() bool {
+					return tc.isTerminal
+				},
+				screenWidth: func() int {
+					return tc.screenWidth
+				},
+				isMaxWidthSet: tc.isMaxWidthSet,
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return mockStackLister, nil
+				},
+				newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+					return mockStackResources, nil
+				},
+				newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+					return mockTaskDefGetter, nil
+				},
+				newAppRunnerDescriber: func(_ *config.Environment) (appRunnerServiceDescriber, error) {
+					return mockAppRunner, nil
+				},
+				newCertDescriber: func(_ *config.Environment) (certificateDescriber, error) {
+					return mockCertDescr, nil
+				},
+				newWebACLGetter: func(_ *config.Environment) (webACLGetter, error) {
+					return mockWebACLs, nil
+				},
+				newECSServiceDescriber: func(_ *config.Environment) (ecsServiceDescriber, error) {
+					return mockECSServices, nil
+				},
+				newTemplateGetter: func(_ *config.Environment) (stackTemplateGetter, error) {
+					return mockTemplateGetter, nil
+				},
+				newDeploymentGetter: func(_ *config.Environment) (stackDeploymentGetter, error) {
+					return mockDeployments, nil
+				},
+				newLogRetentionGetter: func(_ *config.Environment) (logGroupRetentionGetter, error) {
+					return mockLogRetention, nil
+				},
+				newObjectCounter: func(_ string) (bucketObjectCounter, error) {
+					return mockObjectCounter, nil
+				},
+				now: func() time.Time {
+					return time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+				},
+			}
+			if tc.outputTemplate != "" {
+				opts.outputTemplate = template.Must(template.New("report.tmpl").Parse(tc.outputTemplate))
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+		})
+	}
+}
+
+func TestShowAppOpts_DescribeWithSections(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test", Region: "us-west-2"}}
+	mockSvcs := []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}
+	mockPipelines := []*codepipeline.Pipeline{{Name: "pipeline-my-app"}}
+	testCases := map[string]struct {
+		noPipelines bool
+		setupMocks  func(m showAppMocks)
+
+		wantedSections map[describe.AppSection]*describe.App
+		wantedErr      error
+	}{
+		"delivers each section once": {
+			setupMocks: func(m showAppMocks) {
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(mockPipelines, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+			wantedSections: map[describe.AppSection]*describe.App{
+				describe.AppSectionEnvironments: {Name: "my-app", Envs: []*config.Environment{{Name: "test", Region: "us-west-2"}}},
+				describe.AppSectionServices:     {Name: "my-app", Services: []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}},
+				describe.AppSectionPipelines:    {Name: "my-app", Pipelines: mockPipelines},
+			},
+		},
+		"delivers the skipped pipelines": {
+			noPipelines: true,
+			setupMocks: func(m showAppMocks) {
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+			wantedSections: map[describe.AppSection]*describe.App{
+				describe.AppSectionEnvironments: {Name: "my-app", Envs: []*config.Environment{{Name: "test", Region: "us-west-2"}}},
+				describe.AppSectionServices:     {Name: "my-app", Services: []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}},
+				describe.AppSectionPipelines:    {Name: "my-app", PipelinesSkipped: true},
+			},
+		},
+		"only delivers the sections resolved before a failure": {
+			setupMocks: func(m showAppMocks) {
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedSections: map[describe.AppSection]*describe.App{
+				describe.AppSectionEnvironments: {Name: "my-app", Envs: []*config.Environment{{Name: "test", Region: "us-west-2"}}},
+				describe.AppSectionServices:     {Name: "my-app", Services: []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}},
+			},
+			wantedErr: errors.New("list pipelines in application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				storeSvc:    mocks.NewMockstore(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				stackLister: mocks.NewMockstackLister(ctrl),
+			}
+			m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+				Name:      "my-app",
+				AccountID: "123456789012",
+				Version:   "v1.0.0",
+			}, nil)
+			m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+			m.storeSvc.EXPECT().ListServices("my-app").Return(mockSvcs, nil)
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					shouldOutputJSON: true,
+					noPipelines:      tc.noPipelines,
+				},
+				store:       m.storeSvc,
+				pipelineSvc: m.pipelineSvc,
+				addons:      &fakeAddonsReader{},
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m.stackLister, nil
+				},
+			}
+			sections := make(map[describe.AppSection]*describe.App)
+
+			// WHEN
+			app, err := opts.DescribeWithSections(func(section describe.AppSection, partial *describe.App) {
+				_, delivered := sections[section]
+				require.False(t, delivered, "section %s was delivered twice", section)
+				sections[section] = partial
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				require.Equal(t, tc.wantedSections, sections)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSections, sections)
+			require.Len(t, sections, len(describe.AppSections))
+			require.Equal(t, app.Envs, sections[describe.AppSectionEnvironments].Envs)
+			require.Equal(t, app.Services, sections[describe.AppSectionServices].Services)
+			require.Equal(t, app.Pipelines, sections[describe.AppSectionPipelines].Pipelines)
+		})
+	}
+}
+
+func TestShowAppOpts_DescribeWithSections_SlowSection(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMockstore(ctrl)
+	pipelineSvc := mocks.NewMockpipelineGetter(ctrl)
+	lister := mocks.NewMockstackLister(ctrl)
+	store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+	store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test", Region: "us-west-2"}}, nil)
+	store.EXPECT().ListServices("my-app").Return([]*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}}, nil)
+	lister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+	envsDelivered := make(chan struct{})
+	// The pipelines are only listed once the environments are delivered, which never happens if they wait for them.
+	pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).DoAndReturn(func(_ map[string]string) ([]*codepipeline.Pipeline, error) {
+		select {
+		case <-envsDelivered:
+		case <-time.After(5 * time.Second):
+			t.Error("expected the environments to be delivered while the pipelines are listed")
+		}
+		return []*codepipeline.Pipeline{{Name: "pipeline-my-app"}}, nil
+	})
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:             "my-app",
+			shouldOutputJSON: true,
+		},
+		store:       store,
+		pipelineSvc: pipelineSvc,
+		addons:      &fakeAddonsReader{},
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return lister, nil
+		},
+	}
+	var order []describe.AppSection
+
+	// WHEN
+	_, err := opts.DescribeWithSections(func(section describe.AppSection, _ *describe.App) {
+		order = append(order, section)
+		if section == describe.AppSectionEnvironments {
+			close(envsDelivered)
+		}
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Len(t, order, len(describe.AppSections))
+	require.Equal(t, describe.AppSectionPipelines, order[len(order)-1])
+}
+
+// TestShowAppOpts_ExecuteConcurrently describes many services in many environments with the concurrent lookups,
+// for the race detector of the unit tests to catch the unsynchronized accesses of the parallel path.
+func TestShowAppOpts_Tee(t *testing.T) {
+	const out = "About\n\n  Name              my-app\n"
+	testCases := map[string]struct {
+		inPage     bool
+		setupMocks func(m showAppMocks)
+
+		wantedStdout string
+	}{
+		"appends the output written to stdout to the file": {
+			setupMocks:   func(m showAppMocks) {},
+			wantedStdout: out,
+		},
+		"appends the paged output to the file": {
+			inPage: true,
+			setupMocks: func(m showAppMocks) {
+				m.pager.EXPECT().Page(out).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				storeSvc: mocks.NewMockstore(ctrl),
+				pager:    mocks.NewMockoutputPager(ctrl),
+			}
+			m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "app-show.log", []byte("previous output\n"), 0644))
+			b := &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", tee: "app-show.log", shouldPage: tc.inPage},
+				store:       m.storeSvc,
+				w:           b,
+				fs:          fs,
+				pager:       m.pager,
+				isTerminal: func() bool {
+					return true
+				},
+			}
+			require.NoError(t, opts.Validate())
+
+			// WHEN
+			err := opts.render(out)
+			require.NoError(t, opts.teeW.Close())
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStdout, b.String())
+			content, err := afero.ReadFile(fs, "app-show.log")
+			require.NoError(t, err)
+			require.Equal(t, "previous output\n"+out, string(content))
+		})
+	}
+}
+
+func TestShowAppOpts_ErrorsTo(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedErr     error
+		wantedContent string
+	}{
+		"writes the error to the file and exits silently": {
+			inErr: errors.New("list environments in application my-app: some error"),
+
+			wantedErr:     &ErrSilentExit{Code: 1},
+			wantedContent: "list environments in application my-app: some error\n",
+		},
+		"keeps the exit code of a silent error": {
+			inErr: &ErrSilentExit{Code: exitCodeInterrupted},
+
+			wantedErr: &ErrSilentExit{Code: exitCodeInterrupted},
+		},
+		"writes nothing without an error": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			defer func(w io.Writer) { log.DiagnosticWriter = w }(log.DiagnosticWriter)
+			stderr := &bytes.Buffer{}
+			log.DiagnosticWriter = stderr
+			fs := afero.NewMemMapFs()
+			opts := &showAppOpts{
+				showAppVars: showAppVars{errorsTo: "errors.log"},
+				fs:          fs,
+			}
+			require.NoError(t, opts.Validate())
+			log.Warningln("some warning")
+
+			// WHEN
+			err := opts.routeError(tc.inErr)
+			opts.closeErrorsTo()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+			content, readErr := afero.ReadFile(fs, "errors.log")
+			require.NoError(t, readErr)
+			require.Contains(t, string(content), "some warning\n")
+			require.True(t, strings.HasSuffix(string(content), tc.wantedContent), "the error is written after the warning")
+			require.Empty(t, stderr.String())
+			require.Equal(t, stderr, log.DiagnosticWriter, "the diagnostics are written to stderr again once the file is closed")
+		})
+	}
+}
+
+func TestShowAppOpts_ErrorsToNotWritable(t *testing.T) {
+	opts := &showAppOpts{
+		showAppVars: showAppVars{errorsTo: "errors.log"},
+		fs:          afero.NewReadOnlyFs(afero.NewMemMapFs()),
+	}
+
+	err := opts.Validate()
+
+	require.EqualError(t, err, "open --errors-to file errors.log: operation not permitted")
+}
+
+func TestNewShowAppOpts_WithSession(t *testing.T) {
+	// GIVEN
+	sess, err := session.NewSession(aws.NewConfig().
+		WithRegion("eu-west-1").
+		WithCredentials(credentials.NewStaticCredentials("AKIAEMBEDDER", "secret", "")))
+	require.NoError(t, err)
+
+	// WHEN
+	opts, err := newShowAppOpts(showAppVars{storeRegion: "us-east-1"}, withSession(sess))
+
+	// THEN
+	require.NoError(t, err)
+	defaultSess, err := opts.sessProvider.Default()
+	require.NoError(t, err)
+	require.Equal(t, "eu-west-1", aws.StringValue(defaultSess.Config.Region))
+	storeSess, err := opts.sessProvider.DefaultWithRegion("us-east-1")
+	require.NoError(t, err)
+	creds, err := storeSess.Config.Credentials.Get()
+	require.NoError(t, err)
+	require.Equal(t, "AKIAEMBEDDER", creds.AccessKeyID, "expected the store session to use the credentials of the embedder")
+}
+
+func TestNewShowAppOpts_CABundle(t *testing.T) {
+	t.Run("errors if the CA bundle doesn't exist", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "ca")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "ca.pem")
+
+		_, err = newShowAppOpts(showAppVars{caBundle: path})
+
+		require.EqualError(t, err, fmt.Sprintf("load CA bundle: read CA bundle %s: open %s: no such file or directory", path, path))
+	})
+	t.Run("errors if the CA bundle has no certificate", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "ca")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "ca.pem")
+		require.NoError(t, ioutil.WriteFile(path, []byte("-----BEGIN CERTIFICATE-----\nnot base64\n-----END CERTIFICATE-----\n"), 0644))
+
+		_, err = newShowAppOpts(showAppVars{caBundle: path})
+
+		require.EqualError(t, err, fmt.Sprintf("load CA bundle: CA bundle %s has no PEM certificate", path))
+	})
+}
+
+func TestShowAppOpts_EnvsAndServices(t *testing.T) {
+	testError := errors.New("some error")
+	mockEnvs := []*config.Environment{{Name: "test"}}
+	mockSvcs := []*config.Workload{{Name: "api"}}
+	testCases := map[string]struct {
+		inRetryOnEmpty int
+		inInterrupted  bool
+		setupMocks     func(m showAppMocks)
+
+		wantedEnvs  []*config.Environment
+		wantedSvcs  []*config.Workload
+		wantedWaits int
+		wantedError error
+	}{
+		"lists the environments and services once without --retry-on-empty": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+			},
+		},
+		"lists them again until neither list is empty": {
+			inRetryOnEmpty: 3,
+			setupMocks: func(m showAppMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil),
+					m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil),
+				)
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil),
+					m.storeSvc.EXPECT().ListServices("my-app").Return(mockSvcs, nil),
+				)
+			},
+			wantedEnvs:  mockEnvs,
+			wantedSvcs:  mockSvcs,
+			wantedWaits: 1,
+		},
+		"returns the last lists once the retries are exhausted": {
+			inRetryOnEmpty: 2,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil).Times(3)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil).Times(3)
+			},
+			wantedEnvs:  mockEnvs,
+			wantedWaits: 2,
+		},
+		"stops waiting once interrupted": {
+			inRetryOnEmpty: 2,
+			inInterrupted:  true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+			},
+		},
+		"returns the error of listing the services": {
+			inRetryOnEmpty: 2,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("list services in application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := showAppMocks{
+				storeSvc: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.inInterrupted {
+				cancel()
+			}
+			var waits int
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", retryOnEmpty: tc.inRetryOnEmpty},
+				store:       m.storeSvc,
+				ctx:         ctx,
+				after: func(d time.Duration) <-chan time.Time {
+					require.Equal(t, retryOnEmptyDelay, d)
+					if tc.inInterrupted {
+						return nil
+					}
+					waits++
+					elapsed := make(chan time.Time, 1)
+					elapsed <- time.Time{}
+					return elapsed
+				},
+			}
+
+			// WHEN
+			envs, svcs, err := opts.envsAndServices()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvs, envs)
+			require.Equal(t, tc.wantedSvcs, svcs)
+			require.Equal(t, tc.wantedWaits, waits)
+		})
+	}
+}
+
+func TestShowAppOpts_ExecuteConcurrently(t *testing.T) {
+	// GIVEN
+	const numEnvs, numSvcs = 8, 12
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := showAppMocks{
+		storeSvc:       mocks.NewMockstore(ctrl),
+		pipelineSvc:    mocks.NewMockpipelineGetter(ctrl),
+		stackLister:    mocks.NewMockstackLister(ctrl),
+		stackResources: mocks.NewMockstackResourcesGetter(ctrl),
+		taskDefGetter:  mocks.NewMocktaskDefinitionGetter(ctrl),
+		ecsServices:    mocks.NewMockecsServiceDescriber(ctrl),
+		templateGetter: mocks.NewMockstackTemplateGetter(ctrl),
+		appResources:   mocks.NewMockappResourcesGetter(ctrl),
+		appStacks:      mocks.NewMockstackDescriber(ctrl),
+	}
+	var envs []*config.Environment
+	for i := 0; i < numEnvs; i++ {
+		// The environments are in different accounts so that their stacks are listed concurrently rather than batched.
+		envs = append(envs, &config.Environment{Name: fmt.Sprintf("env%d", i), Region: "us-west-2", AccountID: fmt.Sprintf("12345678901%d", i)})
+	}
+	var svcs []*config.Workload
+	for i := 0; i < numSvcs; i++ {
+		svcs = append(svcs, &config.Workload{Name: fmt.Sprintf("svc%d", i), Type: "Backend Service"})
+	}
+	m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "123456789012", Version: "v1.0.0"}, nil)
+	m.storeSvc.EXPECT().ListEnvironments("my-app").Return(envs, nil)
+	m.storeSvc.EXPECT().ListServices("my-app").Return(svcs, nil)
+	m.pipelineSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+	m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).DoAndReturn(func(tags map[string]string) ([]cloudformation.StackDescription, error) {
+		env := tags["copilot-environment"]
+		stacks := []cloudformation.StackDescription{{StackName: aws.String("my-app-" + env), StackStatus: aws.String("UPDATE_COMPLETE")}}
+		for _, svc := range svcs {
+			stacks = append(stacks, cloudformation.StackDescription{StackName: aws.String(fmt.Sprintf("my-app-%s-%s", env, svc.Name)), StackStatus: aws.String("CREATE_COMPLETE")})
+		}
+		return stacks, nil
+	}).Times(numEnvs)
+	m.taskDefGetter.EXPECT().TaskDefinition(gomock.Any()).DoAndReturn(func(name string) (*awsecs.TaskDefinition, error) {
+		return &awsecs.TaskDefinition{Family: aws.String(name), Revision: aws.Int64(1)}, nil
+	}).Times(numEnvs * numSvcs)
+	m.stackResources.EXPECT().StackResources(gomock.Any()).DoAndReturn(func(name string) ([]*cloudformation.StackResource, error) {
+		return []*cloudformation.StackResource{
+			{ResourceType: aws.String("AWS::ECS::Service"), PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789012:service/my-app-Cluster/" + name)},
+		}, nil
+	}).AnyTimes()
+	m.ecsServices.EXPECT().Service(gomock.Any(), gomock.Any()).Return(&awsecs.Service{}, nil).Times(numEnvs * numSvcs)
+	m.templateGetter.EXPECT().TemplateBody(gomock.Any()).Return(serviceConnectTemplate, nil).Times(numEnvs * numSvcs)
+	m.appResources.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil).AnyTimes()
+	m.appStacks.EXPECT().Describe("my-app-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil)
+	var outputMu sync.Mutex
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	var sections []describe.AppSection
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:                  "my-app",
+			shouldOutputJSON:      true,
+			shouldOutputResources: true,
+			shouldBenchmark:       true,
+		},
+		store:        m.storeSvc,
+		w:            newSyncWriter(stdout, &outputMu),
+		diagW:        newSyncWriter(stderr, &outputMu),
+		pipelineSvc:  m.pipelineSvc,
+		appResources: m.appResources,
+		appStacks:    m.appStacks,
+		addons:       &fakeAddonsReader{},
+		limiter:      newAdaptiveLimiter(defaultMaxConcurrency, func() int { return 0 }),
+		onSection: func(section describe.AppSection, _ *describe.App) {
+			sections = append(sections, section)
+		},
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return m.stackLister, nil
+		},
+		newStackResourcesGetter: func(_ *config.Environment) (stackResourcesGetter, error) {
+			return m.stackResources, nil
+		},
+		newTaskDefGetter: func(_ *config.Environment) (taskDefinitionGetter, error) {
+			return m.taskDefGetter, nil
+		},
+		newECSServiceDescriber: func(_ *config.Environment) (ecsServiceDescriber, error) {
+			return m.ecsServices, nil
+		},
+		newTemplateGetter: func(_ *config.Environment) (stackTemplateGetter, error) {
+			return m.templateGetter, nil
+		},
+		now: time.Now,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	var app describe.App
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &app), "expected a single json document on stdout")
+	require.Len(t, app.Deployments, numEnvs*numSvcs)
+	for _, deployment := range app.Deployments {
+		require.Equal(t, awsecs.DeploymentControllerECS, deployment.DeploymentController)
+	}
+	require.Len(t, app.ServiceConnect, numEnvs*numSvcs)
+	require.ElementsMatch(t, describe.AppSections, sections)
+	require.Contains(t, stderr.String(), "look up deployment controllers")
+}
+
+func TestShowAppOpts_WriteAuditEvent(t *testing.T) {
+	mockNow := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inAuditLog   string
+		inVars       showAppVars
+		inReadOnlyFS bool
+		setupMocks   func(m *mocks.MockidentityService)
+
+		wantedFileContent   string
+		wantedStderrContent string
+	}{
+		"appends the event to the file": {
+			inAuditLog: "audit.log",
+			inVars:     showAppVars{name: "my-app", shouldOutputJSON: true},
+			setupMocks: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:sts::123456789012:assumed-role/auditor/jane"}, nil)
+			},
+			wantedFileContent: `{"timestamp":"2021-06-01T12:00:00Z","principal":"arn:aws:sts::123456789012:assumed-role/auditor/jane","app":"my-app","outputFormat":"json"}` + "\n",
+		},
+		"writes the event to stderr": {
+			inAuditLog: "-",
+			inVars:     showAppVars{name: "my-app", outputFormat: "csv"},
+			setupMocks: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/jane"}, nil)
+			},
+			wantedStderrContent: `{"timestamp":"2021-06-01T12:00:00Z","principal":"arn:aws:iam::123456789012:user/jane","app":"my-app","outputFormat":"csv"}` + "\n",
+		},
+		"records an unknown principal if the caller identity can't be retrieved": {
+			inAuditLog: "audit.log",
+			inVars:     showAppVars{name: "my-app"},
+			setupMocks: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
+			},
+			wantedFileContent: `{"timestamp":"2021-06-01T12:00:00Z","principal":"unknown","app":"my-app","outputFormat":"human"}` + "\n",
+		},
+		"does not fail if the file can't be written": {
+			inAuditLog:   "audit.log",
+			inVars:       showAppVars{name: "my-app"},
+			inReadOnlyFS: true,
+			setupMocks: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{ARN: "arn:aws:iam::123456789012:user/jane"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockidentityService(ctrl)
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			if tc.inReadOnlyFS {
+				fs = afero.NewReadOnlyFs(fs)
+			}
+			stderr := &bytes.Buffer{}
+			tc.inVars.auditLog = tc.inAuditLog
+			opts := &showAppOpts{
+				showAppVars: tc.inVars,
+				identity:    m,
+				fs:          fs,
+				diagW:       stderr,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			opts.writeAuditEvent()
+
+			// THEN
+			require.Equal(t, tc.wantedStderrContent, stderr.String())
+			if tc.wantedFileContent != "" {
+				content, err := afero.ReadFile(fs, "audit.log")
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFileContent, string(content))
+			}
+		})
+	}
+}
+
+func TestShowAppOpts_CheckComplete(t *testing.T) {
+	testCases := map[string]struct {
+		inApp     *describe.App
+		inPartial []string
+
+		wantedDiagnostics string
+		wantedError       error
+	}{
+		"nothing is written for a complete description": {
+			inApp: &describe.App{
+				Name:        "my-app",
+				EnvStatuses: map[string]string{"test": "CREATE_COMPLETE"},
+			},
+		},
+		"writes what is incomplete as a json error": {
+			inApp: &describe.App{
+				Name:        "my-app",
+				EnvStatuses: map[string]string{"test": "CREATE_COMPLETE", "prod": describe.EnvStatusUnknown},
+			},
+			inPartial: []string{"Couldn't describe the stack of environment prod: access denied"},
+
+			wantedDiagnostics: `{"error":"found 1 incomplete field and 1 partial error with --strict-json","app":"my-app","incompleteFields":["environmentStatuses.prod"],"partialErrors":["Couldn't describe the stack of environment prod: access denied"]}` + "\n",
+			wantedError:       errors.New("found 1 incomplete field and 1 partial error with --strict-json"),
+		},
+		"partial errors alone are incomplete": {
+			inApp:     &describe.App{Name: "my-app"},
+			inPartial: []string{"Couldn't list the budgets of application my-app: access denied", "Couldn't get the pipelines: throttled"},
+
+			wantedDiagnostics: `{"error":"found 0 incomplete fields and 2 partial errors with --strict-json","app":"my-app","partialErrors":["Couldn't list the budgets of application my-app: access denied","Couldn't get the pipelines: throttled"]}` + "\n",
+			wantedError:       errors.New("found 0 incomplete fields and 2 partial errors with --strict-json"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var diag bytes.Buffer
+			opts := &showAppOpts{
+				showAppVars: showAppVars{name: "my-app", isStrictJSON: true},
+				diagW:       &diag,
+				partial:     tc.inPartial,
+			}
+
+			// WHEN
+			err := opts.checkComplete(tc.inApp)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDiagnostics, diag.String())
+		})
+	}
+}
+
+func TestShowAppOpts_CheckPromotion(t *testing.T) {
+	const (
+		stagingTemplate = "Metadata:\n  Version: v1.1.0\nResources: {}\n"
+		prodTemplate    = "Metadata:\n  Version: v1.0.0\nResources: {}\n"
+	)
+	testError := errors.New("some error")
+	staging := &config.Environment{App: "my-app", Name: "staging"}
+	prod := &config.Environment{App: "my-app", Name: "prod"}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m showAppMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the source environment can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get environment staging: some error"),
+		},
+		"fails the check without calling AWS if the target environment doesn't exist": {
+			inJSON: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(staging, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "my-app", EnvironmentName: "prod"})
+			},
+			wantedContent: `{"app":"my-app","from":"staging","to":"prod","ready":false,"checks":[{"name":"exists","passed":false,"reason":"Environment prod is not in application my-app."}]}` + "\n",
+			wantedError:   fmt.Errorf("environment prod failed 1 of 1 checks with --promotion-check"),
+		},
+		"passes all the checks of a healthy environment on the same version": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(staging, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(prod, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-api"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-staging").Return(stagingTemplate, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-prod").Return(stagingTemplate, nil)
+			},
+			wantedContent: `Environment prod is ready for the promotion of application my-app from staging.
+
+  PASS              exists              Environment prod is in application my-app.
+  PASS              healthy             No stack of environment prod is in a failed state.
+  PASS              template version    Environment prod is on version v1.1.0, and staging on version v1.1.0.
+  PASS              not deploying       No stack of environment prod is being deployed.
+`,
+		},
+		"fails the checks of an older environment with failed and in progress stacks": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(staging, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(prod, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-prod-api"), StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")},
+					{StackName: aws.String("my-app-prod-web"), StackStatus: aws.String("UPDATE_IN_PROGRESS")},
+				}, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-staging").Return(stagingTemplate, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-prod").Return(prodTemplate, nil)
+			},
+			wantedContent: `Environment prod is not ready for the promotion of application my-app from staging: 3 of 4 checks failed.
+
+  PASS              exists              Environment prod is in application my-app.
+  FAIL              healthy             Stack my-app-prod-api is in UPDATE_ROLLBACK_COMPLETE.
+  FAIL              template version    Environment prod is on version v1.0.0, older than version v1.1.0 of staging: run "copilot env upgrade -n prod".
+  FAIL              not deploying       Stack my-app-prod-web is in UPDATE_IN_PROGRESS.
+`,
+			wantedError: fmt.Errorf("environment prod failed 3 of 4 checks with --promotion-check"),
+		},
+		"fails the checks of an environment without its stack": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(staging, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(prod, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, nil)
+			},
+			wantedContent: `Environment prod is not ready for the promotion of application my-app from staging: 2 of 4 checks failed.
+
+  PASS              exists              Environment prod is in application my-app.
+  FAIL              healthy             Stack my-app-prod of environment prod was not found.
+  FAIL              template version    Stack my-app-prod of environment prod was not found.
+  PASS              not deploying       No stack of environment prod is being deployed.
+`,
+			wantedError: fmt.Errorf("environment prod failed 2 of 4 checks with --promotion-check"),
+		},
+		"errors if the template of an environment can't be retrieved": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "staging").Return(staging, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "prod").Return(prod, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.templateGetter.EXPECT().TemplateBody("my-app-staging").Return("", testError)
+			},
+			wantedError: fmt.Errorf("get template of stack my-app-staging: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			m := showAppMocks{
+				storeSvc:       mocks.NewMockstore(ctrl),
+				stackLister:    mocks.NewMockstackLister(ctrl),
+				templateGetter: mocks.NewMockstackTemplateGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					shouldOutputJSON: tc.inJSON,
+					promotionCheck:   []string{"staging", "prod"},
+				},
+				store: m.storeSvc,
+				w:     b,
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m.stackLister, nil
+				},
+				newTemplateGetter: func(_ *config.Environment) (stackTemplateGetter, error) {
+					return m.templateGetter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestShowAppOpts_Doctor(t *testing.T) {
+	testError := errors.New("some error")
+	mockNow := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	mockCaller := identity.Caller{ARN: "arn:aws:iam::123456789012:user/jane"}
+	testCases := map[string]struct {
+		inName        string
+		inJSON        bool
+		inNoPipelines bool
+		inVersion     string
+		setupMocks    func(m showAppMocks)
+
+		wantedContent string
+		wantedError   error
 	}{
-		"valid app name": {
-			inAppName: "my-app",
+		"passes all the checks without an application": {
+			inVersion: "v1.8.0",
+			setupMocks: func(m showAppMocks) {
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.clock.EXPECT().ServerTime().Return(mockNow.Add(-2*time.Second), nil)
+				m.storeSvc.EXPECT().ListApplications().Return([]*config.Application{{Name: "my-app"}, {Name: "other-app"}}, nil)
+			},
+			wantedContent: `Ran 4 checks: 4 passed, 0 warnings, 0 failed.
 
+  pass              CLI version         v1.8.0
+  pass              Credentials         arn:aws:iam::123456789012:user/jane
+  pass              Clock skew          the local clock is 2s ahead of AWS
+  pass              Config store        2 applications found
+`,
+		},
+		"fails without calling the config store further if the application doesn't exist": {
+			inName: "my-app",
+			inJSON: true,
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name: "my-app",
-				}, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, testError)
+				m.clock.EXPECT().ServerTime().Return(time.Time{}, testError)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app"})
 			},
-			wantedError: nil,
+			wantedContent: `{"app":"my-app","diagnostics":[{"check":"CLI version","status":"warn","detail":"unknown, the CLI was built from source"},{"check":"Credentials","status":"fail","detail":"some error"},{"check":"Clock skew","status":"warn","detail":"couldn't compare the local clock with AWS: some error"},{"check":"Config store","status":"fail","detail":"application my-app not found"}]}` + "\n",
+			wantedError:   errors.New("2 of 4 checks failed with --doctor"),
 		},
-		"invalid app name": {
-			inAppName: "my-app",
+		"checks the permissions of each environment and the pipelines": {
+			inName:    "my-app",
+			inVersion: "v1.8.0",
+			setupMocks: func(m showAppMocks) {
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.clock.EXPECT().ServerTime().Return(mockNow.Add(6*time.Minute), nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "210987654321", Region: "us-east-1"},
+					{Name: "perf", AccountID: "123456789012", Region: "eu-west-1"},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return(nil, awserr.New("AccessDenied", "not authorized to perform: cloudformation:DescribeStacks", nil))
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "perf",
+				}).Return(nil, testError)
+				m.pipelineSvc.EXPECT().GetPipelinesByTags(map[string]string{"copilot-application": "my-app"}).Return(nil, nil)
+			},
+			wantedContent: `Ran 8 checks: 5 passed, 1 warning, 2 failed.
 
+  pass              CLI version         v1.8.0
+  pass              Credentials         arn:aws:iam::123456789012:user/jane
+  fail              Clock skew          the local clock is 6m0s behind AWS, requests signed more than 5m0s away are rejected
+  pass              Config store        application my-app found
+  pass              Environment test    stacks listed in account 123456789012 and region us-west-2
+  fail              Environment prod    permission denied: list stacks in environment prod: AccessDenied: not authorized to perform: cloudformation:DescribeStacks
+  warn              Environment perf    list stacks in environment perf: some error
+  pass              Pipelines           pipelines listed
+`,
+			wantedError: errors.New("2 of 8 checks failed with --doctor"),
+		},
+		"skips the pipelines with --no-pipelines and warns about a drifting clock": {
+			inName:        "my-app",
+			inVersion:     "v1.8.0",
+			inNoPipelines: true,
 			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+				m.identity.EXPECT().Get().Return(mockCaller, nil)
+				m.clock.EXPECT().ServerTime().Return(mockNow.Add(-2*time.Minute), nil)
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
 			},
+			wantedContent: `Ran 4 checks: 3 passed, 1 warning, 0 failed.
 
-			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
+  pass              CLI version         v1.8.0
+  pass              Credentials         arn:aws:iam::123456789012:user/jane
+  warn              Clock skew          the local clock is 2m0s ahead of AWS
+  pass              Config store        application my-app found
+`,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			defer func(v string) { version.Version = v }(version.Version)
+			version.Version = tc.inVersion
+			b := &bytes.Buffer{}
+			m := showAppMocks{
+				storeSvc:    mocks.NewMockstore(ctrl),
+				stackLister: mocks.NewMockstackLister(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+				identity:    mocks.NewMockidentityService(ctrl),
+				clock:       mocks.NewMockserverClock(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             tc.inName,
+					shouldOutputJSON: tc.inJSON,
+					noPipelines:      tc.inNoPipelines,
+					shouldRunDoctor:  true,
+				},
+				store:       m.storeSvc,
+				w:           b,
+				pipelineSvc: m.pipelineSvc,
+				identity:    m.identity,
+				clock:       m.clock,
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m.stackLister, nil
+				},
+				now: func() time.Time {
+					return mockNow
+				},
+			}
 
-			mockStoreReader := mocks.NewMockstore(ctrl)
-			mockPrompter := mocks.NewMockprompter(ctrl)
+			// WHEN
+			err := opts.Execute()
 
-			mocks := showAppMocks{
-				storeSvc: mockStoreReader,
-				prompt:   mockPrompter,
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
 			}
-			tc.setupMocks(mocks)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestShowAppOpts_CountOnly(t *testing.T) {
+	testError := errors.New("some error")
+	mockEnvs := []*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "prod", AccountID: "210987654321", Region: "us-east-1"},
+	}
+	mockSvcs := []*config.Workload{
+		{Name: "api", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "web", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "worker", Type: manifest.BackendServiceType},
+	}
+	testCases := map[string]struct {
+		inJSON        bool
+		inNoPipelines bool
+		setupMocks    func(m showAppMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"tallies the workloads and the health of their deployments": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(mockSvcs, nil)
+				m.storeSvc.EXPECT().ListJobs("my-app").Return([]*config.Workload{{Name: "report", Type: manifest.ScheduledJobType}}, nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": "my-app"}).Return([]string{"pipeline-my-app"}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test"), StackStatus: aws.String("UPDATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-api"), StackStatus: aws.String("CREATE_COMPLETE")},
+					{StackName: aws.String("my-app-test-worker"), StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE")},
+					{StackName: aws.String("my-app-test-report"), StackStatus: aws.String("UPDATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-prod-api"), StackStatus: aws.String("UPDATE_IN_PROGRESS")},
+				}, nil)
+			},
+			wantedContent: `  Environments                 2
+  Services                     3
+    Backend Service            1
+    Load Balanced Web Service  2
+  Jobs                         1
+  Pipelines                    1
+  Healthy                      2
+  Unhealthy                    2
+`,
+		},
+		"skips the pipelines and the environments whose stacks can't be listed": {
+			inJSON:        true,
+			inNoPipelines: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(mockSvcs[:1], nil)
+				m.storeSvc.EXPECT().ListJobs("my-app").Return(nil, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("my-app-test-api"), StackStatus: aws.String("CREATE_COMPLETE")},
+				}, nil)
+				m.stackLister.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "my-app",
+					"copilot-environment": "prod",
+				}).Return(nil, testError)
+			},
+			wantedContent: `{"app":"my-app","environments":2,"services":{"Load Balanced Web Service":1},"jobs":0,"healthy":1,"unhealthy":0}` + "\n",
+		},
+		"errors if the jobs can't be listed": {
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(mockSvcs, nil)
+				m.storeSvc.EXPECT().ListJobs("my-app").Return(nil, testError)
+			},
+			wantedError: errors.New("list jobs in application my-app: some error"),
+		},
+	}
 
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			m := showAppMocks{
+				storeSvc:    mocks.NewMockstore(ctrl),
+				stackLister: mocks.NewMockstackLister(ctrl),
+				pipelineSvc: mocks.NewMockpipelineGetter(ctrl),
+			}
+			tc.setupMocks(m)
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
-					name: tc.inAppName,
+					name:             "my-app",
+					shouldOutputJSON: tc.inJSON,
+					noPipelines:      tc.inNoPipelines,
+					shouldCountOnly:  true,
+				},
+				store:       m.storeSvc,
+				w:           b,
+				pipelineSvc: m.pipelineSvc,
+				newStackLister: func(_ *config.Environment) (stackLister, error) {
+					return m.stackLister, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestShowAppOpts_CountOnlyRedact(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStackLister := mocks.NewMockstackLister(ctrl)
+	mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+		{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		{Name: "prod", AccountID: "210987654321", Region: "us-east-1"},
+	}, nil)
+	mockStore.EXPECT().ListServices("my-app").Return([]*config.Workload{{Name: "api", Type: manifest.LoadBalancedWebServiceType}}, nil)
+	mockStore.EXPECT().ListJobs("my-app").Return(nil, nil)
+	mockStackLister.EXPECT().ListStacksWithTags(map[string]string{
+		"copilot-application": "my-app",
+		"copilot-environment": "test",
+	}).Return([]cloudformation.StackDescription{
+		{StackName: aws.String("my-app-test-api"), StackStatus: aws.String("CREATE_COMPLETE")},
+	}, nil)
+	mockStackLister.EXPECT().ListStacksWithTags(map[string]string{
+		"copilot-application": "my-app",
+		"copilot-environment": "prod",
+	}).Return(nil, errors.New("AccessDenied: User: arn:aws:sts::210987654321:assumed-role/ci/session is not authorized to perform: tag:GetResources"))
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	defer func(w io.Writer) { log.DiagnosticWriter = w }(log.DiagnosticWriter)
+	log.DiagnosticWriter = stderr
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:            "my-app",
+			noPipelines:     true,
+			shouldCountOnly: true,
+			shouldRedact:    true,
+		},
+		store: mockStore,
+		w:     stdout,
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return mockStackLister, nil
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.NotRegexp(t, redactedAccountIDRegexp, stdout.String())
+	require.NotRegexp(t, redactedAccountIDRegexp, stderr.String())
+	require.Contains(t, stderr.String(), "arn:aws:sts::account-0001:assumed-role/ci/session")
+}
+
+func TestShowAppOpts_CountOnlyWarnings(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStackLister := mocks.NewMockstackLister(ctrl)
+	mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}, {Name: "staging"}, {Name: "prod"}}, nil)
+	mockStore.EXPECT().ListServices("my-app").Return(nil, nil)
+	mockStore.EXPECT().ListJobs("my-app").Return(nil, nil)
+	mockStackLister.EXPECT().ListStacksWithTags(gomock.Any()).DoAndReturn(func(tags map[string]string) ([]cloudformation.StackDescription, error) {
+		return nil, fmt.Errorf("some error in %s", tags["copilot-environment"])
+	}).Times(3)
+	stderr := &bytes.Buffer{}
+	defer func(w io.Writer) { log.DiagnosticWriter = w }(log.DiagnosticWriter)
+	log.DiagnosticWriter = stderr
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:            "my-app",
+			noPipelines:     true,
+			shouldCountOnly: true,
+		},
+		store: mockStore,
+		w:     &bytes.Buffer{},
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return mockStackLister, nil
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Note: Couldn't count the workloads deployed in environment test: list stacks in environment test: some error in test
+Note: Couldn't count the workloads deployed in environment staging: list stacks in environment staging: some error in staging
+Note: Couldn't count the workloads deployed in environment prod: list stacks in environment prod: some error in prod
+`, stderr.String())
+}
+
+func TestShowAppOpts_Interrupt(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
+	mockStackLister := mocks.NewMockstackLister(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{
+		Name:      "my-app",
+		AccountID: "123456789012",
+		Version:   "v1.0.0",
+	}, nil)
+	mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+	mockStore.EXPECT().ListServices("my-app").Return(nil, nil)
+	mockPLSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+	// The command is interrupted while the stacks of the environments are fetched, aborting the calls in flight.
+	mockStackLister.EXPECT().ListStacksWithTags(gomock.Any()).DoAndReturn(func(_ map[string]string) ([]cloudformation.StackDescription, error) {
+		cancel()
+		return nil, errors.New("RequestCanceled: request context canceled")
+	}).Times(2)
+
+	b := &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:     "my-app",
+			isStrict: true,
+		},
+		store:       mockStore,
+		w:           b,
+		pipelineSvc: mockPLSvc,
+		addons:      &fakeAddonsReader{},
+		ctx:         ctx,
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return mockStackLister, nil
+		},
+		screenWidth: func() int {
+			return 0
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.Equal(t, &ErrSilentExit{Code: 130}, err)
+	require.Empty(t, b.String(), "expected the partial output not to be written")
+}
+
+func TestShowAppOpts_JSONSchema(t *testing.T) {
+	// GIVEN
+	b := &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			shouldPrintSchema: true,
+			formatVersion:     describe.AppFormatVersion1,
+		},
+		w: b,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	wanted, err := describe.AppJSONSchema(describe.AppFormatVersion1)
+	require.NoError(t, err)
+	require.Equal(t, wanted, b.String(), "expected the schema to be written without describing the application")
+}
+
+func TestShowAppOpts_Exists(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     showAppVars
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"exits with 0 if the application exists": {
+			inVars: showAppVars{name: "my-app", shouldCheckExists: true},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+		"exits with 2 if the application doesn't exist": {
+			inVars: showAppVars{name: "my-ap", shouldCheckExists: true},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-ap").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-ap"})
+			},
+
+			wantedError: &ErrSilentExit{Code: 2},
+		},
+		"exits with 1 if the application can't be retrieved": {
+			inVars: showAppVars{name: "my-app", shouldCheckExists: true},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get application my-app: some error"),
+		},
+		"skips the description flags": {
+			inVars: showAppVars{name: "my-app", shouldCheckExists: true, shouldOutputJSON: true, shouldOutputResources: true, isStrict: true},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			b := &bytes.Buffer{}
+			opts := &showAppOpts{
+				showAppVars: tc.inVars,
+				store:       mockStore,
+				w:           b,
+			}
+
+			// WHEN
+			err := opts.Validate()
+			require.NoError(t, err)
+			err = opts.Ask()
+			require.NoError(t, err)
+			err = opts.Execute()
+
+			// THEN
+			var silentErr *ErrSilentExit
+			if _, ok := tc.wantedError.(*ErrSilentExit); ok {
+				require.True(t, errors.As(err, &silentErr), "expected the command to exit silently")
+				require.Equal(t, tc.wantedError, silentErr)
+			} else if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				require.False(t, errors.As(err, &silentErr), "expected a real error to exit with 1")
+			} else {
+				require.NoError(t, err)
+			}
+			require.Empty(t, b.String(), "expected nothing to be written")
+		})
+	}
+}
+
+func TestShowAppOpts_ApplyWorkspaceDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		inVars         showAppVars
+		inChangedFlags []string
+		inNoWorkspace  bool
+		inNoFile       bool
+		inFile         string
+
+		wantedVars  showAppVars
+		wantedError error
+	}{
+		"no defaults outside of a workspace": {
+			inNoWorkspace: true,
+			inFile:        "output: json",
+		},
+		"no defaults without a file": {
+			inNoFile: true,
+		},
+		"sets the flags from the file": {
+			inFile: `output: json
+resources: true
+show-secrets: true
+full: true
+no-color: true
+no-legend: true
+no-hints: true
+fail-on: error
+`,
+			wantedVars: showAppVars{
+				outputFormat:          "json",
+				shouldOutputResources: true,
+				shouldShowSecrets:     true,
+				shouldShowFull:        true,
+				noColor:               true,
+				noLegend:              true,
+				noHints:               true,
+				failOn:                "error",
+			},
+		},
+		"flags set on the command line or from environment variables take precedence": {
+			inVars: showAppVars{
+				shouldOutputJSON: false,
+				failOn:           "warning",
+			},
+			inChangedFlags: []string{jsonFlag, failOnFlag, noColorFlag},
+			inFile: `output: csv
+fail-on: error
+no-color: true
+resources: true
+`,
+			wantedVars: showAppVars{
+				failOn:                "warning",
+				shouldOutputResources: true,
+			},
+		},
+		"ignores the defaults that conflict with the flags that were set": {
+			inVars: showAppVars{
+				shouldOutputJSON: true,
+				isStrict:         true,
+			},
+			inChangedFlags: []string{jsonFlag, strictFlag},
+			inFile: `explain: true
+fail-on: info
+`,
+			wantedVars: showAppVars{
+				shouldOutputJSON: true,
+				isStrict:         true,
+			},
+		},
+		"an empty file": {
+			inFile: "",
+		},
+		"errors on unknown keys": {
+			inFile: "colour: false\n",
+
+			wantedError: errors.New("unmarshal flag defaults file /ws/.copilot-show.yaml: yaml: unmarshal errors:\n  line 1: field colour not found in type cli.showAppDefaults"),
+		},
+		"errors on an unsupported output": {
+			inFile: "output: yaml\n",
+
+			wantedError: errors.New(`validate flag defaults file /ws/.copilot-show.yaml: unsupported output "yaml", must be one of human, json, csv, openmetrics, lines, markdown, go, mermaid or dotenv`),
+		},
+		"errors on an unsupported severity": {
+			inFile: "fail-on: critical\n",
+
+			wantedError: errors.New(`validate flag defaults file /ws/.copilot-show.yaml: unsupported severity "critical" for fail-on, must be one of info, warning, error`),
+		},
+		"errors on an unsupported format version": {
+			inFile: "format-version: \"0\"\n",
+
+			wantedError: errors.New(`validate flag defaults file /ws/.copilot-show.yaml: unsupported format version "0" for format-version, must be one of 1`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockcopilotDirGetter(ctrl)
+			if tc.inNoWorkspace {
+				mockWs.EXPECT().CopilotDirPath().Return("", errors.New("couldn't find a directory called copilot"))
+			} else {
+				mockWs.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			}
+			fs := afero.NewMemMapFs()
+			if !tc.inNoFile {
+				require.NoError(t, afero.WriteFile(fs, "/ws/.copilot-show.yaml", []byte(tc.inFile), 0644))
+			}
+			changed := make(map[string]bool)
+			for _, flag := range tc.inChangedFlags {
+				changed[flag] = true
+			}
+			opts := &showAppOpts{
+				showAppVars: tc.inVars,
+				ws:          mockWs,
+				fs:          fs,
+				flagChanged: func(name string) bool {
+					return changed[name]
 				},
-				store:  mockStoreReader,
-				prompt: mockPrompter,
 			}
 
 			// WHEN
-			err := opts.Validate()
+			err := opts.applyWorkspaceDefaults()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVars, opts.showAppVars)
+		})
+	}
+}
+
+func TestShowAppOpts_IncludeTemplates(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
+	mockStackLister := mocks.NewMockstackLister(ctrl)
+	mockTemplateGetter := mocks.NewMockstackTemplateGetter(ctrl)
+	mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{
+		Name:      "my-app",
+		AccountID: "123456789012",
+		Version:   "v1.0.0",
+	}, nil)
+	mockStore.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+	mockStore.EXPECT().ListServices("my-app").Return(nil, nil)
+	mockPLSvc.EXPECT().GetPipelinesByTags(gomock.Any()).Return(nil, nil)
+	mockStackLister.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+		{StackName: aws.String("my-app-test")},
+		{StackName: aws.String("my-app-test-api")},
+	}, nil)
+	mockTemplateGetter.EXPECT().TemplateBody("my-app-test").Return("Description: environment", nil)
+	mockTemplateGetter.EXPECT().TemplateBody("my-app-test-api").Return("Description: service", nil)
+
+	fs := afero.NewMemMapFs()
+	b := &bytes.Buffer{}
+	opts := &showAppOpts{
+		showAppVars: showAppVars{
+			name:             "my-app",
+			shouldOutputJSON: true,
+			includeTemplates: true,
+			templatesDir:     "templates",
+		},
+		store:       mockStore,
+		w:           b,
+		fs:          fs,
+		pipelineSvc: mockPLSvc,
+		addons:      &fakeAddonsReader{},
+		newStackLister: func(_ *config.Environment) (stackLister, error) {
+			return mockStackLister, nil
+		},
+		newTemplateGetter: func(_ *config.Environment) (stackTemplateGetter, error) {
+			return mockTemplateGetter, nil
+		},
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.NotContains(t, b.String(), "Description", "expected the templates to not be inlined in the output")
+	envTpl, err := afero.ReadFile(fs, "templates/my-app-test.stack.yml")
+	require.NoError(t, err)
+	require.Equal(t, "Description: environment", string(envTpl))
+	svcTpl, err := afero.ReadFile(fs, "templates/my-app-test-api.stack.yml")
+	require.NoError(t, err)
+	require.Equal(t, "Description: service", string(svcTpl))
+}
+
+func TestAppShowEnvFlagDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		inArgs []string
+		inEnv  map[string]string
+
+		wantedJSON     bool
+		wantedName     string
+		wantedNoColor  bool
+		wantedEndpoint string
+		wantedError    error
+	}{
+		"sets the flags from the environment variables": {
+			inEnv: map[string]string{
+				"COPILOT_OUTPUT":         "JSON",
+				"COPILOT_APP":            "my-app",
+				"COPILOT_NO_COLOR":       "true",
+				"COPILOT_STORE_ENDPOINT": "http://localhost:4566",
+			},
+
+			wantedJSON:     true,
+			wantedName:     "my-app",
+			wantedNoColor:  true,
+			wantedEndpoint: "http://localhost:4566",
+		},
+		"explicit flags win over the environment variables": {
+			inArgs: []string{"--json=false", "-n", "other-app"},
+			inEnv: map[string]string{
+				"COPILOT_OUTPUT": "json",
+				"COPILOT_APP":    "my-app",
+			},
+
+			wantedName: "other-app",
+		},
+		"errors if the output is not supported": {
+			inEnv: map[string]string{
+				"COPILOT_OUTPUT": "yaml",
+			},
+
+			wantedError: errors.New(`environment variable COPILOT_OUTPUT: unsupported output "yaml", must be one of json or human`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			flags := buildAppShowCmd().Flags()
+			require.NoError(t, flags.Parse(tc.inArgs))
+
+			// WHEN
+			err := defaultFlagsFromEnv(flags, func(key string) (string, bool) {
+				v, ok := tc.inEnv[key]
+				return v, ok
+			}, appShowEnvFlagDefaults)
 
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
-			} else {
-				require.NoError(t, err)
+				return
 			}
+			require.NoError(t, err)
+			isJSON, _ := flags.GetBool(jsonFlag)
+			require.Equal(t, tc.wantedJSON, isJSON)
+			appName, _ := flags.GetString(nameFlag)
+			require.Equal(t, tc.wantedName, appName)
+			noColor, _ := flags.GetBool(noColorFlag)
+			require.Equal(t, tc.wantedNoColor, noColor)
+			endpoint, _ := flags.GetString(storeEndpointFlag)
+			require.Equal(t, tc.wantedEndpoint, endpoint)
 		})
 	}
 }
 
-func TestShowAppOpts_Ask(t *testing.T) {
-	testError := errors.New("some error")
+func TestAppShowPrettyFlag(t *testing.T) {
 	testCases := map[string]struct {
-		inApp string
+		inArgs []string
 
-		setupMocks func(mocks showAppMocks)
-
-		wantedApp   string
-		wantedError error
+		wanted bool
 	}{
-		"with all flags": {
-			inApp: "my-app",
-
-			setupMocks: func(m showAppMocks) {},
+		"compact json output by default": {
+			inArgs: []string{"--json"},
 
-			wantedApp:   "my-app",
-			wantedError: nil,
+			wanted: false,
 		},
-		"prompt for all input": {
-			inApp: "",
+		"indents the json output with pretty": {
+			inArgs: []string{"--json", "--pretty"},
 
-			setupMocks: func(m showAppMocks) {
-				m.sel.EXPECT().Application(appShowNamePrompt, appShowNameHelpPrompt).Return("my-app", nil)
-			},
-			wantedApp:   "my-app",
-			wantedError: nil,
+			wanted: true,
 		},
-		"returns error if failed to select application": {
-			inApp: "",
+	}
 
-			setupMocks: func(m showAppMocks) {
-				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", testError)
-			},
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			flags := buildAppShowCmd().Flags()
+			require.NoError(t, flags.Parse(tc.inArgs))
 
-			wantedError: fmt.Errorf("select application: %w", testError),
+			isPretty, err := flags.GetBool(prettyFlag)
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, isPretty)
+		})
+	}
+}
+
+// fakeGitRun returns a run of git that writes the output to its stdout.
+func fakeGitRun(output string) func(name string, args []string, options ...command.Option) error {
+	return func(_ string, _ []string, options ...command.Option) error {
+		cmd := &osexec.Cmd{}
+		for _, opt := range options {
+			opt(cmd)
+		}
+		_, err := io.WriteString(cmd.Stdout, output)
+		return err
+	}
+}
+
+func TestReadShowAppContext(t *testing.T) {
+	const contexts = `contexts:
+  prod:
+    profile: prod-admin
+    region: us-west-2
+    store-region: us-east-1
+  local:
+    store-endpoint: http://localhost:4566
+`
+	testCases := map[string]struct {
+		inContent string
+		inName    string
+
+		wantedContext *showAppContext
+		wantedError   error
+	}{
+		"returns the context": {
+			inContent:     contexts,
+			inName:        "prod",
+			wantedContext: &showAppContext{Profile: "prod-admin", Region: "us-west-2", StoreRegion: "us-east-1"},
+		},
+		"fails on an unknown context": {
+			inContent:   contexts,
+			inName:      "staging",
+			wantedError: errors.New("context staging not found in contexts.yml, must be one of local or prod"),
+		},
+		"fails if there are no contexts": {
+			inName:      "prod",
+			wantedError: errors.New("context prod not found in contexts.yml: there are no contexts"),
+		},
+		"fails on an unknown setting": {
+			inContent:   "contexts:\n  prod:\n    account: 123456789012\n",
+			inName:      "prod",
+			wantedError: errors.New("unmarshal contexts file contexts.yml: yaml: unmarshal errors:\n  line 3: field account not found in type cli.showAppContext"),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			mocks := showAppMocks{
-				sel: mocks.NewMockappSelector(ctrl),
-			}
-			tc.setupMocks(mocks)
-
-			opts := &showAppOpts{
-				showAppVars: showAppVars{
-					name: tc.inApp,
-				},
-				sel: mocks.sel,
-			}
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "contexts.yml", []byte(tc.inContent), 0644))
 
 			// WHEN
-			err := opts.Ask()
+			showCtx, err := readShowAppContext(fs, "contexts.yml", tc.inName)
 
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
-			} else {
-				require.NoError(t, err)
-				require.Equal(t, tc.wantedApp, opts.name, "expected app names to match")
-
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContext, showCtx)
 		})
 	}
 }
 
-func TestShowAppOpts_Execute(t *testing.T) {
-	testAppName := "my-app"
-	testError := errors.New("some error")
+func TestShowAppContext_Apply(t *testing.T) {
 	testCases := map[string]struct {
-		shouldOutputJSON bool
-
-		setupMocks func(mocks showAppMocks)
+		inChanged []string
 
-		wantedContent string
-		wantedError   error
+		wantedStoreRegion   string
+		wantedStoreEndpoint string
 	}{
-		"correctly shows json output": {
-			shouldOutputJSON: true,
+		"sets the store flags that weren't set": {
+			wantedStoreRegion:   "us-east-1",
+			wantedStoreEndpoint: "http://localhost:4566",
+		},
+		"the flags that were set take precedence": {
+			inChanged:           []string{storeRegionFlag},
+			wantedStoreRegion:   "eu-west-1",
+			wantedStoreEndpoint: "http://localhost:4566",
+		},
+	}
 
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-						Prod:      false,
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-						Prod:      true,
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return([]*codepipeline.Pipeline{
-						{Name: "pipeline1"},
-						{Name: "pipeline2"},
-					}, nil)
-			},
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			vars := showAppVars{storeRegion: "eu-west-1"}
+			showCtx := &showAppContext{StoreRegion: "us-east-1", StoreEndpoint: "http://localhost:4566"}
 
-			wantedContent: "{\"name\":\"my-app\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
-		},
-		"correctly shows human output": {
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return([]*codepipeline.Pipeline{
-						{Name: "pipeline1"},
-						{Name: "pipeline2"},
-					}, nil)
-			},
+			// WHEN
+			showCtx.apply(&vars, func(name string) bool {
+				for _, changed := range tc.inChanged {
+					if name == changed {
+						return true
+					}
+				}
+				return false
+			})
 
-			wantedContent: `About
+			// THEN
+			require.Equal(t, tc.wantedStoreRegion, vars.storeRegion)
+			require.Equal(t, tc.wantedStoreEndpoint, vars.storeEndpoint)
+		})
+	}
+}
 
-  Name              my-app
-  URI               example.com
+func TestCommitWorkspace(t *testing.T) {
+	const commit = "4c1b2e9f"
+	t.Run("reads the summary of the workspace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockGit := mocks.NewMockrunner(ctrl)
+		mockGit.EXPECT().Run("git", []string{"-C", "/ws", "show", "4c1b2e9f:./copilot/.workspace"}, gomock.Any()).
+			DoAndReturn(fakeGitRun("application: my-app\n"))
+		ws := &commitWorkspace{git: mockGit, dir: "/ws", commit: commit}
 
-Environments
+		summary, err := ws.Summary()
 
-  Name              AccountID           Region
-  ----              ---------           ------
-  test              123456789           us-west-2
-  prod              123456789           us-west-1
+		require.NoError(t, err)
+		require.Equal(t, &workspace.Summary{Application: "my-app"}, summary)
+	})
+	t.Run("lists the services with a manifest at the commit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockGit := mocks.NewMockrunner(ctrl)
+		gomock.InOrder(
+			mockGit.EXPECT().Run("git", []string{"-C", "/ws", "ls-tree", "-d", "--name-only", "4c1b2e9f", "--", "./copilot/"}, gomock.Any()).
+				DoAndReturn(fakeGitRun("copilot/api\ncopilot/environments\ncopilot/report\n")),
+			mockGit.EXPECT().Run("git", []string{"-C", "/ws", "show", "4c1b2e9f:./copilot/api/manifest.yml"}, gomock.Any()).
+				DoAndReturn(fakeGitRun("name: api\ntype: Load Balanced Web Service\n")),
+			mockGit.EXPECT().Run("git", []string{"-C", "/ws", "show", "4c1b2e9f:./copilot/environments/manifest.yml"}, gomock.Any()).
+				Return(errors.New("exit status 128")),
+			mockGit.EXPECT().Run("git", []string{"-C", "/ws", "show", "4c1b2e9f:./copilot/report/manifest.yml"}, gomock.Any()).
+				DoAndReturn(fakeGitRun("name: report\ntype: Scheduled Job\n")),
+		)
+		ws := &commitWorkspace{git: mockGit, dir: "/ws", commit: commit}
 
-Services
+		names, err := ws.ServiceNames()
 
-  Name              Type
-  ----              ----
-  my-svc            lb-web-svc
+		require.NoError(t, err)
+		require.Equal(t, []string{"api"}, names)
+	})
+	t.Run("wraps the error of a manifest that can't be read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockGit := mocks.NewMockrunner(ctrl)
+		mockGit.EXPECT().Run("git", []string{"-C", "/ws", "show", "4c1b2e9f:./copilot/api/manifest.yml"}, gomock.Any()).
+			Return(errors.New("exit status 128"))
+		ws := &commitWorkspace{git: mockGit, dir: "/ws", commit: commit}
 
-Pipelines
+		_, err := ws.ReadServiceManifest("api")
 
-  Name
-  ----
-  pipeline1
-  pipeline2
-`,
-		},
-		"returns error if fail to get application": {
-			shouldOutputJSON: false,
+		require.EqualError(t, err, "read service api manifest file: read copilot/api/manifest.yml at commit 4c1b2e9f: exit status 128")
+	})
+}
 
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
-			},
+func TestNewAppCache(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("AKIA", "secret", ""),
+	})
+	require.NoError(t, err)
 
-			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
+	require.Nil(t, newAppCache(fs, "", sess), "expected no cache without a cache directory")
+	cache := newAppCache(fs, "/cache", sess)
+	require.NotNil(t, cache)
+	require.NoError(t, cache.SetAppNames([]string{"my-app"}))
+
+	exists, err := afero.Exists(fs, "/cache/copilot/apps.json")
+	require.NoError(t, err)
+	require.True(t, exists)
+	names, ok := newAppCache(fs, "/cache", sess).AppNames()
+	require.True(t, ok)
+	require.Equal(t, []string{"my-app"}, names)
+	otherSess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
+	_, ok = newAppCache(fs, "/cache", otherSess).AppNames()
+	require.False(t, ok)
+}
+
+func TestShowAppOpts_RecommendedActions(t *testing.T) {
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	testCases := map[string]struct {
+		inVars      showAppVars
+		inDescribed *describe.App
+
+		wantedActions []string
+	}{
+		"recommends nothing if the application wasn't described": {},
+		"recommends nothing with --no-hints": {
+			inVars:      showAppVars{noHints: true},
+			inDescribed: &describe.App{Name: "my-app"},
 		},
-		"returns error if fail to list environment": {
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, testError)
-			},
+		"recommends nothing with json": {
+			inVars:      showAppVars{shouldOutputJSON: true},
+			inDescribed: &describe.App{Name: "my-app"},
+		},
+		"recommends adding an environment to an application without any": {
+			inDescribed: &describe.App{Name: "my-app"},
 
-			wantedError: fmt.Errorf("list environments in application %s: %w", "my-app", testError),
+			wantedActions: []string{"Run `copilot env init` to add an environment to your application."},
 		},
-		"returns error if fail to list services": {
-			shouldOutputJSON: false,
+		"recommends adding a service to an application without any": {
+			inDescribed: &describe.App{Name: "my-app", Envs: mockEnvs},
 
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, testError)
+			wantedActions: []string{"Run `copilot init` to add a service or job to your application."},
+		},
+		"recommends the actions for the undeployed services, the failed stacks and the missing pipelines": {
+			inDescribed: &describe.App{
+				Name: "my-app",
+				Envs: mockEnvs,
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "worker", Type: "Backend Service"},
+					{Name: "report", Type: "Scheduled Job"},
+				},
+				EnvStatuses: map[string]string{"test": "UPDATE_COMPLETE", "prod": "ROLLBACK_COMPLETE"},
+				Deployments: []*describe.AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "api", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_COMPLETE"},
+				},
 			},
 
-			wantedError: fmt.Errorf("list services in application %s: %w", "my-app", testError),
+			wantedActions: []string{
+				"Run `copilot svc deploy --name worker` to deploy worker to an environment.",
+				"Run `copilot job deploy --name report` to deploy report to an environment.",
+				"Run `copilot env show --name prod` to see why environment prod isn't provisioned successfully.",
+				"Run `copilot svc status --name api --env prod` to see why api failed to deploy to prod.",
+				"No pipelines found. Run `copilot pipeline init` to release your services with a pipeline.",
+			},
 		},
-		"returns error if fail to list pipelines": {
-			shouldOutputJSON: false,
-
-			setupMocks: func(m showAppMocks) {
-				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
-					Name:   "my-app",
-					Domain: "example.com",
-				}, nil)
-				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
-					{
-						Name:      "test",
-						Region:    "us-west-2",
-						AccountID: "123456789",
-					},
-					{
-						Name:      "prod",
-						AccountID: "123456789",
-						Region:    "us-west-1",
-					},
-				}, nil)
-				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
-					{
-						Name: "my-svc",
-						Type: "lb-web-svc",
-					},
-				}, nil)
-				m.pipelineSvc.EXPECT().
-					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
-					Return(nil, testError)
+		"doesn't recommend a pipeline if the pipelines were skipped": {
+			inDescribed: &describe.App{
+				Name:             "my-app",
+				Envs:             mockEnvs,
+				Services:         []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}},
+				Deployments:      []*describe.AppDeployment{{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE"}},
+				PipelinesSkipped: true,
 			},
-			wantedError: fmt.Errorf("list pipelines in application %s: %w", "my-app", testError),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			b := &bytes.Buffer{}
-			mockStoreReader := mocks.NewMockstore(ctrl)
-			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
-
-			mocks := showAppMocks{
-				storeSvc:    mockStoreReader,
-				pipelineSvc: mockPLSvc,
-			}
-			tc.setupMocks(mocks)
-
 			opts := &showAppOpts{
-				showAppVars: showAppVars{
-					shouldOutputJSON: tc.shouldOutputJSON,
-					name:             testAppName,
-				},
-				store:       mockStoreReader,
-				w:           b,
-				pipelineSvc: mockPLSvc,
+				showAppVars: tc.inVars,
+				described:   tc.inDescribed,
 			}
 
-			// WHEN
-			err := opts.Execute()
-
-			// THEN
-			if tc.wantedError != nil {
-				require.EqualError(t, err, tc.wantedError.Error())
-			} else {
-				require.NoError(t, err)
-				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
-			}
+			require.Equal(t, tc.wantedActions, opts.RecommendedActions())
 		})
 	}
 }
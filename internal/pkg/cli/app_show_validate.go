@@ -0,0 +1,715 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// appShowExclusiveMode is a flag of app show that changes what the command does, and the flags that can't be
+// combined with it in the order they're checked. If --output is one of them, the mode can still be written in outputs.
+type appShowExclusiveMode struct {
+	flag      string
+	conflicts []string
+	outputs   []string
+}
+
+// appShowDoctorMode is --doctor, which checks the access to the config store and the accounts instead of describing
+// the application.
+var appShowDoctorMode = appShowExclusiveMode{
+	flag: doctorFlag,
+	conflicts: []string{
+		outputFlag,
+		explainFlag,
+		onlyFailingFlag,
+		includeTemplatesFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		diffBaselineFlag,
+		listOnlyFlag,
+		compareEnvFlag,
+		promotionCheckFlag,
+	},
+	outputs: []string{appShowOutputHuman, appShowOutputJSON},
+}
+
+// appShowCountOnlyMode is --count-only, which counts the environments, services and pipelines without describing them.
+var appShowCountOnlyMode = appShowExclusiveMode{
+	flag: countOnlyFlag,
+	conflicts: []string{
+		outputFlag,
+		explainFlag,
+		onlyFailingFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		diffBaselineFlag,
+		listOnlyFlag,
+		compareEnvFlag,
+		promotionCheckFlag,
+		doctorFlag,
+	},
+	outputs: []string{appShowOutputHuman, appShowOutputJSON},
+}
+
+// appShowWatchMode is --watch, which refreshes the human readable description in the terminal until the command is
+// interrupted.
+var appShowWatchMode = appShowExclusiveMode{
+	flag: watchFlag,
+	conflicts: []string{
+		outputFlag,
+		jsonFlag,
+		outputTemplateFileFlag,
+		validateOnlyFlag,
+		diffBaselineFlag,
+		existsFlag,
+		listOnlyFlag,
+		compareEnvFlag,
+		promotionCheckFlag,
+		doctorFlag,
+		countOnlyFlag,
+		strictFlag,
+		failOnFlag,
+		clipboardFlag,
+		pageFlag,
+	},
+	outputs: []string{appShowOutputHuman},
+}
+
+// appShowStrictJSONMode is --strict-json, which writes the json description and fails if it's incomplete.
+var appShowStrictJSONMode = appShowExclusiveMode{
+	flag: strictJSONFlag,
+	conflicts: []string{
+		outputFlag,
+		explainFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		compareEnvFlag,
+		onlyFailingFlag,
+	},
+	outputs: []string{appShowOutputJSON},
+}
+
+// appShowDiffBaselineMode is --diff-baseline, which writes the differences with a previous description instead of the
+// description.
+var appShowDiffBaselineMode = appShowExclusiveMode{
+	flag: diffBaselineFlag,
+	conflicts: []string{
+		outputFlag,
+		explainFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		compareEnvFlag,
+		onlyFailingFlag,
+	},
+	outputs: []string{appShowOutputHuman, appShowOutputJSON},
+}
+
+// appShowPromotionCheckMode is --promotion-check, which compares the deployments of two environments instead of
+// describing the application.
+var appShowPromotionCheckMode = appShowExclusiveMode{
+	flag: promotionCheckFlag,
+	conflicts: []string{
+		outputFlag,
+		explainFlag,
+		onlyFailingFlag,
+		includeTemplatesFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		compareEnvFlag,
+	},
+	outputs: []string{appShowOutputHuman, appShowOutputJSON},
+}
+
+// appShowJSONSchemaMode is --json-schema, which writes the schema of the json description without describing the
+// application.
+var appShowJSONSchemaMode = appShowExclusiveMode{
+	flag: jsonSchemaFlag,
+	conflicts: []string{
+		listOnlyFlag,
+		compareEnvFlag,
+		promotionCheckFlag,
+		doctorFlag,
+		countOnlyFlag,
+		watchFlag,
+	},
+}
+
+// appShowDashboardMode is --dashboard, which renders a summary of the human readable description.
+var appShowDashboardMode = appShowExclusiveMode{
+	flag: dashboardFlag,
+	conflicts: []string{
+		jsonFlag,
+		outputFlag,
+		explainFlag,
+		compareEnvFlag,
+	},
+	outputs: []string{appShowOutputHuman},
+}
+
+// appShowValidateOnlyMode is --validate-only, which checks the application without rendering its description.
+var appShowValidateOnlyMode = appShowExclusiveMode{
+	flag: validateOnlyFlag,
+	conflicts: []string{
+		jsonFlag,
+		outputFlag,
+		explainFlag,
+		dashboardFlag,
+		compareEnvFlag,
+		onlyFailingFlag,
+	},
+	outputs: []string{appShowOutputHuman},
+}
+
+// appShowOutputTemplateFileMode is --output-template-file, which renders the description with a Go template.
+var appShowOutputTemplateFileMode = appShowExclusiveMode{
+	flag: outputTemplateFileFlag,
+	conflicts: []string{
+		jsonFlag,
+		outputFlag,
+		explainFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		compareEnvFlag,
+	},
+	outputs: []string{appShowOutputHuman},
+}
+
+// appShowCompareEnvsMode is --compare-env, which compares two environments instead of describing the application.
+var appShowCompareEnvsMode = appShowExclusiveMode{
+	flag: compareEnvFlag,
+	conflicts: []string{
+		explainFlag,
+		onlyFailingFlag,
+		includeTemplatesFlag,
+	},
+}
+
+// appShowExistsMode is --exists, which checks that the application exists without describing it.
+var appShowExistsMode = appShowExclusiveMode{
+	flag: existsFlag,
+	conflicts: []string{
+		listOnlyFlag,
+		compareEnvFlag,
+	},
+}
+
+// appShowOutputTargetsMode is several formats of --output, which write the description to several targets at once.
+var appShowOutputTargetsMode = appShowExclusiveMode{
+	flag: outputFlag,
+	conflicts: []string{
+		jsonFlag,
+		explainFlag,
+		dashboardFlag,
+		validateOnlyFlag,
+		outputTemplateFileFlag,
+		clipboardFlag,
+		compareEnvFlag,
+	},
+}
+
+// validateSortEnvs returns an error if the order of the environments isn't one of describe.EnvSortOrders.
+func (o *showAppOpts) validateSortEnvs() error {
+	for _, order := range describe.EnvSortOrders {
+		if o.sortEnvs == order {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported environment order %q for --%s, must be one of %s", o.sortEnvs, sortEnvsFlag, strings.Join(describe.EnvSortOrders, ", "))
+}
+
+// validateFormatVersion returns an error if the version of the layout of the output set by source isn't supported.
+func validateFormatVersion(version, source string) error {
+	for _, supported := range describe.AppFormatVersions {
+		if version == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported format version %q for %s, must be one of %s", version, source, strings.Join(describe.AppFormatVersions, ", "))
+}
+
+// validatePipelineSource returns an error if the source of the pipelines isn't supported,
+// or if the pipelines of GitHub Actions are requested while the pipelines are skipped.
+func (o *showAppOpts) validatePipelineSource() error {
+	switch o.pipelineSource {
+	case appShowPipelineSourceCodePipeline:
+	case appShowPipelineSourceGitHubActions:
+		if o.noPipelines {
+			return fmt.Errorf("--%s %s and --%s cannot be specified together", pipelineSourceFlag, o.pipelineSource, noPipelinesFlag)
+		}
+	default:
+		return fmt.Errorf("unsupported pipeline source %q, must be one of %s or %s", o.pipelineSource, appShowPipelineSourceCodePipeline, appShowPipelineSourceGitHubActions)
+	}
+	return nil
+}
+
+// validateFromPipeline sets the name to the application that the pipeline of --from-pipeline was created for,
+// from its application tag. --name can only be set to the same application.
+func (o *showAppOpts) validateFromPipeline() error {
+	if o.pipelineSource == appShowPipelineSourceGitHubActions {
+		return fmt.Errorf("--%s and --%s %s cannot be specified together", fromPipelineFlag, pipelineSourceFlag, o.pipelineSource)
+	}
+	tags, err := o.pipelineTags.PipelineTags(o.fromPipeline)
+	if err != nil {
+		return fmt.Errorf("--%s: %w", fromPipelineFlag, err)
+	}
+	app := tags[deploy.AppTagKey]
+	if app == "" {
+		return fmt.Errorf("pipeline %s has no %s tag, so it doesn't belong to any application", o.fromPipeline, deploy.AppTagKey)
+	}
+	if o.flagChanged(nameFlag) && o.name != app {
+		return fmt.Errorf("pipeline %s belongs to application %s, not to %s of --%s", o.fromPipeline, app, o.name, nameFlag)
+	}
+	o.name = app
+	return nil
+}
+
+// validateName resolves --name to an application. An exact match always wins. Otherwise, the applications
+// whose name starts with --name are matched, or the ones that contain it if none does.
+// A single match is selected, and several matches are prompted for in Ask.
+func (o *showAppOpts) validateName() error {
+	_, err := o.store.GetApplication(o.name)
+	if err == nil {
+		return nil
+	}
+	if o.allowStackFallback && isTransientErr(err) {
+		// The application is looked up in the stacks instead once the config store fails again.
+		return nil
+	}
+	var noSuchAppErr *config.ErrNoSuchApplication
+	if !errors.As(err, &noSuchAppErr) {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	apps, listErr := o.store.ListApplications()
+	if listErr != nil {
+		return fmt.Errorf("list applications: %w", listErr)
+	}
+	matches := matchAppName(o.name, apps)
+	switch len(matches) {
+	case 0:
+		if suggestions := suggestAppNames(o.name, apps); o.shouldHintNames && len(suggestions) != 0 {
+			// The suggestions are only logged so that the error is the same for scripts.
+			var quoted []string
+			for _, suggestion := range suggestions {
+				quoted = append(quoted, fmt.Sprintf("'%s'", suggestion))
+			}
+			log.Infof("Did you mean %s?\n", english.OxfordWordSeries(quoted, "or"))
+		}
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	case 1:
+		log.Infof("Found one application matching %s, defaulting to: %s\n", o.name, color.HighlightUserInput(matches[0]))
+		o.name = matches[0]
+	default:
+		o.nameMatches = matches
+		o.name = ""
+	}
+	return nil
+}
+
+// matchAppName returns the names of the applications that start with partial, or that contain it if none does.
+func matchAppName(partial string, apps []*config.Application) []string {
+	var prefixed, contained []string
+	for _, app := range apps {
+		switch {
+		case strings.HasPrefix(app.Name, partial):
+			prefixed = append(prefixed, app.Name)
+		case strings.Contains(app.Name, partial):
+			contained = append(contained, app.Name)
+		}
+	}
+	if len(prefixed) != 0 {
+		return prefixed
+	}
+	return contained
+}
+
+// suggestAppNames returns the names of the applications closest to name by edit distance, sorted, if they're close
+// enough to be typos of it: at most a third of its characters apart, and at least one.
+func suggestAppNames(name string, apps []*config.Application) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+	var suggestions []string
+	for _, app := range apps {
+		distance := levenshtein(name, app.Name)
+		if distance > maxDistance {
+			continue
+		}
+		if distance < maxDistance {
+			// Only the closest names are suggested.
+			maxDistance = distance
+			suggestions = nil
+		}
+		suggestions = append(suggestions, app.Name)
+	}
+	sort.Strings(suggestions)
+	if len(suggestions) > maxAppNameSuggestions {
+		suggestions = suggestions[:maxAppNameSuggestions]
+	}
+	return suggestions
+}
+
+// levenshtein returns the minimum number of single-character insertions, deletions and substitutions to turn a into b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// validateCompareEnvs returns an error if --compare-env doesn't name two different environments,
+// or if it's combined with a flag that changes the description of the application.
+func (o *showAppOpts) validateCompareEnvs() error {
+	if len(o.compareEnvs) != 2 {
+		return fmt.Errorf("--%s requires exactly two environment names", compareEnvFlag)
+	}
+	if o.compareEnvs[0] == o.compareEnvs[1] {
+		return fmt.Errorf("--%s requires two different environments", compareEnvFlag)
+	}
+	return o.validateExclusiveMode(appShowCompareEnvsMode)
+}
+
+// validatePromotionCheck returns an error if --promotion-check doesn't name two different environments,
+// or if it's combined with a flag that changes the description of the application.
+func (o *showAppOpts) validatePromotionCheck() error {
+	if len(o.promotionCheck) != 2 {
+		return fmt.Errorf("--%s requires exactly two environment names", promotionCheckFlag)
+	}
+	if o.promotionCheck[0] == o.promotionCheck[1] {
+		return fmt.Errorf("--%s requires two different environments", promotionCheckFlag)
+	}
+	return o.validateExclusiveMode(appShowPromotionCheckMode)
+}
+
+// validateOmit returns an error if the description isn't written as json, and ignores the paths of --omit
+// that aren't fields of the json output with a warning rather than an error.
+func (o *showAppOpts) validateOmit() error {
+	isJSON := o.shouldOutputJSON
+	for _, target := range o.outputTargets {
+		isJSON = isJSON || target.format == appShowOutputJSON
+	}
+	if !isJSON {
+		return fmt.Errorf("--%s requires --%s or --%s %s", omitFlag, jsonFlag, outputFlag, appShowOutputJSON)
+	}
+	var known []string
+	for _, path := range o.omitFields {
+		if !describe.IsJSONField(path) {
+			log.Warningf("Ignoring --%s %s: the json output has no such field.\n", omitFlag, path)
+			continue
+		}
+		known = append(known, path)
+	}
+	o.omitFields = known
+	return nil
+}
+
+// validateExclusiveMode returns an error if the mode is combined with one of the flags it conflicts with.
+func (o *showAppOpts) validateExclusiveMode(mode appShowExclusiveMode) error {
+	for _, flag := range mode.conflicts {
+		if o.isFlagSet(flag, mode.outputs) {
+			return fmt.Errorf("--%s and --%s cannot be specified together", mode.flag, flag)
+		}
+	}
+	return nil
+}
+
+// isFlagSet returns true if a flag of app show is set, on the command line or in the flag defaults file.
+// --output is set if it's one of several formats, or a single format other than outputs.
+func (o *showAppOpts) isFlagSet(flag string, outputs []string) bool {
+	switch flag {
+	case outputFlag:
+		if o.outputTargets != nil {
+			return true
+		}
+		if o.outputFormat == "" {
+			return false
+		}
+		for _, format := range outputs {
+			if o.outputFormat == format {
+				return false
+			}
+		}
+		return true
+	case jsonFlag:
+		return o.shouldOutputJSON
+	case explainFlag:
+		return o.shouldExplain
+	case onlyFailingFlag:
+		return o.shouldOnlyFailing
+	case includeTemplatesFlag:
+		return o.includeTemplates
+	case dashboardFlag:
+		return o.shouldShowDashboard
+	case validateOnlyFlag:
+		return o.shouldValidateOnly
+	case outputTemplateFileFlag:
+		return o.outputTemplateFile != ""
+	case diffBaselineFlag:
+		return o.diffBaseline != ""
+	case existsFlag:
+		return o.shouldCheckExists
+	case listOnlyFlag:
+		return o.shouldListOnly
+	case compareEnvFlag:
+		return o.compareEnvs != nil
+	case promotionCheckFlag:
+		return o.promotionCheck != nil
+	case doctorFlag:
+		return o.shouldRunDoctor
+	case countOnlyFlag:
+		return o.shouldCountOnly
+	case watchFlag:
+		return o.shouldWatch
+	case strictFlag:
+		return o.isStrict
+	case failOnFlag:
+		return o.failOn != ""
+	case clipboardFlag:
+		return o.shouldCopy
+	case pageFlag:
+		return o.shouldPage
+	}
+	return false
+}
+
+// validateDoctor returns an error if --doctor is combined with a flag that requires describing the application.
+func (o *showAppOpts) validateDoctor() error {
+	return o.validateExclusiveMode(appShowDoctorMode)
+}
+
+// validateCountOnly returns an error if --count-only is combined with a flag that renders or checks the description,
+// as the application isn't described.
+func (o *showAppOpts) validateCountOnly() error {
+	return o.validateExclusiveMode(appShowCountOnlyMode)
+}
+
+// validateJSONSchema returns an error if the format version isn't supported, or if --json-schema is combined with
+// a flag that describes the application instead.
+func (o *showAppOpts) validateJSONSchema() error {
+	if o.formatVersion != "" {
+		if err := validateFormatVersion(o.formatVersion, "--"+formatVersionFlag); err != nil {
+			return err
+		}
+	}
+	return o.validateExclusiveMode(appShowJSONSchemaMode)
+}
+
+// validateWatch returns an error if --diff is specified without --watch, or if --watch is combined with a flag
+// whose output can't be refreshed in the terminal or that exits once the application is described.
+func (o *showAppOpts) validateWatch() error {
+	if !o.shouldWatch {
+		if o.shouldWatchDiff {
+			return fmt.Errorf("--%s requires --%s", watchDiffFlag, watchFlag)
+		}
+		return nil
+	}
+	if o.watchInterval <= 0 {
+		return fmt.Errorf("--%s must be positive, got %s", watchIntervalFlag, o.watchInterval)
+	}
+	return o.validateExclusiveMode(appShowWatchMode)
+}
+
+// validateStrictJSON returns an error if --strict-json is combined with another output than json, and turns on --json.
+func (o *showAppOpts) validateStrictJSON() error {
+	if err := o.validateExclusiveMode(appShowStrictJSONMode); err != nil {
+		return err
+	}
+	o.shouldOutputJSON = true
+	return nil
+}
+
+// validateDashboard returns an error if --dashboard is combined with another layout than the human readable format.
+func (o *showAppOpts) validateDashboard() error {
+	return o.validateExclusiveMode(appShowDashboardMode)
+}
+
+// validateValidateOnly returns an error if --validate-only is combined with the flags that change how the description is rendered.
+func (o *showAppOpts) validateValidateOnly() error {
+	return o.validateExclusiveMode(appShowValidateOnlyMode)
+}
+
+// validateDiffBaseline returns an error if --diff-baseline is combined with a flag that changes the output of the command,
+// or if the baseline isn't the json description of an application.
+func (o *showAppOpts) validateDiffBaseline() error {
+	if err := o.validateExclusiveMode(appShowDiffBaselineMode); err != nil {
+		return err
+	}
+	content, err := afero.ReadFile(o.fs, o.diffBaseline)
+	if err != nil {
+		return fmt.Errorf("read baseline file %s: %w", o.diffBaseline, err)
+	}
+	var baseline describe.App
+	if err := json.Unmarshal(content, &baseline); err != nil {
+		return fmt.Errorf("unmarshal baseline file %s: %w", o.diffBaseline, err)
+	}
+	o.baseline = &baseline
+	return nil
+}
+
+func (o *showAppOpts) validateFailOn() error {
+	if o.isStrict {
+		return fmt.Errorf("--%s and --%s cannot be specified together", strictFlag, failOnFlag)
+	}
+	for _, severity := range describe.WarningSeverities {
+		if o.failOn == severity {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported severity %q for --%s, must be one of %s", o.failOn, failOnFlag, strings.Join(describe.WarningSeverities, ", "))
+}
+
+// validateTemplatesDir returns an error if the templates directory isn't set with --include-templates, or if it isn't writable.
+// The directory is checked before describing the application so that no AWS calls are made in vain.
+func (o *showAppOpts) validateTemplatesDir() error {
+	if !o.includeTemplates {
+		return fmt.Errorf("--%s requires --%s", templatesDirFlag, includeTemplatesFlag)
+	}
+	if o.templatesDir == "" {
+		return fmt.Errorf("--%s requires --%s", includeTemplatesFlag, templatesDirFlag)
+	}
+	if err := o.fs.MkdirAll(o.templatesDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.templatesDir, err)
+	}
+	f, err := afero.TempFile(o.fs, o.templatesDir, templatesDirWriteCheck)
+	if err != nil {
+		return fmt.Errorf("directory %s is not writable: %w", o.templatesDir, err)
+	}
+	f.Close()
+	if err := o.fs.Remove(f.Name()); err != nil {
+		return fmt.Errorf("remove %s: %w", f.Name(), err)
+	}
+	return nil
+}
+
+// validateOutputTemplateFile parses the Go template of --output-template-file, and returns an error if it's
+// combined with another output format.
+func (o *showAppOpts) validateOutputTemplateFile() error {
+	if err := o.validateExclusiveMode(appShowOutputTemplateFileMode); err != nil {
+		return err
+	}
+	content, err := afero.ReadFile(o.fs, o.outputTemplateFile)
+	if err != nil {
+		return fmt.Errorf("read output template file %s: %w", o.outputTemplateFile, err)
+	}
+	tpl, err := template.New(filepath.Base(o.outputTemplateFile)).Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("parse output template file %s: %w", o.outputTemplateFile, err)
+	}
+	o.outputTemplate = tpl
+	return nil
+}
+
+// readEnvProfiles parses the JSON or YAML file that maps environment names to named profiles.
+func (o *showAppOpts) readEnvProfiles() (map[string]string, error) {
+	content, err := afero.ReadFile(o.fs, o.profileFromEnv)
+	if err != nil {
+		return nil, fmt.Errorf("read environment profiles file %s: %w", o.profileFromEnv, err)
+	}
+	// JSON is a subset of YAML, so a single unmarshal handles both formats.
+	profiles := make(map[string]string)
+	if err := yaml.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("unmarshal environment profiles file %s: %w", o.profileFromEnv, err)
+	}
+	return profiles, nil
+}
+
+// validateExists returns an error if --exists is combined with flags that describe the application,
+// or if the application to check isn't named. The name isn't matched against the existing applications.
+func (o *showAppOpts) validateExists() error {
+	if o.name == "" {
+		return fmt.Errorf("--%s is required with --%s", nameFlag, existsFlag)
+	}
+	return o.validateExclusiveMode(appShowExistsMode)
+}
+
+// validateHealthWeights reads the weights of the health score from --health-weights, and scores the health of the
+// application. The weights that aren't set in the file keep their default values.
+func (o *showAppOpts) validateHealthWeights() error {
+	content, err := afero.ReadFile(o.fs, o.healthWeightsFile)
+	if err != nil {
+		return fmt.Errorf("read health weights file %s: %w", o.healthWeightsFile, err)
+	}
+	weights := describe.DefaultHealthWeights()
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&weights); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unmarshal health weights file %s: %w", o.healthWeightsFile, err)
+	}
+	if err := weights.Validate(); err != nil {
+		return fmt.Errorf("health weights file %s: %w", o.healthWeightsFile, err)
+	}
+	o.healthWeights = &weights
+	o.shouldScoreHealth = true
+	return nil
+}
+
+// validateAsOfCommit resolves the git ref of --as-of-commit to a commit of the repository of the workspace, and reads
+// the manifests of the workspace at that commit from then on. The commit is resolved once so that a moving ref, like a
+// branch, can't change between the manifests.
+func (o *showAppOpts) validateAsOfCommit() error {
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("--%s must be run from a workspace: %w", asOfCommitFlag, err)
+	}
+	wsDir := filepath.Dir(copilotDir)
+	var stdout, stderr bytes.Buffer
+	args := []string{"-C", wsDir, "rev-parse", "--verify", "--quiet", o.asOfCommit + "^{commit}"}
+	if err := o.git.Run("git", args, command.Stdout(&stdout), command.Stderr(&stderr)); err != nil {
+		return fmt.Errorf("resolve --%s %s: not a commit of the repository of the workspace: %w", asOfCommitFlag, o.asOfCommit, err)
+	}
+	o.wsCommit = strings.TrimSpace(stdout.String())
+	o.wsSvcs = &commitWorkspace{
+		git:    o.git,
+		dir:    wsDir,
+		commit: o.wsCommit,
+	}
+	return nil
+}
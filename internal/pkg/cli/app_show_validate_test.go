@@ -0,0 +1,350 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestAppNames(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+		inApps []string
+
+		wantedSuggestions []string
+	}{
+		"suggests the closest app name": {
+			inName: "paymnts",
+			inApps: []string{"orders", "payments", "payroll"},
+
+			wantedSuggestions: []string{"payments"},
+		},
+		"suggests all the app names tied for the closest": {
+			inName: "order",
+			inApps: []string{"orders", "border", "payments"},
+
+			wantedSuggestions: []string{"border", "orders"},
+		},
+		"suggests at most three app names": {
+			inName: "api",
+			inApps: []string{"apd", "apc", "apb", "apa"},
+
+			wantedSuggestions: []string{"apa", "apb", "apc"},
+		},
+		"suggests nothing if no app name is close enough": {
+			inName: "inventory",
+			inApps: []string{"orders", "payments"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var apps []*config.Application
+			for _, app := range tc.inApps {
+				apps = append(apps, &config.Application{Name: app})
+			}
+
+			// WHEN
+			suggestions := suggestAppNames(tc.inName, apps)
+
+			// THEN
+			require.Equal(t, tc.wantedSuggestions, suggestions)
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	require.Equal(t, 0, levenshtein("payments", "payments"))
+	require.Equal(t, 1, levenshtein("paymnts", "payments"))
+	require.Equal(t, 3, levenshtein("kitten", "sitting"))
+	require.Equal(t, 6, levenshtein("", "orders"))
+}
+
+func TestShowAppOpts_ValidateHealthWeights(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedWeights *describe.AppHealthWeights
+		wantedError   error
+	}{
+		"keeps the default of the weights that aren't set": {
+			inContent:     "services: 70\ncertificates: 0\n",
+			wantedWeights: &describe.AppHealthWeights{Services: 70, Stacks: 30, Pipelines: 20},
+		},
+		"uses the default weights if the file is empty": {
+			wantedWeights: &describe.AppHealthWeights{Services: 40, Stacks: 30, Pipelines: 20, Certificates: 10},
+		},
+		"fails on an unknown component": {
+			inContent:   "alarms: 10\n",
+			wantedError: errors.New("unmarshal health weights file weights.yml: yaml: unmarshal errors:\n  line 1: field alarms not found in type describe.AppHealthWeights"),
+		},
+		"fails on a negative weight": {
+			inContent:   "stacks: -5\n",
+			wantedError: errors.New("health weights file weights.yml: weight of stacks must be non-negative, got -5"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "weights.yml", []byte(tc.inContent), 0644))
+			opts := &showAppOpts{
+				showAppVars: showAppVars{healthWeightsFile: "weights.yml"},
+				fs:          fs,
+			}
+
+			// WHEN
+			err := opts.validateHealthWeights()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWeights, opts.healthWeights)
+			require.True(t, opts.shouldScoreHealth)
+		})
+	}
+}
+
+func TestShowAppOpts_ValidateFromPipeline(t *testing.T) {
+	testCases := map[string]struct {
+		inName           string
+		inNameChanged    bool
+		inPipelineSource string
+		setupMocks       func(m *mocks.MockpipelineTagsGetter)
+
+		wantedName  string
+		wantedError error
+	}{
+		"describes the application of the pipeline instead of the one of the workspace": {
+			inName: "my-ws-app",
+			setupMocks: func(m *mocks.MockpipelineTagsGetter) {
+				m.EXPECT().PipelineTags("pipeline-my-app-repo").Return(map[string]string{"copilot-application": "my-app"}, nil)
+			},
+			wantedName: "my-app",
+		},
+		"accepts --name if it's the application of the pipeline": {
+			inName:        "my-app",
+			inNameChanged: true,
+			setupMocks: func(m *mocks.MockpipelineTagsGetter) {
+				m.EXPECT().PipelineTags("pipeline-my-app-repo").Return(map[string]string{"copilot-application": "my-app"}, nil)
+			},
+			wantedName: "my-app",
+		},
+		"fails if --name is another application": {
+			inName:        "other-app",
+			inNameChanged: true,
+			setupMocks: func(m *mocks.MockpipelineTagsGetter) {
+				m.EXPECT().PipelineTags("pipeline-my-app-repo").Return(map[string]string{"copilot-application": "my-app"}, nil)
+			},
+			wantedError: errors.New("pipeline pipeline-my-app-repo belongs to application my-app, not to other-app of --name"),
+		},
+		"fails if the pipeline has no application tag": {
+			setupMocks: func(m *mocks.MockpipelineTagsGetter) {
+				m.EXPECT().PipelineTags("pipeline-my-app-repo").Return(map[string]string{"team": "platform"}, nil)
+			},
+			wantedError: errors.New("pipeline pipeline-my-app-repo has no copilot-application tag, so it doesn't belong to any application"),
+		},
+		"wraps the error if the tags can't be retrieved": {
+			setupMocks: func(m *mocks.MockpipelineTagsGetter) {
+				m.EXPECT().PipelineTags("pipeline-my-app-repo").Return(nil, errors.New("get pipeline pipeline-my-app-repo: some error"))
+			},
+			wantedError: errors.New("--from-pipeline: get pipeline pipeline-my-app-repo: some error"),
+		},
+		"fails with the GitHub Actions workflows": {
+			inPipelineSource: appShowPipelineSourceGitHubActions,
+			setupMocks:       func(m *mocks.MockpipelineTagsGetter) {},
+			wantedError:      errors.New("--from-pipeline and --pipeline-source github-actions cannot be specified together"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpipelineTagsGetter(ctrl)
+			tc.setupMocks(m)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:           tc.inName,
+					fromPipeline:   "pipeline-my-app-repo",
+					pipelineSource: tc.inPipelineSource,
+				},
+				pipelineTags: m,
+				flagChanged: func(name string) bool {
+					return name == nameFlag && tc.inNameChanged
+				},
+			}
+
+			// WHEN
+			err := opts.validateFromPipeline()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestShowAppOpts_ValidateAsOfCommit(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockcopilotDirGetter, git *mocks.Mockrunner)
+
+		wantedCommit string
+		wantedError  error
+	}{
+		"fails outside of a workspace": {
+			setupMocks: func(ws *mocks.MockcopilotDirGetter, git *mocks.Mockrunner) {
+				ws.EXPECT().CopilotDirPath().Return("", errors.New("couldn't find a directory called copilot"))
+			},
+			wantedError: errors.New("--as-of-commit must be run from a workspace: couldn't find a directory called copilot"),
+		},
+		"fails if the ref isn't a commit": {
+			setupMocks: func(ws *mocks.MockcopilotDirGetter, git *mocks.Mockrunner) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				git.EXPECT().Run("git", []string{"-C", "/ws", "rev-parse", "--verify", "--quiet", "v1.2.0^{commit}"}, gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedError: errors.New("resolve --as-of-commit v1.2.0: not a commit of the repository of the workspace: exit status 1"),
+		},
+		"reads the workspace at the resolved commit": {
+			setupMocks: func(ws *mocks.MockcopilotDirGetter, git *mocks.Mockrunner) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				git.EXPECT().Run("git", []string{"-C", "/ws", "rev-parse", "--verify", "--quiet", "v1.2.0^{commit}"}, gomock.Any()).
+					DoAndReturn(fakeGitRun("4c1b2e9f0d3a5b6c7d8e9f0a1b2c3d4e5f6a7b8c\n"))
+			},
+			wantedCommit: "4c1b2e9f0d3a5b6c7d8e9f0a1b2c3d4e5f6a7b8c",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockcopilotDirGetter(ctrl)
+			mockGit := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(mockWs, mockGit)
+			opts := &showAppOpts{
+				showAppVars: showAppVars{asOfCommit: "v1.2.0"},
+				ws:          mockWs,
+				git:         mockGit,
+			}
+
+			// WHEN
+			err := opts.validateAsOfCommit()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCommit, opts.wsCommit)
+			require.Equal(t, &commitWorkspace{git: mockGit, dir: "/ws", commit: tc.wantedCommit}, opts.wsSvcs)
+		})
+	}
+}
+
+func TestShowAppOpts_ValidateExclusiveMode(t *testing.T) {
+	set := func(t *testing.T, o *showAppOpts, flag string) {
+		switch flag {
+		case outputFlag:
+			o.outputFormat = appShowOutputCSV
+		case jsonFlag:
+			o.shouldOutputJSON = true
+		case explainFlag:
+			o.shouldExplain = true
+		case onlyFailingFlag:
+			o.shouldOnlyFailing = true
+		case includeTemplatesFlag:
+			o.includeTemplates = true
+		case dashboardFlag:
+			o.shouldShowDashboard = true
+		case validateOnlyFlag:
+			o.shouldValidateOnly = true
+		case outputTemplateFileFlag:
+			o.outputTemplateFile = "show.tmpl"
+		case diffBaselineFlag:
+			o.diffBaseline = "baseline.json"
+		case existsFlag:
+			o.shouldCheckExists = true
+		case listOnlyFlag:
+			o.shouldListOnly = true
+		case compareEnvFlag:
+			o.compareEnvs = []string{"test", "prod"}
+		case promotionCheckFlag:
+			o.promotionCheck = []string{"test", "prod"}
+		case doctorFlag:
+			o.shouldRunDoctor = true
+		case countOnlyFlag:
+			o.shouldCountOnly = true
+		case watchFlag:
+			o.shouldWatch = true
+		case strictFlag:
+			o.isStrict = true
+		case failOnFlag:
+			o.failOn = describe.WarningSeverityError
+		case clipboardFlag:
+			o.shouldCopy = true
+		case pageFlag:
+			o.shouldPage = true
+		case strictJSONFlag:
+			o.isStrictJSON = true
+		case jsonSchemaFlag:
+			o.shouldPrintSchema = true
+		default:
+			t.Fatalf("unexpected flag %s", flag)
+		}
+	}
+	for _, mode := range []appShowExclusiveMode{
+		appShowDoctorMode,
+		appShowCountOnlyMode,
+		appShowWatchMode,
+		appShowStrictJSONMode,
+		appShowDiffBaselineMode,
+		appShowPromotionCheckMode,
+		appShowJSONSchemaMode,
+		appShowDashboardMode,
+		appShowValidateOnlyMode,
+		appShowOutputTemplateFileMode,
+		appShowCompareEnvsMode,
+		appShowExistsMode,
+		appShowOutputTargetsMode,
+	} {
+		t.Run(mode.flag, func(t *testing.T) {
+			o := &showAppOpts{}
+			set(t, o, mode.flag)
+			require.NoError(t, o.validateExclusiveMode(mode))
+			for _, format := range mode.outputs {
+				o.outputFormat = format
+				require.NoError(t, o.validateExclusiveMode(mode), "expected --%s to be written in --%s %s", mode.flag, outputFlag, format)
+			}
+
+			for _, flag := range mode.conflicts {
+				o := &showAppOpts{}
+				set(t, o, mode.flag)
+				set(t, o, flag)
+				require.EqualError(t, o.validateExclusiveMode(mode), fmt.Sprintf("--%s and --%s cannot be specified together", mode.flag, flag))
+			}
+		})
+	}
+}
@@ -5,15 +5,19 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // tryReadingAppName retrieves the application's name from the workspace if it exists and returns it.
@@ -31,6 +35,51 @@ func tryReadingAppName() string {
 	return summary.Application
 }
 
+// envFlagDefault is an environment variable that provides the default value of a flag.
+type envFlagDefault struct {
+	envVar string
+	flag   string
+	// value converts the value of the environment variable to the value of the flag.
+	// If it's nil, the value of the environment variable is used as is.
+	value func(envValue string) (string, error)
+}
+
+// defaultFlagsFromEnv sets the flags that are not specified on the command line from their environment variables.
+// Flags specified on the command line take precedence over environment variables,
+// which take precedence over the built-in default values of the flags.
+func defaultFlagsFromEnv(flags *pflag.FlagSet, lookupEnv func(key string) (string, bool), defaults []envFlagDefault) error {
+	for _, d := range defaults {
+		if flags.Changed(d.flag) {
+			continue
+		}
+		envValue, ok := lookupEnv(d.envVar)
+		if !ok || envValue == "" {
+			continue
+		}
+		value := envValue
+		if d.value != nil {
+			var err error
+			if value, err = d.value(envValue); err != nil {
+				return fmt.Errorf("environment variable %s: %w", d.envVar, err)
+			}
+		}
+		if err := flags.Set(d.flag, value); err != nil {
+			return fmt.Errorf("set --%s from environment variable %s: %w", d.flag, d.envVar, err)
+		}
+	}
+	return nil
+}
+
+// ErrSilentExit is returned by commands that already reported their outcome and only need to exit with Code.
+// It shouldn't be logged.
+type ErrSilentExit struct {
+	Code int
+}
+
+func (e *ErrSilentExit) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
 type errReservedArg struct {
 	val string
 }
@@ -62,6 +111,23 @@ func runCmdE(f func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Co
 	}
 }
 
+// interruptContext returns a context that is canceled on the first interrupt or termination signal.
+// The signals are handled as usual again once the context is canceled or stop is called.
+func interruptContext() (ctx context.Context, stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signals)
+	}()
+	return ctx, cancel
+}
+
 // returns true if error type is stack set not exist.
 func isStackSetNotExistsErr(err error) bool {
 	if err == nil {
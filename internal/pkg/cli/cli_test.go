@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultFlagsFromEnv(t *testing.T) {
+	defaults := []envFlagDefault{
+		{
+			envVar: "COPILOT_OUTPUT",
+			flag:   "json",
+			value: func(output string) (string, error) {
+				if output != "json" {
+					return "", errors.New("unsupported output")
+				}
+				return "true", nil
+			},
+		},
+		{
+			envVar: "COPILOT_APP",
+			flag:   "name",
+		},
+	}
+	testCases := map[string]struct {
+		inArgs []string
+		inEnv  map[string]string
+
+		wantedJSON  bool
+		wantedName  string
+		wantedError error
+	}{
+		"keeps the built-in defaults if no variable is set": {
+			wantedName: "workspace-app",
+		},
+		"uses the environment variables as defaults": {
+			inEnv: map[string]string{
+				"COPILOT_OUTPUT": "json",
+				"COPILOT_APP":    "my-app",
+			},
+
+			wantedJSON: true,
+			wantedName: "my-app",
+		},
+		"flags on the command line take precedence": {
+			inArgs: []string{"--name", "other-app"},
+			inEnv: map[string]string{
+				"COPILOT_APP": "my-app",
+			},
+
+			wantedName: "other-app",
+		},
+		"ignores empty variables": {
+			inEnv: map[string]string{
+				"COPILOT_APP": "",
+			},
+
+			wantedName: "workspace-app",
+		},
+		"errors if the variable can't be converted": {
+			inEnv: map[string]string{
+				"COPILOT_OUTPUT": "yaml",
+			},
+
+			wantedError: errors.New("environment variable COPILOT_OUTPUT: unsupported output"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var json bool
+			var appName string
+			flags := pflag.NewFlagSet("show", pflag.ContinueOnError)
+			flags.BoolVar(&json, "json", false, "")
+			flags.StringVar(&appName, "name", "workspace-app", "")
+			require.NoError(t, flags.Parse(tc.inArgs))
+
+			// WHEN
+			err := defaultFlagsFromEnv(flags, func(key string) (string, bool) {
+				v, ok := tc.inEnv[key]
+				return v, ok
+			}, defaults)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+			require.Equal(t, tc.wantedName, appName)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"io"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultMaxConcurrency is the maximum number of in-flight calls when a command describes resources concurrently.
+const defaultMaxConcurrency = 5
+
+// forEachConcurrently calls fn for each index in [0, n) with at most limit calls running at the same time.
+// It waits for all the calls to return and returns the first non-nil error, if any.
+// Callers that collect results should write them to the index they're called with to keep a stable order.
+func forEachConcurrently(n, limit int, fn func(i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+	sem := make(chan struct{}, limit)
+	var g errgroup.Group
+	for i := 0; i < n; i++ {
+		i := i
+		sem <- struct{}{}
+		g.Go(func() error {
+			defer func() { <-sem }()
+			return fn(i)
+		})
+	}
+	return g.Wait()
+}
+
+// adaptiveLimiter bounds the number of calls in flight, and halves the bound every time more calls were throttled,
+// down to one call at a time. The throttled calls themselves are retried with a backoff by the SDK.
+type adaptiveLimiter struct {
+	mu        sync.Mutex
+	cond      *sync.Cond
+	limit     int
+	inFlight  int
+	throttled func() int // Number of throttled calls so far.
+	seen      int        // Number of throttled calls that the limit was last adapted to.
+}
+
+// newAdaptiveLimiter returns a limiter that allows limit calls in flight until the calls are throttled.
+func newAdaptiveLimiter(limit int, throttled func() int) *adaptiveLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+	l := &adaptiveLimiter{
+		limit:     limit,
+		throttled: throttled,
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// newFixedLimiter returns a limiter that always allows limit calls in flight, even if the calls are throttled.
+func newFixedLimiter(limit int) *adaptiveLimiter {
+	return newAdaptiveLimiter(limit, func() int { return 0 })
+}
+
+// Limit returns the current number of calls allowed in flight, and the number of throttled calls it's adapted to.
+func (l *adaptiveLimiter) Limit() (limit, throttled int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit, l.seen
+}
+
+func (l *adaptiveLimiter) acquire() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.adapt()
+	for l.inFlight >= l.limit {
+		l.cond.Wait()
+	}
+	l.inFlight++
+}
+
+func (l *adaptiveLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	l.adapt()
+	l.cond.Broadcast()
+}
+
+// adapt halves the limit if calls were throttled since it was last adapted. It must be called with mu held.
+func (l *adaptiveLimiter) adapt() {
+	throttled := l.throttled()
+	if throttled <= l.seen {
+		return
+	}
+	l.seen = throttled
+	if l.limit > 1 {
+		l.limit /= 2
+	}
+}
+
+// forEachLimited calls fn for each index in [0, n) with at most the limit of the limiter calls running at the same time.
+// Like forEachConcurrently, it waits for all the calls to return and returns the first non-nil error, if any.
+func forEachLimited(n int, limiter *adaptiveLimiter, fn func(i int) error) error {
+	var g errgroup.Group
+	for i := 0; i < n; i++ {
+		i := i
+		limiter.acquire()
+		g.Go(func() error {
+			defer limiter.release()
+			return fn(i)
+		})
+	}
+	return g.Wait()
+}
+
+// syncWriter serializes the writes to a writer with a mutex, so that the writes of concurrent goroutines are never
+// interleaved. Writers that share a mutex, like the ones of stdout and stderr on the same terminal, are serialized
+// with each other. Each write is atomic, so a message must be written in a single call to not be torn.
+type syncWriter struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+// newSyncWriter returns a writer to w whose writes are serialized with mu.
+func newSyncWriter(w io.Writer, mu *sync.Mutex) *syncWriter {
+	return &syncWriter{
+		mu: mu,
+		w:  w,
+	}
+}
+
+// Write writes p to the underlying writer while holding the mutex.
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	t.Run("calls fn for every index with at most limit calls in flight", func(t *testing.T) {
+		// GIVEN
+		var mu sync.Mutex
+		inFlight, maxInFlight := 0, 0
+		results := make([]int, 10)
+
+		// WHEN
+		err := forEachConcurrently(len(results), 3, func(i int) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			results[i] = i * i
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxInFlight, 3)
+		require.Equal(t, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}, results)
+	})
+	t.Run("returns an error if any call fails", func(t *testing.T) {
+		// WHEN
+		err := forEachConcurrently(5, 2, func(i int) error {
+			if i == 3 {
+				return errors.New("some error")
+			}
+			return nil
+		})
+
+		// THEN
+		require.EqualError(t, err, "some error")
+	})
+	t.Run("runs calls serially if limit is smaller than one", func(t *testing.T) {
+		// GIVEN
+		var order []int
+
+		// WHEN
+		err := forEachConcurrently(3, 0, func(i int) error {
+			order = append(order, i)
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 2}, order)
+	})
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	t.Run("halves the limit every time more calls were throttled", func(t *testing.T) {
+		// GIVEN
+		throttled := 0
+		limiter := newAdaptiveLimiter(8, func() int { return throttled })
+
+		// WHEN
+		limiter.acquire()
+		limiter.release()
+		limit, seen := limiter.Limit()
+
+		// THEN
+		require.Equal(t, 8, limit)
+		require.Equal(t, 0, seen)
+
+		// WHEN
+		throttled = 3
+		limiter.acquire()
+		limiter.release()
+		limit, seen = limiter.Limit()
+
+		// THEN
+		require.Equal(t, 4, limit)
+		require.Equal(t, 3, seen)
+
+		// WHEN
+		for i := 0; i < 5; i++ {
+			throttled++
+			limiter.acquire()
+			limiter.release()
+		}
+		limit, _ = limiter.Limit()
+
+		// THEN
+		require.Equal(t, 1, limit, "expected the limit to not drop below one")
+	})
+	t.Run("calls fn for every index with at most limit calls in flight", func(t *testing.T) {
+		// GIVEN
+		var mu sync.Mutex
+		inFlight, maxInFlight := 0, 0
+		results := make([]int, 10)
+		limiter := newAdaptiveLimiter(2, func() int { return 0 })
+
+		// WHEN
+		err := forEachLimited(len(results), limiter, func(i int) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			results[i] = i * 2
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxInFlight, 2)
+		require.Equal(t, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}, results)
+	})
+}
+
+// byteByByteWriter writes one byte at a time and yields in between, so that unsynchronized concurrent writes interleave.
+type byteByByteWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *byteByByteWriter) Write(p []byte) (int, error) {
+	for _, c := range p {
+		w.buf.WriteByte(c)
+		runtime.Gosched()
+	}
+	return len(p), nil
+}
+
+func TestSyncWriter(t *testing.T) {
+	// GIVEN
+	var mu sync.Mutex
+	underlying := &byteByByteWriter{}
+	stdout, stderr := newSyncWriter(underlying, &mu), newSyncWriter(underlying, &mu)
+	var wg sync.WaitGroup
+
+	// WHEN
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			fmt.Fprintf(stdout, "output line %d\n", i)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			fmt.Fprintf(stderr, "diagnostic line %d\n", i)
+		}(i)
+	}
+	wg.Wait()
+
+	// THEN
+	lines := strings.Split(strings.TrimSuffix(underlying.buf.String(), "\n"), "\n")
+	require.Len(t, lines, 100)
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		seen[line] = true
+	}
+	for i := 0; i < 50; i++ {
+		require.True(t, seen[fmt.Sprintf("output line %d", i)], "output line %d is torn", i)
+		require.True(t, seen[fmt.Sprintf("diagnostic line %d", i)], "diagnostic line %d is torn", i)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -23,8 +25,9 @@ const (
 )
 
 type listEnvVars struct {
-	appName          string
-	shouldOutputJSON bool
+	appName            string
+	shouldOutputJSON   bool
+	shouldShowDetailed bool
 }
 
 type listEnvOpts struct {
@@ -33,6 +36,8 @@ type listEnvOpts struct {
 	prompt prompter
 	sel    configSelector
 
+	newEnvDescriber func(envName string) (envDescriber, error) // Overriden in tests.
+
 	w io.Writer
 }
 
@@ -42,14 +47,32 @@ func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 		return nil, err
 	}
 
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+
 	prompter := prompt.New()
-	return &listEnvOpts{
+	opts := &listEnvOpts{
 		listEnvVars: vars,
 		store:       store,
 		sel:         selector.NewConfigSelect(prompter, store),
 		prompt:      prompter,
 		w:           os.Stdout,
-	}, nil
+	}
+	opts.newEnvDescriber = func(envName string) (envDescriber, error) {
+		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         envName,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating describer for environment %s in application %s: %w", envName, opts.appName, err)
+		}
+		return d, nil
+	}
+	return opts, nil
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -76,6 +99,9 @@ func (o *listEnvOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.shouldShowDetailed {
+		return o.executeDetailed(envs)
+	}
 
 	var out string
 	if o.shouldOutputJSON {
@@ -92,6 +118,38 @@ func (o *listEnvOpts) Execute() error {
 	return nil
 }
 
+// executeDetailed describes all the environments concurrently and writes a description of each one.
+func (o *listEnvOpts) executeDetailed(envs []*config.Environment) error {
+	list := &describe.EnvList{
+		Environments: make([]*describe.EnvDescription, len(envs)),
+	}
+	err := forEachConcurrently(len(envs), defaultMaxConcurrency, func(i int) error {
+		d, err := o.newEnvDescriber(envs[i].Name)
+		if err != nil {
+			return err
+		}
+		env, err := d.Describe()
+		if err != nil {
+			return fmt.Errorf("describe environment %s: %w", envs[i].Name, err)
+		}
+		list.Environments[i] = env
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !o.shouldOutputJSON {
+		fmt.Fprint(o.w, list.HumanString())
+		return nil
+	}
+	data, err := list.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
 func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
 	b := &strings.Builder{}
 	for _, env := range envs {
@@ -123,7 +181,9 @@ func buildEnvListCmd() *cobra.Command {
 		Short: "Lists all the environments in an application.",
 		Example: `
   Lists all the environments for the frontend application.
-  /code $ copilot env ls -a frontend`,
+  /code $ copilot env ls -a frontend
+  Lists the region, account and number of deployed services of each environment.
+  /code $ copilot env ls -a frontend --detailed`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListEnvOpts(vars)
 			if err != nil {
@@ -137,5 +197,6 @@ func buildEnvListCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowDetailed, detailedFlag, false, envDetailedFlagDescription)
 	return cmd
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -201,3 +202,102 @@ func TestEnvList_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvList_ExecuteDetailed(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(store *mocks.Mockstore, describers map[string]*mocks.MockenvDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"with detailed envs": {
+			setupMocks: func(store *mocks.Mockstore, describers map[string]*mocks.MockenvDescriber) {
+				store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				store.EXPECT().ListEnvironments("coolapp").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				describers["test"].EXPECT().Describe().Return(&describe.EnvDescription{
+					Environment: &config.Environment{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+					Services: []*config.Workload{
+						{Name: "frontend"},
+					},
+				}, nil)
+				describers["prod"].EXPECT().Describe().Return(&describe.EnvDescription{
+					Environment: &config.Environment{Name: "prod", Region: "us-east-1", AccountID: "210987654321", Prod: true},
+				}, nil)
+			},
+			wantedContent: `Name                Production          Region              Account ID          Services
+----                ----------          ------              ----------          --------
+test                false               us-west-2           123456789012        1
+prod                true                us-east-1           210987654321        0
+`,
+		},
+		"with detailed json envs": {
+			shouldOutputJSON: true,
+			setupMocks: func(store *mocks.Mockstore, describers map[string]*mocks.MockenvDescriber) {
+				store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				store.EXPECT().ListEnvironments("coolapp").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				describers["test"].EXPECT().Describe().Return(&describe.EnvDescription{
+					Environment: &config.Environment{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+					Services: []*config.Workload{
+						{Name: "frontend", Type: "Load Balanced Web Service"},
+					},
+				}, nil)
+			},
+			wantedContent: `{"environments":[{"environment":{"app":"","name":"test","region":"us-west-2","accountID":"123456789012","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""},"services":[{"app":"","name":"frontend","type":"Load Balanced Web Service"}]}]}` + "\n",
+		},
+		"with failed call to describe an environment": {
+			setupMocks: func(store *mocks.Mockstore, describers map[string]*mocks.MockenvDescriber) {
+				store.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				store.EXPECT().ListEnvironments("coolapp").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				describers["test"].EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe environment test: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			describers := map[string]*mocks.MockenvDescriber{
+				"test": mocks.NewMockenvDescriber(ctrl),
+				"prod": mocks.NewMockenvDescriber(ctrl),
+			}
+			tc.setupMocks(mockStore, describers)
+			b := &bytes.Buffer{}
+			opts := &listEnvOpts{
+				listEnvVars: listEnvVars{
+					appName:            "coolapp",
+					shouldOutputJSON:   tc.shouldOutputJSON,
+					shouldShowDetailed: true,
+				},
+				store: mockStore,
+				newEnvDescriber: func(envName string) (envDescriber, error) {
+					return describers[envName], nil
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
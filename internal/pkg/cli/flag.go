@@ -23,6 +23,7 @@ const (
 	typeFlag     = "type"
 	profileFlag  = "profile"
 	yesFlag      = "yes"
+	noFlag       = "no"
 	jsonFlag     = "json"
 	allFlag      = "all"
 
@@ -49,6 +50,81 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	showSecretsFlag       = "show-secrets"
+	profileFromEnvFlag    = "profile-from-env"
+	strictFlag            = "strict"
+	strictJSONFlag        = "strict-json"
+	detailedFlag          = "detailed"
+	listOnlyFlag          = "list-only"
+	existsFlag            = "exists"
+	explainFlag           = "explain"
+	clipboardFlag         = "clipboard"
+	pageFlag              = "page"
+	onlyFailingFlag       = "only-failing"
+	noColorFlag           = "no-color"
+	compareEnvFlag        = "compare-env"
+	awsConfigFlag         = "aws-config"
+	credentialsFileFlag   = "credentials-file"
+	caBundleFlag          = "ca-bundle"
+	maxRetriesFlag        = "max-retries"
+	storeRegionFlag       = "store-region"
+	storeEndpointFlag     = "store-endpoint"
+	retryBaseDelayFlag    = "retry-base-delay"
+	retryOnEmptyFlag      = "retry-on-empty"
+	serialFlag            = "serial"
+	parallelEnvsFlag      = "parallel-envs"
+	benchmarkFlag         = "benchmark"
+	fullFlag              = "full"
+	includeTemplatesFlag  = "include-templates"
+	templatesDirFlag      = "templates-dir"
+	failOnFlag            = "fail-on"
+	auditCallsFlag        = "audit-calls"
+	noLegendFlag          = "no-legend"
+	noHintsFlag           = "no-hints"
+	outputFlag            = "output"
+	showTagsFlag          = "show-tags"
+	verboseFlag           = "verbose"
+	showDeployersFlag     = "show-deployers"
+	showLoggingFlag       = "show-logging"
+	dashboardFlag         = "dashboard"
+	redactFlag            = "redact"
+	validateOnlyFlag      = "validate-only"
+	noPipelinesFlag       = "no-pipelines"
+	promotionCheckFlag    = "promotion-check"
+	pipelineSourceFlag    = "pipeline-source"
+	maxWidthFlag          = "max-width"
+	prettyFlag            = "pretty"
+	auditLogFlag          = "audit-log"
+	includeJobRunsFlag    = "include-jobs-runs"
+	ownerTagKeyFlag       = "owner-tag-key"
+	sortEnvsFlag          = "sort-envs"
+	checkTopologyFlag     = "check-topology"
+	checkDriftFlag        = "check-drift"
+	sinceLastDeployFlag   = "since-last-deploy"
+	showCostControlsFlag  = "show-cost-controls"
+	refreshCacheFlag      = "refresh-cache"
+	completionHintFlag    = "completion-hint"
+	stackSetFlag          = "stackset"
+	diffBaselineFlag      = "diff-baseline"
+	firstFlag             = "first"
+	doctorFlag            = "doctor"
+	countOnlyFlag         = "count-only"
+	jsonSchemaFlag        = "json-schema"
+	omitFlag              = "omit"
+	teeFlag               = "tee"
+	errorsToFlag          = "errors-to"
+	stackFallbackFlag     = "allow-stack-fallback"
+	asOfCommitFlag        = "as-of-commit"
+	healthScoreFlag       = "health-score"
+	healthWeightsFlag     = "health-weights"
+	contextFlag           = "context"
+	fromPipelineFlag      = "from-pipeline"
+	formatVersionFlag     = "format-version"
+	watchFlag             = "watch"
+	watchIntervalFlag     = "watch-interval"
+	watchDiffFlag         = "diff"
+
+	outputTemplateFileFlag = "output-template-file"
 
 	storageTypeFlag         = "storage-type"
 	storagePartitionKeyFlag = "partition-key"
@@ -176,10 +252,163 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
+	appResourcesFlagDescription      = "Optional. Show the resources of the services in your application."
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	showSecretsFlagDescription       = `Optional. Show the names and sources of the secrets referenced by each service.
+Secret values are never retrieved.`
+	envDetailedFlagDescription = "Optional. Show the region, account and number of deployed services of each environment."
+	noColorFlagDescription     = "Optional. Disable colored output."
+	appListOnlyFlagDescription = "Optional. Print the applications that can be selected as a JSON array instead of prompting."
+	appExistsFlagDescription   = `Optional. Print nothing and exit with 0 if the application exists, 2 if it doesn't, or 1 on errors.
+The application must be named exactly with --name.`
+	appExplainFlagDescription     = "Optional. Annotate each value with the AWS resource it is retrieved from."
+	appClipboardFlagDescription   = "Optional. Also copy the output to the system clipboard."
+	appOnlyFailingFlagDescription = `Optional. Only show the environments and services with a warning or a failed status.
+Pipelines and secrets are omitted.`
+	appBenchmarkFlagDescription = "Optional. Print the time spent in each phase of the command to stderr."
+	appAWSConfigFlagDescription = `Optional. Path to the AWS shared config file to use instead of the default location.
+Defaults to $AWS_CONFIG_FILE if it's set.`
+	appCredentialsFileFlagDescription = `Optional. Path to a json file with the AccessKeyId, SecretAccessKey and optional SessionToken
+and Expiration to use instead of the default credential chain, like the temporary credentials of a credential broker.`
+	appCABundleFlagDescription = `Optional. Path to a PEM file with the certificate authorities to trust instead of the ones of the system,
+like the authority of a corporate proxy. Takes precedence over $AWS_CA_BUNDLE.
+The proxy is configured with the HTTPS_PROXY and NO_PROXY environment variables.`
+	appStoreRegionFlagDescription = `Optional. Region of the config store to read the application from, like a replica in a secondary region.
+Defaults to the region of your default profile. The resources of each environment are always read in its own region.`
+	appStoreEndpointFlagDescription = `Optional. URL of an SSM-compatible endpoint to read the config store from instead of SSM, like LocalStack.
+Defaults to $COPILOT_STORE_ENDPOINT if it's set.`
+	appMaxRetriesFlagDescription     = "Optional. Maximum number of times a failed AWS API call is retried. 0 disables the retries."
+	appRetryBaseDelayFlagDescription = `Optional. Delay before the first retry of a failed AWS API call, doubled at each retry.
+Throttled calls wait at least 500ms.`
+	appRetryOnEmptyFlagDescription = `Optional. Number of times to list the environments and services of the application again, 2s apart,
+while either list is empty, like right after app init or env init. 0 disables the retries.`
+	appSerialFlagDescription = `Optional. Make the AWS API calls one at a time, like on CI runners that are throttled.
+Cannot be specified with --parallel-envs.`
+	appParallelEnvsFlagDescription = `Optional. Number of environments and services to describe at the same time, without backing off when throttled.
+With 0, the default, up to 5 are described at the same time, halved every time the calls are throttled.`
+	appFullFlagDescription = `Optional. Show the full value of every cell instead of truncating the tables to the width of the terminal.
+The tables are truncated to 80 characters if the output is not a terminal.`
+	appIncludeTemplatesFlagDescription = `Optional. Write the deployed CloudFormation template of each stack of the application to --templates-dir.
+The templates are never included in the output.`
+	appTemplatesDirFlagDescription = "Optional. Directory to write the stack templates to with --include-templates."
+	appFailOnFlagDescription       = `Optional. Exit with an error if any warnings of this severity or higher are found.
+Must be one of "info", "warning" or "error".`
+	appAuditCallsFlagDescription = `Optional. Print the distinct AWS API operations and hosts called by the command to stderr.
+Only the operation names and hosts are recorded, never the request or response bodies.`
+	appNoLegendFlagDescription = "Optional. Omit the legend explaining the symbols and colors of the human readable output."
+	appNoHintsFlagDescription  = "Optional. Omit the recommended follow-up actions after the human readable output."
+	appOutputFlagDescription   = `Optional. Output format, one of "human", "json", "csv", "openmetrics", "lines", "markdown", "go", "mermaid" or "dotenv".
+The csv format has a row for each service deployed in each environment.
+The openmetrics format has the same timestamp for all the samples of one invocation.
+The lines format is a json array of summary lines, like "Envs: prod, staging".
+The markdown format has a GitHub-flavored Markdown table for the environments, services and pipelines.
+The go format is a describe.App Go composite literal to paste in table tests.
+The mermaid format is a Mermaid flowchart with a subgraph for each environment and a node for each service.
+The dotenv format is KEY=value lines of the metadata of the application, like APP_ENV_COUNT=2, to source in scripts.
+Repeat the flag as format=file to write several formats from a single description, with "-" for stdout.
+Any other format is rendered by the executable copilot-format-<format> in the plugins directory of the
+copilot configuration directory, which reads the json description on stdin and writes the output on stdout.`
+	appShowTagsFlagDescription = `Optional. Show the tags of the application and of the environment and service stacks.
+The tags of an environment or a service that are identical to the tags of the application are omitted.`
+	appVerboseFlagDescription = `Optional. Show the stages of each pipeline and the services deployed in each stage.
+The stages are always included in the json output.
+Also print the number of AWS API calls made by the command to each service to stderr.`
+	appDashboardFlagDescription = `Optional. Show the environments and the services deployed in them as a tree colored by health,
+under a banner that counts the healthy, degraded and failing deployments.`
+	appOutputTemplateFileFlagDescription = `Optional. Path to a Go template file to render the description of the application with,
+for example {{range .Envs}}{{.Name}} {{end}}. The fields are the ones of the json output, named as in Go.`
+	appValidateOnlyFlagDescription = `Optional. Only print the problems found in the deployed state of the application, like failed stacks,
+stacks of unknown services and pipelines deploying to unknown environments. Exits with an error if any is of error severity.`
+	appRedactFlagDescription = `Optional. Replace the account IDs in the output, including in ARNs, with tokens like account-0001.
+Each account ID has its own token, the same wherever it appears.`
+	appShowLoggingFlagDescription = `Optional. Show whether the services ship their logs, and the log group and its retention.
+Services whose main container has no log configuration are flagged with a warning.`
+	appShowDeployersFlagDescription = `Optional. Show who or what last deployed to each environment, from the CloudTrail event history.
+The deployer is "unknown" if no stack of the environment was deployed in the last 90 days.`
+	appMaxWidthFlagDescription = `Optional. Truncate the tables to this number of columns instead of the detected width of the terminal.
+Set it to 0 to never truncate the tables.`
+	appAuditLogFlagDescription = `Optional. File to append a json event recording who described which application to, or "-" for stderr.
+Defaults to $COPILOT_AUDIT_LOG if it's set. Failures to write the event never fail the command.`
+	appIncludeJobRunsFlagDescription = `Optional. Show the outcomes of the recent runs of the jobs in each environment,
+up to the last 5 runs of the past 7 days, from the executions of their state machines.`
+	appOwnerTagKeyFlagDescription = `Optional. Key of the application tag to read the owner of the application from.
+The owner is "unowned" if the application doesn't have the tag.`
+	appFormatVersionFlagDescription = `Optional. Version of the layout of the output, so that parsers keep working after a CLI upgrade.
+Defaults to the latest version. The fields and columns of each format don't change within a version.`
+	appSortEnvsFlagDescription = `Optional. Order of the environments in the human readable output, "name", "recency" or "prod".
+recency lists the most recently deployed environments first, and prod lists the production environments first.
+The json output always lists the environments in the order of the config store.`
+	appCheckDriftFlagDescription = `Optional. Compare the manifest of each service in the workspace, with the overrides of each environment,
+with the deployed task definition of the service, and flag the fields that differ with a warning.`
+	appSinceLastDeployFlagDescription = `Optional. List the fields that the last deployment of each service changed, like its images and variables,
+from the differences between its active task definition and the previous revision. Unchanged services are omitted.`
+	appShowCostControlsFlagDescription = `Optional. List the budgets and the cost anomaly monitors filtered on the copilot-application tag,
+with the spend of the current period against the limit of each budget. The tag must be activated as a cost allocation tag.`
+	appCheckTopologyFlagDescription = `Optional. Note when the environments of the application span more than 3 regions or several continents,
+which adds latency between them. The notes are info warnings.`
+	appRefreshCacheFlagDescription = `Optional. List the applications from the config store to select from rather than from the cache,
+and cache them again. The cache of the application names expires after 5 minutes.`
+	appCompletionHintFlagDescription = `Optional. Suggest the closest application names if --name doesn't match any application,
+like "did you mean 'payments'?". The error is the same either way.`
+	appStackSetFlagDescription = `Optional. Name of a CloudFormation stack set that deployed the application across accounts.
+Its stack instances in the accounts and regions of no environment of the config store are shown as extra environments,
+marked as stack set instances, without looking up their deployments.`
+	appAssumeYesFlagDescription = `Optional. Answer yes to the confirmation prompts without asking.
+The other prompts fail rather than wait for an input, like the selection of an application without --name.`
+	appAssumeNoFlagDescription = `Optional. Answer no to the confirmation prompts without asking.
+The other prompts fail rather than wait for an input, like the selection of an application without --name.`
+	appDiffBaselineFlagDescription = `Optional. Path to a snapshot of the json output of app show to compare the application with.
+Only the fields that differ from the snapshot are printed, and the command exits with an error if any differ.`
+	appFirstFlagDescription = `Optional. Without --name, select the only application instead of prompting,
+and exit with an error if there are none or several.`
+	appOmitFlagDescription = `Optional. Comma-separated paths of the fields to leave out of the json output, like "secrets,deployments.storage".
+A dotted path omits the field from each element of a list. Unknown paths are ignored with a warning.`
+	appTeeFlagDescription = `Optional. File to append a copy of the output written to stdout to, in the same format and with the same colors.
+The file is opened before describing the application, and the command fails if it can't be opened.`
+	appErrorsToFlagDescription = `Optional. File to append the errors, warnings and other diagnostics to instead of stderr, like /dev/fd/3,
+so that stdout only carries the output. The file is opened before describing the application, and the command fails if it can't be opened.`
+	appStackFallbackFlagDescription = `Optional. If the config store keeps failing with a transient error, like throttling, list the environments
+and services of the application from the stacks tagged with it in the region of your credentials instead of failing.`
+	appAsOfCommitFlagDescription = `Optional. Git ref, like a commit SHA, a tag or a branch, to read the manifests of the workspace from
+instead of the working tree, for the services that are only in the workspace and --check-drift. The deployed state is still live.`
+	appHealthScoreFlagDescription = `Optional. Score the health of the application from 0 to 100, from its deployments, stacks,
+the last builds of its pipelines and its certificates.`
+	appHealthWeightsFlagDescription = `Optional. Path to a YAML file with the weights of the services, stacks, pipelines and certificates
+in the health score, like "services: 50". The weights that aren't set keep their defaults. Implies --health-score.`
+	appContextFlagDescription = `Optional. Name of a context, a saved profile, region and config store to describe the application with,
+of the copilot/contexts.yml file of your configuration directory, like ~/.config. --store-region and --store-endpoint take precedence.`
+	appFromPipelineFlagDescription = `Optional. Name of a pipeline to describe the application it was created for instead of --name,
+from the copilot-application tag of the pipeline. The pipeline is looked up in the region of your credentials.`
+	appDoctorFlagDescription = `Optional. Check what app show needs instead of describing the application: the credentials, the clock,
+the config store and, with --name, the permissions to list the stacks of each environment. Exits with an error if any check fails.`
+	appCountOnlyFlagDescription = `Optional. Only print the number of environments, services by type, jobs, pipelines and healthy and unhealthy deployments.
+Only the stacks of the environments are listed, so it's much faster than describing the application.`
+	appWatchFlagDescription = `Optional. Describe the application again every --watch-interval until interrupted,
+rendering each refresh in place of the previous one. Only the human readable output can be watched.`
+	appWatchIntervalFlagDescription = "Optional. How long to wait between the refreshes of --watch."
+	appWatchDiffFlagDescription     = `Optional. With --watch, highlight the lines that changed since the previous refresh,
+and list the fields of the description that changed after it.`
+	appJSONSchemaFlagDescription = `Optional. Print the JSON Schema of the json output for --format-version instead of describing the application,
+to validate the output or generate client types from it.`
+	appPrettyFlagDescription = `Optional. Indent the json output over several lines for humans to read it.
+The json output is compact on a single line otherwise, like for piping it to other tools.`
+	appPipelineSourceFlagDescription = `Optional. Where to read the pipelines of the application from, "codepipeline" or "github-actions".
+The github-actions pipelines are the workflows recorded in the copilot-github-workflow tag of the stacks they deployed.`
+	appNoPipelinesFlagDescription = "Optional. Skip the lookup of the pipelines of the application, which is often the slowest."
+	appCompareEnvFlagDescription  = `Optional. Compare the services deployed in two environments of the application.
+For example: --compare-env test,prod`
+	appPromotionCheckFlagDescription = `Optional. Check that the second of two environments is ready for the services of the first to be promoted to it:
+it exists, none of its stacks failed or are being deployed, and its template is at least as recent. For example: --promotion-check staging,prod`
+	appPageFlagDescription = `Optional. Display the output one screen at a time with $PAGER, or a built-in pager if it's not set.
+Ignored with --json or if the output is not a terminal.`
+	appStrictJSONFlagDescription = `Optional. Output in JSON format, or exit with an error if any data couldn't be retrieved,
+like an unknown environment status. The fields and errors that are incomplete are written to stderr as JSON instead.`
+	appStrictFlagDescription      = "Optional. Exit with an error if any warnings are found while describing the application."
+	profileFromEnvFlagDescription = `Optional. Path to a JSON or YAML file mapping environment names to named profiles.
+Environments that are not in the file are described with the default credentials.`
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
@@ -3,8 +3,16 @@
 
 package cli
 
-import "github.com/aws/copilot-cli/internal/pkg/aws/identity"
+import (
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+)
 
 type identityService interface {
 	Get() (identity.Caller, error)
 }
+
+type serverClock interface {
+	ServerTime() (time.Time, error)
+}
@@ -6,10 +6,21 @@ package cli
 import (
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	awscodestar "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -203,6 +214,16 @@ type sessionProvider interface {
 	sessionFromStaticProvider
 }
 
+type callRecorder interface {
+	Calls() []sessions.APICall
+	ServiceCounts() map[string]int
+}
+
+type wsAddonsReader interface {
+	ReadAddonsDir(svcName string) ([]string, error)
+	ReadAddon(svcName, fileName string) ([]byte, error)
+}
+
 type describer interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
@@ -241,6 +262,15 @@ type wsSvcReader interface {
 	svcManifestReader
 }
 
+type stackSetInstanceLister interface {
+	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
+}
+
+type wsAppSvcReader interface {
+	wsSvcReader
+	Summary() (*workspace.Summary, error)
+}
+
 type wsSvcDirReader interface {
 	wsSvcReader
 	copilotDirGetter
@@ -408,12 +438,65 @@ type envTemplateUpgrader interface {
 	legacyEnvUpgrader
 }
 
+type stackLister interface {
+	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
+}
+
+type stackResourcesGetter interface {
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+}
+
+type stackDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+}
+
+type stackTemplateGetter interface {
+	TemplateBody(name string) (string, error)
+}
+
+type taskDefinitionGetter interface {
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+}
+
+type ecsServiceDescriber interface {
+	Service(clusterName, serviceName string) (*awsecs.Service, error)
+}
+
+type appRunnerServiceDescriber interface {
+	DescribeService(svcARN string) (*apprunner.Service, error)
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
 	GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error)
 }
 
+type pipelineBuildGetter interface {
+	LastBuild(pipeline *codepipeline.Pipeline) (*codepipeline.BuildExecution, error)
+}
+
+type pipelineTagsGetter interface {
+	PipelineTags(name string) (map[string]string, error)
+}
+
+type pipelineArtifactStoreGetter interface {
+	ArtifactStore(name string) (*codepipeline.ArtifactStore, error)
+}
+
+type bucketConfigGetter interface {
+	BucketEncryption(bucket string) (string, error)
+	BucketExpirationDays(bucket string) (*int64, error)
+}
+
+type budgetLister interface {
+	BudgetsWithTag(accountID, key, value string) ([]*budgets.Budget, error)
+}
+
+type anomalyMonitorLister interface {
+	AnomalyMonitorsWithTag(key, value string) ([]*costexplorer.AnomalyMonitor, error)
+}
+
 type executor interface {
 	Execute() error
 }
@@ -429,6 +512,51 @@ type executeAsker interface {
 
 type appSelector interface {
 	Application(prompt, help string, additionalOpts ...string) (string, error)
+	ApplicationFrom(prompt, help string, appNames []string) (string, error)
+}
+
+type appChoiceLister interface {
+	ApplicationChoices(additionalOpts ...string) ([]string, error)
+}
+
+type certificateDescriber interface {
+	CertificateExpiry(certARN string) (time.Time, error)
+}
+
+type webACLGetter interface {
+	WebACLForResource(resourceARN string) (string, error)
+}
+
+type stackDeploymentGetter interface {
+	LastStackDeployment(stack string) (*cloudtrail.StackDeployment, error)
+}
+
+type logGroupRetentionGetter interface {
+	LogGroupRetention(logGroup string) (int, error)
+}
+
+type alarmStatusGetter interface {
+	AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error)
+}
+
+type jobExecutionLister interface {
+	RecentExecutions(stateMachineARN string, since time.Time, max int) ([]stepfunctions.Execution, error)
+}
+
+type bucketObjectCounter interface {
+	BucketObjectCount(bucket string) (int64, error)
+}
+
+type clipboardWriter interface {
+	Copy(text string) error
+}
+
+type outputPager interface {
+	Page(text string) error
+}
+
+type connectionGetter interface {
+	GetConnection(connectionARN string) (*awscodestar.Connection, error)
 }
 
 type appEnvSelector interface {
@@ -8,6 +8,7 @@ import (
 	identity "github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockidentityService is a mock of identityService interface
@@ -47,3 +48,41 @@ func (mr *MockidentityServiceMockRecorder) Get() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockidentityService)(nil).Get))
 }
+
+// MockserverClock is a mock of serverClock interface
+type MockserverClock struct {
+	ctrl     *gomock.Controller
+	recorder *MockserverClockMockRecorder
+}
+
+// MockserverClockMockRecorder is the mock recorder for MockserverClock
+type MockserverClockMockRecorder struct {
+	mock *MockserverClock
+}
+
+// NewMockserverClock creates a new mock instance
+func NewMockserverClock(ctrl *gomock.Controller) *MockserverClock {
+	mock := &MockserverClock{ctrl: ctrl}
+	mock.recorder = &MockserverClockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserverClock) EXPECT() *MockserverClockMockRecorder {
+	return m.recorder
+}
+
+// ServerTime mocks base method
+func (m *MockserverClock) ServerTime() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerTime")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerTime indicates an expected call of ServerTime
+func (mr *MockserverClockMockRecorder) ServerTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerTime", reflect.TypeOf((*MockserverClock)(nil).ServerTime))
+}
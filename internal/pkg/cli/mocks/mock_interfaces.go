@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: ./interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,8 +7,18 @@ package mocks
 import (
 	encoding "encoding"
 	session "github.com/aws/aws-sdk-go/aws/session"
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	budgets "github.com/aws/copilot-cli/internal/pkg/aws/budgets"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	cloudtrail "github.com/aws/copilot-cli/internal/pkg/aws/cloudtrail"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	codestar "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
+	costexplorer "github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	sessions "github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -26,6 +36,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
+	time "time"
 )
 
 // MockactionCommand is a mock of actionCommand interface
@@ -1921,6 +1932,110 @@ func (mr *MocksessionProviderMockRecorder) FromStaticCreds(accessKeyID, secretAc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromStaticCreds", reflect.TypeOf((*MocksessionProvider)(nil).FromStaticCreds), accessKeyID, secretAccessKey, sessionToken)
 }
 
+// MockcallRecorder is a mock of callRecorder interface
+type MockcallRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockcallRecorderMockRecorder
+}
+
+// MockcallRecorderMockRecorder is the mock recorder for MockcallRecorder
+type MockcallRecorderMockRecorder struct {
+	mock *MockcallRecorder
+}
+
+// NewMockcallRecorder creates a new mock instance
+func NewMockcallRecorder(ctrl *gomock.Controller) *MockcallRecorder {
+	mock := &MockcallRecorder{ctrl: ctrl}
+	mock.recorder = &MockcallRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcallRecorder) EXPECT() *MockcallRecorderMockRecorder {
+	return m.recorder
+}
+
+// Calls mocks base method
+func (m *MockcallRecorder) Calls() []sessions.APICall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calls")
+	ret0, _ := ret[0].([]sessions.APICall)
+	return ret0
+}
+
+// Calls indicates an expected call of Calls
+func (mr *MockcallRecorderMockRecorder) Calls() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calls", reflect.TypeOf((*MockcallRecorder)(nil).Calls))
+}
+
+// ServiceCounts mocks base method
+func (m *MockcallRecorder) ServiceCounts() map[string]int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceCounts")
+	ret0, _ := ret[0].(map[string]int)
+	return ret0
+}
+
+// ServiceCounts indicates an expected call of ServiceCounts
+func (mr *MockcallRecorderMockRecorder) ServiceCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceCounts", reflect.TypeOf((*MockcallRecorder)(nil).ServiceCounts))
+}
+
+// MockwsAddonsReader is a mock of wsAddonsReader interface
+type MockwsAddonsReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAddonsReaderMockRecorder
+}
+
+// MockwsAddonsReaderMockRecorder is the mock recorder for MockwsAddonsReader
+type MockwsAddonsReaderMockRecorder struct {
+	mock *MockwsAddonsReader
+}
+
+// NewMockwsAddonsReader creates a new mock instance
+func NewMockwsAddonsReader(ctrl *gomock.Controller) *MockwsAddonsReader {
+	mock := &MockwsAddonsReader{ctrl: ctrl}
+	mock.recorder = &MockwsAddonsReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAddonsReader) EXPECT() *MockwsAddonsReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadAddonsDir mocks base method
+func (m *MockwsAddonsReader) ReadAddonsDir(svcName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddonsDir", svcName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddonsDir indicates an expected call of ReadAddonsDir
+func (mr *MockwsAddonsReaderMockRecorder) ReadAddonsDir(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonsDir", reflect.TypeOf((*MockwsAddonsReader)(nil).ReadAddonsDir), svcName)
+}
+
+// ReadAddon mocks base method
+func (m *MockwsAddonsReader) ReadAddon(svcName, fileName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddon", svcName, fileName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddon indicates an expected call of ReadAddon
+func (mr *MockwsAddonsReaderMockRecorder) ReadAddon(svcName, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddon", reflect.TypeOf((*MockwsAddonsReader)(nil).ReadAddon), svcName, fileName)
+}
+
 // Mockdescriber is a mock of describer interface
 type Mockdescriber struct {
 	ctrl     *gomock.Controller
@@ -2292,6 +2407,117 @@ func (mr *MockwsSvcReaderMockRecorder) ReadServiceManifest(svcName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceManifest), svcName)
 }
 
+// MockstackSetInstanceLister is a mock of stackSetInstanceLister interface
+type MockstackSetInstanceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackSetInstanceListerMockRecorder
+}
+
+// MockstackSetInstanceListerMockRecorder is the mock recorder for MockstackSetInstanceLister
+type MockstackSetInstanceListerMockRecorder struct {
+	mock *MockstackSetInstanceLister
+}
+
+// NewMockstackSetInstanceLister creates a new mock instance
+func NewMockstackSetInstanceLister(ctrl *gomock.Controller) *MockstackSetInstanceLister {
+	mock := &MockstackSetInstanceLister{ctrl: ctrl}
+	mock.recorder = &MockstackSetInstanceListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackSetInstanceLister) EXPECT() *MockstackSetInstanceListerMockRecorder {
+	return m.recorder
+}
+
+// InstanceSummaries mocks base method
+func (m *MockstackSetInstanceLister) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstanceSummaries", varargs...)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceSummaries indicates an expected call of InstanceSummaries
+func (mr *MockstackSetInstanceListerMockRecorder) InstanceSummaries(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceSummaries", reflect.TypeOf((*MockstackSetInstanceLister)(nil).InstanceSummaries), varargs...)
+}
+
+// MockwsAppSvcReader is a mock of wsAppSvcReader interface
+type MockwsAppSvcReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppSvcReaderMockRecorder
+}
+
+// MockwsAppSvcReaderMockRecorder is the mock recorder for MockwsAppSvcReader
+type MockwsAppSvcReaderMockRecorder struct {
+	mock *MockwsAppSvcReader
+}
+
+// NewMockwsAppSvcReader creates a new mock instance
+func NewMockwsAppSvcReader(ctrl *gomock.Controller) *MockwsAppSvcReader {
+	mock := &MockwsAppSvcReader{ctrl: ctrl}
+	mock.recorder = &MockwsAppSvcReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAppSvcReader) EXPECT() *MockwsAppSvcReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsAppSvcReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsAppSvcReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsAppSvcReader)(nil).ServiceNames))
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsAppSvcReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsAppSvcReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAppSvcReader)(nil).ReadServiceManifest), svcName)
+}
+
+// Summary mocks base method
+func (m *MockwsAppSvcReader) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary
+func (mr *MockwsAppSvcReaderMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppSvcReader)(nil).Summary))
+}
+
 // MockwsSvcDirReader is a mock of wsSvcDirReader interface
 type MockwsSvcDirReader struct {
 	ctrl     *gomock.Controller
@@ -4333,240 +4559,1184 @@ func (mr *MockenvTemplateUpgraderMockRecorder) EnvironmentTemplate(appName, envN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).EnvironmentTemplate), appName, envName)
 }
 
-// MockpipelineGetter is a mock of pipelineGetter interface
-type MockpipelineGetter struct {
+// MockstackLister is a mock of stackLister interface
+type MockstackLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineGetterMockRecorder
+	recorder *MockstackListerMockRecorder
 }
 
-// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter
-type MockpipelineGetterMockRecorder struct {
-	mock *MockpipelineGetter
+// MockstackListerMockRecorder is the mock recorder for MockstackLister
+type MockstackListerMockRecorder struct {
+	mock *MockstackLister
 }
 
-// NewMockpipelineGetter creates a new mock instance
-func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
-	mock := &MockpipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+// NewMockstackLister creates a new mock instance
+func NewMockstackLister(ctrl *gomock.Controller) *MockstackLister {
+	mock := &MockstackLister{ctrl: ctrl}
+	mock.recorder = &MockstackListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+func (m *MockstackLister) EXPECT() *MockstackListerMockRecorder {
 	return m.recorder
 }
 
-// GetPipeline mocks base method
-func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+// ListStacksWithTags mocks base method
+func (m *MockstackLister) ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
-	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "ListStacksWithTags", tags)
+	ret0, _ := ret[0].([]cloudformation.StackDescription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipeline indicates an expected call of GetPipeline
-func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+// ListStacksWithTags indicates an expected call of ListStacksWithTags
+func (mr *MockstackListerMockRecorder) ListStacksWithTags(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacksWithTags", reflect.TypeOf((*MockstackLister)(nil).ListStacksWithTags), tags)
 }
 
-// ListPipelineNamesByTags mocks base method
-func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockstackResourcesGetter is a mock of stackResourcesGetter interface
+type MockstackResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesGetterMockRecorder
 }
 
-// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags
-func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
+// MockstackResourcesGetterMockRecorder is the mock recorder for MockstackResourcesGetter
+type MockstackResourcesGetterMockRecorder struct {
+	mock *MockstackResourcesGetter
 }
 
-// GetPipelinesByTags mocks base method
-func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+// NewMockstackResourcesGetter creates a new mock instance
+func NewMockstackResourcesGetter(ctrl *gomock.Controller) *MockstackResourcesGetter {
+	mock := &MockstackResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackResourcesGetter) EXPECT() *MockstackResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method
+func (m *MockstackResourcesGetter) StackResources(name string) ([]*cloudformation.StackResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
-	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipelinesByTags indicates an expected call of GetPipelinesByTags
-func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+// StackResources indicates an expected call of StackResources
+func (mr *MockstackResourcesGetterMockRecorder) StackResources(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesGetter)(nil).StackResources), name)
 }
 
-// Mockexecutor is a mock of executor interface
-type Mockexecutor struct {
+// MockstackDescriber is a mock of stackDescriber interface
+type MockstackDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecutorMockRecorder
+	recorder *MockstackDescriberMockRecorder
 }
 
-// MockexecutorMockRecorder is the mock recorder for Mockexecutor
-type MockexecutorMockRecorder struct {
-	mock *Mockexecutor
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
 }
 
-// NewMockexecutor creates a new mock instance
-func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
-	mock := &Mockexecutor{ctrl: ctrl}
-	mock.recorder = &MockexecutorMockRecorder{mock}
+// NewMockstackDescriber creates a new mock instance
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method
-func (m *Mockexecutor) Execute() error {
+// Describe mocks base method
+func (m *MockstackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute
-func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+// Describe indicates an expected call of Describe
+func (mr *MockstackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), name)
 }
 
-// MockdeletePipelineRunner is a mock of deletePipelineRunner interface
-type MockdeletePipelineRunner struct {
+// MockstackTemplateGetter is a mock of stackTemplateGetter interface
+type MockstackTemplateGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeletePipelineRunnerMockRecorder
+	recorder *MockstackTemplateGetterMockRecorder
 }
 
-// MockdeletePipelineRunnerMockRecorder is the mock recorder for MockdeletePipelineRunner
-type MockdeletePipelineRunnerMockRecorder struct {
-	mock *MockdeletePipelineRunner
+// MockstackTemplateGetterMockRecorder is the mock recorder for MockstackTemplateGetter
+type MockstackTemplateGetterMockRecorder struct {
+	mock *MockstackTemplateGetter
 }
 
-// NewMockdeletePipelineRunner creates a new mock instance
-func NewMockdeletePipelineRunner(ctrl *gomock.Controller) *MockdeletePipelineRunner {
-	mock := &MockdeletePipelineRunner{ctrl: ctrl}
-	mock.recorder = &MockdeletePipelineRunnerMockRecorder{mock}
+// NewMockstackTemplateGetter creates a new mock instance
+func NewMockstackTemplateGetter(ctrl *gomock.Controller) *MockstackTemplateGetter {
+	mock := &MockstackTemplateGetter{ctrl: ctrl}
+	mock.recorder = &MockstackTemplateGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdeletePipelineRunner) EXPECT() *MockdeletePipelineRunnerMockRecorder {
+func (m *MockstackTemplateGetter) EXPECT() *MockstackTemplateGetterMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method
-func (m *MockdeletePipelineRunner) Run() error {
+// TemplateBody mocks base method
+func (m *MockstackTemplateGetter) TemplateBody(name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TemplateBody", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Run indicates an expected call of Run
-func (mr *MockdeletePipelineRunnerMockRecorder) Run() *gomock.Call {
+// TemplateBody indicates an expected call of TemplateBody
+func (mr *MockstackTemplateGetterMockRecorder) TemplateBody(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdeletePipelineRunner)(nil).Run))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockstackTemplateGetter)(nil).TemplateBody), name)
 }
 
-// MockexecuteAsker is a mock of executeAsker interface
-type MockexecuteAsker struct {
+// MocktaskDefinitionGetter is a mock of taskDefinitionGetter interface
+type MocktaskDefinitionGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecuteAskerMockRecorder
+	recorder *MocktaskDefinitionGetterMockRecorder
 }
 
-// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker
-type MockexecuteAskerMockRecorder struct {
-	mock *MockexecuteAsker
+// MocktaskDefinitionGetterMockRecorder is the mock recorder for MocktaskDefinitionGetter
+type MocktaskDefinitionGetterMockRecorder struct {
+	mock *MocktaskDefinitionGetter
 }
 
-// NewMockexecuteAsker creates a new mock instance
-func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
-	mock := &MockexecuteAsker{ctrl: ctrl}
-	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+// NewMocktaskDefinitionGetter creates a new mock instance
+func NewMocktaskDefinitionGetter(ctrl *gomock.Controller) *MocktaskDefinitionGetter {
+	mock := &MocktaskDefinitionGetter{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+func (m *MocktaskDefinitionGetter) EXPECT() *MocktaskDefinitionGetterMockRecorder {
 	return m.recorder
 }
 
-// Ask mocks base method
-func (m *MockexecuteAsker) Ask() error {
+// TaskDefinition mocks base method
+func (m *MocktaskDefinitionGetter) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ask")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Ask indicates an expected call of Ask
-func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MocktaskDefinitionGetterMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefinitionGetter)(nil).TaskDefinition), taskDefName)
 }
 
-// Execute mocks base method
-func (m *MockexecuteAsker) Execute() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockecsServiceDescriber is a mock of ecsServiceDescriber interface
+type MockecsServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceDescriberMockRecorder
 }
 
-// Execute indicates an expected call of Execute
-func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+// MockecsServiceDescriberMockRecorder is the mock recorder for MockecsServiceDescriber
+type MockecsServiceDescriberMockRecorder struct {
+	mock *MockecsServiceDescriber
 }
 
-// MockappSelector is a mock of appSelector interface
-type MockappSelector struct {
-	ctrl     *gomock.Controller
-	recorder *MockappSelectorMockRecorder
+// NewMockecsServiceDescriber creates a new mock instance
+func NewMockecsServiceDescriber(ctrl *gomock.Controller) *MockecsServiceDescriber {
+	mock := &MockecsServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceDescriberMockRecorder{mock}
+	return mock
 }
 
-// MockappSelectorMockRecorder is the mock recorder for MockappSelector
-type MockappSelectorMockRecorder struct {
-	mock *MockappSelector
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsServiceDescriber) EXPECT() *MockecsServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method
+func (m *MockecsServiceDescriber) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockecsServiceDescriberMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceDescriber)(nil).Service), clusterName, serviceName)
+}
+
+// MockappRunnerServiceDescriber is a mock of appRunnerServiceDescriber interface
+type MockappRunnerServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappRunnerServiceDescriberMockRecorder
+}
+
+// MockappRunnerServiceDescriberMockRecorder is the mock recorder for MockappRunnerServiceDescriber
+type MockappRunnerServiceDescriberMockRecorder struct {
+	mock *MockappRunnerServiceDescriber
+}
+
+// NewMockappRunnerServiceDescriber creates a new mock instance
+func NewMockappRunnerServiceDescriber(ctrl *gomock.Controller) *MockappRunnerServiceDescriber {
+	mock := &MockappRunnerServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockappRunnerServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappRunnerServiceDescriber) EXPECT() *MockappRunnerServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method
+func (m *MockappRunnerServiceDescriber) DescribeService(svcARN string) (*apprunner.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", svcARN)
+	ret0, _ := ret[0].(*apprunner.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService
+func (mr *MockappRunnerServiceDescriberMockRecorder) DescribeService(svcARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockappRunnerServiceDescriber)(nil).DescribeService), svcARN)
+}
+
+// MockpipelineGetter is a mock of pipelineGetter interface
+type MockpipelineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineGetterMockRecorder
+}
+
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
+}
+
+// NewMockpipelineGetter creates a new mock instance
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipeline mocks base method
+func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+}
+
+// ListPipelineNamesByTags mocks base method
+func (m *MockpipelineGetter) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags
+func (mr *MockpipelineGetterMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).ListPipelineNamesByTags), tags)
+}
+
+// GetPipelinesByTags mocks base method
+func (m *MockpipelineGetter) GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelinesByTags", tags)
+	ret0, _ := ret[0].([]*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelinesByTags indicates an expected call of GetPipelinesByTags
+func (mr *MockpipelineGetterMockRecorder) GetPipelinesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelinesByTags", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipelinesByTags), tags)
+}
+
+// MockpipelineBuildGetter is a mock of pipelineBuildGetter interface
+type MockpipelineBuildGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineBuildGetterMockRecorder
+}
+
+// MockpipelineBuildGetterMockRecorder is the mock recorder for MockpipelineBuildGetter
+type MockpipelineBuildGetterMockRecorder struct {
+	mock *MockpipelineBuildGetter
+}
+
+// NewMockpipelineBuildGetter creates a new mock instance
+func NewMockpipelineBuildGetter(ctrl *gomock.Controller) *MockpipelineBuildGetter {
+	mock := &MockpipelineBuildGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineBuildGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineBuildGetter) EXPECT() *MockpipelineBuildGetterMockRecorder {
+	return m.recorder
+}
+
+// LastBuild mocks base method
+func (m *MockpipelineBuildGetter) LastBuild(pipeline *codepipeline.Pipeline) (*codepipeline.BuildExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastBuild", pipeline)
+	ret0, _ := ret[0].(*codepipeline.BuildExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastBuild indicates an expected call of LastBuild
+func (mr *MockpipelineBuildGetterMockRecorder) LastBuild(pipeline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBuild", reflect.TypeOf((*MockpipelineBuildGetter)(nil).LastBuild), pipeline)
+}
+
+// MockpipelineTagsGetter is a mock of pipelineTagsGetter interface
+type MockpipelineTagsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineTagsGetterMockRecorder
+}
+
+// MockpipelineTagsGetterMockRecorder is the mock recorder for MockpipelineTagsGetter
+type MockpipelineTagsGetterMockRecorder struct {
+	mock *MockpipelineTagsGetter
+}
+
+// NewMockpipelineTagsGetter creates a new mock instance
+func NewMockpipelineTagsGetter(ctrl *gomock.Controller) *MockpipelineTagsGetter {
+	mock := &MockpipelineTagsGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineTagsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineTagsGetter) EXPECT() *MockpipelineTagsGetterMockRecorder {
+	return m.recorder
+}
+
+// PipelineTags mocks base method
+func (m *MockpipelineTagsGetter) PipelineTags(name string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineTags", name)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineTags indicates an expected call of PipelineTags
+func (mr *MockpipelineTagsGetterMockRecorder) PipelineTags(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineTags", reflect.TypeOf((*MockpipelineTagsGetter)(nil).PipelineTags), name)
+}
+
+// MockpipelineArtifactStoreGetter is a mock of pipelineArtifactStoreGetter interface
+type MockpipelineArtifactStoreGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineArtifactStoreGetterMockRecorder
+}
+
+// MockpipelineArtifactStoreGetterMockRecorder is the mock recorder for MockpipelineArtifactStoreGetter
+type MockpipelineArtifactStoreGetterMockRecorder struct {
+	mock *MockpipelineArtifactStoreGetter
+}
+
+// NewMockpipelineArtifactStoreGetter creates a new mock instance
+func NewMockpipelineArtifactStoreGetter(ctrl *gomock.Controller) *MockpipelineArtifactStoreGetter {
+	mock := &MockpipelineArtifactStoreGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineArtifactStoreGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineArtifactStoreGetter) EXPECT() *MockpipelineArtifactStoreGetterMockRecorder {
+	return m.recorder
+}
+
+// ArtifactStore mocks base method
+func (m *MockpipelineArtifactStoreGetter) ArtifactStore(name string) (*codepipeline.ArtifactStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtifactStore", name)
+	ret0, _ := ret[0].(*codepipeline.ArtifactStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtifactStore indicates an expected call of ArtifactStore
+func (mr *MockpipelineArtifactStoreGetterMockRecorder) ArtifactStore(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtifactStore", reflect.TypeOf((*MockpipelineArtifactStoreGetter)(nil).ArtifactStore), name)
+}
+
+// MockbucketConfigGetter is a mock of bucketConfigGetter interface
+type MockbucketConfigGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockbucketConfigGetterMockRecorder
+}
+
+// MockbucketConfigGetterMockRecorder is the mock recorder for MockbucketConfigGetter
+type MockbucketConfigGetterMockRecorder struct {
+	mock *MockbucketConfigGetter
+}
+
+// NewMockbucketConfigGetter creates a new mock instance
+func NewMockbucketConfigGetter(ctrl *gomock.Controller) *MockbucketConfigGetter {
+	mock := &MockbucketConfigGetter{ctrl: ctrl}
+	mock.recorder = &MockbucketConfigGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbucketConfigGetter) EXPECT() *MockbucketConfigGetterMockRecorder {
+	return m.recorder
+}
+
+// BucketEncryption mocks base method
+func (m *MockbucketConfigGetter) BucketEncryption(bucket string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketEncryption", bucket)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketEncryption indicates an expected call of BucketEncryption
+func (mr *MockbucketConfigGetterMockRecorder) BucketEncryption(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketEncryption", reflect.TypeOf((*MockbucketConfigGetter)(nil).BucketEncryption), bucket)
+}
+
+// BucketExpirationDays mocks base method
+func (m *MockbucketConfigGetter) BucketExpirationDays(bucket string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketExpirationDays", bucket)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketExpirationDays indicates an expected call of BucketExpirationDays
+func (mr *MockbucketConfigGetterMockRecorder) BucketExpirationDays(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketExpirationDays", reflect.TypeOf((*MockbucketConfigGetter)(nil).BucketExpirationDays), bucket)
+}
+
+// MockbudgetLister is a mock of budgetLister interface
+type MockbudgetLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockbudgetListerMockRecorder
+}
+
+// MockbudgetListerMockRecorder is the mock recorder for MockbudgetLister
+type MockbudgetListerMockRecorder struct {
+	mock *MockbudgetLister
+}
+
+// NewMockbudgetLister creates a new mock instance
+func NewMockbudgetLister(ctrl *gomock.Controller) *MockbudgetLister {
+	mock := &MockbudgetLister{ctrl: ctrl}
+	mock.recorder = &MockbudgetListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbudgetLister) EXPECT() *MockbudgetListerMockRecorder {
+	return m.recorder
+}
+
+// BudgetsWithTag mocks base method
+func (m *MockbudgetLister) BudgetsWithTag(accountID, key, value string) ([]*budgets.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BudgetsWithTag", accountID, key, value)
+	ret0, _ := ret[0].([]*budgets.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BudgetsWithTag indicates an expected call of BudgetsWithTag
+func (mr *MockbudgetListerMockRecorder) BudgetsWithTag(accountID, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BudgetsWithTag", reflect.TypeOf((*MockbudgetLister)(nil).BudgetsWithTag), accountID, key, value)
+}
+
+// MockanomalyMonitorLister is a mock of anomalyMonitorLister interface
+type MockanomalyMonitorLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockanomalyMonitorListerMockRecorder
+}
+
+// MockanomalyMonitorListerMockRecorder is the mock recorder for MockanomalyMonitorLister
+type MockanomalyMonitorListerMockRecorder struct {
+	mock *MockanomalyMonitorLister
+}
+
+// NewMockanomalyMonitorLister creates a new mock instance
+func NewMockanomalyMonitorLister(ctrl *gomock.Controller) *MockanomalyMonitorLister {
+	mock := &MockanomalyMonitorLister{ctrl: ctrl}
+	mock.recorder = &MockanomalyMonitorListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockanomalyMonitorLister) EXPECT() *MockanomalyMonitorListerMockRecorder {
+	return m.recorder
+}
+
+// AnomalyMonitorsWithTag mocks base method
+func (m *MockanomalyMonitorLister) AnomalyMonitorsWithTag(key, value string) ([]*costexplorer.AnomalyMonitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnomalyMonitorsWithTag", key, value)
+	ret0, _ := ret[0].([]*costexplorer.AnomalyMonitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnomalyMonitorsWithTag indicates an expected call of AnomalyMonitorsWithTag
+func (mr *MockanomalyMonitorListerMockRecorder) AnomalyMonitorsWithTag(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnomalyMonitorsWithTag", reflect.TypeOf((*MockanomalyMonitorLister)(nil).AnomalyMonitorsWithTag), key, value)
+}
+
+// Mockexecutor is a mock of executor interface
+type Mockexecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecutorMockRecorder
+}
+
+// MockexecutorMockRecorder is the mock recorder for Mockexecutor
+type MockexecutorMockRecorder struct {
+	mock *Mockexecutor
+}
+
+// NewMockexecutor creates a new mock instance
+func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
+	mock := &Mockexecutor{ctrl: ctrl}
+	mock.recorder = &MockexecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *Mockexecutor) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+}
+
+// MockdeletePipelineRunner is a mock of deletePipelineRunner interface
+type MockdeletePipelineRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeletePipelineRunnerMockRecorder
+}
+
+// MockdeletePipelineRunnerMockRecorder is the mock recorder for MockdeletePipelineRunner
+type MockdeletePipelineRunnerMockRecorder struct {
+	mock *MockdeletePipelineRunner
+}
+
+// NewMockdeletePipelineRunner creates a new mock instance
+func NewMockdeletePipelineRunner(ctrl *gomock.Controller) *MockdeletePipelineRunner {
+	mock := &MockdeletePipelineRunner{ctrl: ctrl}
+	mock.recorder = &MockdeletePipelineRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeletePipelineRunner) EXPECT() *MockdeletePipelineRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *MockdeletePipelineRunner) Run() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run
+func (mr *MockdeletePipelineRunnerMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdeletePipelineRunner)(nil).Run))
+}
+
+// MockexecuteAsker is a mock of executeAsker interface
+type MockexecuteAsker struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecuteAskerMockRecorder
+}
+
+// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker
+type MockexecuteAskerMockRecorder struct {
+	mock *MockexecuteAsker
+}
+
+// NewMockexecuteAsker creates a new mock instance
+func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
+	mock := &MockexecuteAsker{ctrl: ctrl}
+	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method
+func (m *MockexecuteAsker) Ask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ask indicates an expected call of Ask
+func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+}
+
+// Execute mocks base method
+func (m *MockexecuteAsker) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+}
+
+// MockappSelector is a mock of appSelector interface
+type MockappSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockappSelectorMockRecorder
+}
+
+// MockappSelectorMockRecorder is the mock recorder for MockappSelector
+type MockappSelectorMockRecorder struct {
+	mock *MockappSelector
+}
+
+// NewMockappSelector creates a new mock instance
+func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
+	mock := &MockappSelector{ctrl: ctrl}
+	mock.recorder = &MockappSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method
+func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application
+func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+}
+
+// ApplicationFrom mocks base method
+func (m *MockappSelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationFrom", prompt, help, appNames)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationFrom indicates an expected call of ApplicationFrom
+func (mr *MockappSelectorMockRecorder) ApplicationFrom(prompt, help, appNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationFrom", reflect.TypeOf((*MockappSelector)(nil).ApplicationFrom), prompt, help, appNames)
 }
 
-// NewMockappSelector creates a new mock instance
-func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
-	mock := &MockappSelector{ctrl: ctrl}
-	mock.recorder = &MockappSelectorMockRecorder{mock}
+// MockappChoiceLister is a mock of appChoiceLister interface
+type MockappChoiceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockappChoiceListerMockRecorder
+}
+
+// MockappChoiceListerMockRecorder is the mock recorder for MockappChoiceLister
+type MockappChoiceListerMockRecorder struct {
+	mock *MockappChoiceLister
+}
+
+// NewMockappChoiceLister creates a new mock instance
+func NewMockappChoiceLister(ctrl *gomock.Controller) *MockappChoiceLister {
+	mock := &MockappChoiceLister{ctrl: ctrl}
+	mock.recorder = &MockappChoiceListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+func (m *MockappChoiceLister) EXPECT() *MockappChoiceListerMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method
-func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// ApplicationChoices mocks base method
+func (m *MockappChoiceLister) ApplicationChoices(additionalOpts ...string) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
+	varargs := []interface{}{}
 	for _, a := range additionalOpts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret := m.ctrl.Call(m, "ApplicationChoices", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationChoices indicates an expected call of ApplicationChoices
+func (mr *MockappChoiceListerMockRecorder) ApplicationChoices(additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationChoices", reflect.TypeOf((*MockappChoiceLister)(nil).ApplicationChoices), additionalOpts...)
+}
+
+// MockcertificateDescriber is a mock of certificateDescriber interface
+type MockcertificateDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertificateDescriberMockRecorder
+}
+
+// MockcertificateDescriberMockRecorder is the mock recorder for MockcertificateDescriber
+type MockcertificateDescriberMockRecorder struct {
+	mock *MockcertificateDescriber
+}
+
+// NewMockcertificateDescriber creates a new mock instance
+func NewMockcertificateDescriber(ctrl *gomock.Controller) *MockcertificateDescriber {
+	mock := &MockcertificateDescriber{ctrl: ctrl}
+	mock.recorder = &MockcertificateDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcertificateDescriber) EXPECT() *MockcertificateDescriberMockRecorder {
+	return m.recorder
+}
+
+// CertificateExpiry mocks base method
+func (m *MockcertificateDescriber) CertificateExpiry(certARN string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateExpiry", certARN)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertificateExpiry indicates an expected call of CertificateExpiry
+func (mr *MockcertificateDescriberMockRecorder) CertificateExpiry(certARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateExpiry", reflect.TypeOf((*MockcertificateDescriber)(nil).CertificateExpiry), certARN)
+}
+
+// MockwebACLGetter is a mock of webACLGetter interface
+type MockwebACLGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebACLGetterMockRecorder
+}
+
+// MockwebACLGetterMockRecorder is the mock recorder for MockwebACLGetter
+type MockwebACLGetterMockRecorder struct {
+	mock *MockwebACLGetter
+}
+
+// NewMockwebACLGetter creates a new mock instance
+func NewMockwebACLGetter(ctrl *gomock.Controller) *MockwebACLGetter {
+	mock := &MockwebACLGetter{ctrl: ctrl}
+	mock.recorder = &MockwebACLGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwebACLGetter) EXPECT() *MockwebACLGetterMockRecorder {
+	return m.recorder
+}
+
+// WebACLForResource mocks base method
+func (m *MockwebACLGetter) WebACLForResource(resourceARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebACLForResource", resourceARN)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application
-func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// WebACLForResource indicates an expected call of WebACLForResource
+func (mr *MockwebACLGetterMockRecorder) WebACLForResource(resourceARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebACLForResource", reflect.TypeOf((*MockwebACLGetter)(nil).WebACLForResource), resourceARN)
+}
+
+// MockstackDeploymentGetter is a mock of stackDeploymentGetter interface
+type MockstackDeploymentGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDeploymentGetterMockRecorder
+}
+
+// MockstackDeploymentGetterMockRecorder is the mock recorder for MockstackDeploymentGetter
+type MockstackDeploymentGetterMockRecorder struct {
+	mock *MockstackDeploymentGetter
+}
+
+// NewMockstackDeploymentGetter creates a new mock instance
+func NewMockstackDeploymentGetter(ctrl *gomock.Controller) *MockstackDeploymentGetter {
+	mock := &MockstackDeploymentGetter{ctrl: ctrl}
+	mock.recorder = &MockstackDeploymentGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackDeploymentGetter) EXPECT() *MockstackDeploymentGetterMockRecorder {
+	return m.recorder
+}
+
+// LastStackDeployment mocks base method
+func (m *MockstackDeploymentGetter) LastStackDeployment(stack string) (*cloudtrail.StackDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastStackDeployment", stack)
+	ret0, _ := ret[0].(*cloudtrail.StackDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastStackDeployment indicates an expected call of LastStackDeployment
+func (mr *MockstackDeploymentGetterMockRecorder) LastStackDeployment(stack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastStackDeployment", reflect.TypeOf((*MockstackDeploymentGetter)(nil).LastStackDeployment), stack)
+}
+
+// MocklogGroupRetentionGetter is a mock of logGroupRetentionGetter interface
+type MocklogGroupRetentionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogGroupRetentionGetterMockRecorder
+}
+
+// MocklogGroupRetentionGetterMockRecorder is the mock recorder for MocklogGroupRetentionGetter
+type MocklogGroupRetentionGetterMockRecorder struct {
+	mock *MocklogGroupRetentionGetter
+}
+
+// NewMocklogGroupRetentionGetter creates a new mock instance
+func NewMocklogGroupRetentionGetter(ctrl *gomock.Controller) *MocklogGroupRetentionGetter {
+	mock := &MocklogGroupRetentionGetter{ctrl: ctrl}
+	mock.recorder = &MocklogGroupRetentionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklogGroupRetentionGetter) EXPECT() *MocklogGroupRetentionGetterMockRecorder {
+	return m.recorder
+}
+
+// LogGroupRetention mocks base method
+func (m *MocklogGroupRetentionGetter) LogGroupRetention(logGroup string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupRetention", logGroup)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupRetention indicates an expected call of LogGroupRetention
+func (mr *MocklogGroupRetentionGetterMockRecorder) LogGroupRetention(logGroup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupRetention", reflect.TypeOf((*MocklogGroupRetentionGetter)(nil).LogGroupRetention), logGroup)
+}
+
+// MockalarmStatusGetter is a mock of alarmStatusGetter interface
+type MockalarmStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmStatusGetterMockRecorder
+}
+
+// MockalarmStatusGetterMockRecorder is the mock recorder for MockalarmStatusGetter
+type MockalarmStatusGetterMockRecorder struct {
+	mock *MockalarmStatusGetter
+}
+
+// NewMockalarmStatusGetter creates a new mock instance
+func NewMockalarmStatusGetter(ctrl *gomock.Controller) *MockalarmStatusGetter {
+	mock := &MockalarmStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockalarmStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockalarmStatusGetter) EXPECT() *MockalarmStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// AlarmStatus mocks base method
+func (m *MockalarmStatusGetter) AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmStatus", alarms)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmStatus indicates an expected call of AlarmStatus
+func (mr *MockalarmStatusGetterMockRecorder) AlarmStatus(alarms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatus", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmStatus), alarms)
+}
+
+// MockjobExecutionLister is a mock of jobExecutionLister interface
+type MockjobExecutionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobExecutionListerMockRecorder
+}
+
+// MockjobExecutionListerMockRecorder is the mock recorder for MockjobExecutionLister
+type MockjobExecutionListerMockRecorder struct {
+	mock *MockjobExecutionLister
+}
+
+// NewMockjobExecutionLister creates a new mock instance
+func NewMockjobExecutionLister(ctrl *gomock.Controller) *MockjobExecutionLister {
+	mock := &MockjobExecutionLister{ctrl: ctrl}
+	mock.recorder = &MockjobExecutionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockjobExecutionLister) EXPECT() *MockjobExecutionListerMockRecorder {
+	return m.recorder
+}
+
+// RecentExecutions mocks base method
+func (m *MockjobExecutionLister) RecentExecutions(stateMachineARN string, since time.Time, max int) ([]stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentExecutions", stateMachineARN, since, max)
+	ret0, _ := ret[0].([]stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecentExecutions indicates an expected call of RecentExecutions
+func (mr *MockjobExecutionListerMockRecorder) RecentExecutions(stateMachineARN, since, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentExecutions", reflect.TypeOf((*MockjobExecutionLister)(nil).RecentExecutions), stateMachineARN, since, max)
+}
+
+// MockbucketObjectCounter is a mock of bucketObjectCounter interface
+type MockbucketObjectCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockbucketObjectCounterMockRecorder
+}
+
+// MockbucketObjectCounterMockRecorder is the mock recorder for MockbucketObjectCounter
+type MockbucketObjectCounterMockRecorder struct {
+	mock *MockbucketObjectCounter
+}
+
+// NewMockbucketObjectCounter creates a new mock instance
+func NewMockbucketObjectCounter(ctrl *gomock.Controller) *MockbucketObjectCounter {
+	mock := &MockbucketObjectCounter{ctrl: ctrl}
+	mock.recorder = &MockbucketObjectCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbucketObjectCounter) EXPECT() *MockbucketObjectCounterMockRecorder {
+	return m.recorder
+}
+
+// BucketObjectCount mocks base method
+func (m *MockbucketObjectCounter) BucketObjectCount(bucket string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketObjectCount", bucket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketObjectCount indicates an expected call of BucketObjectCount
+func (mr *MockbucketObjectCounterMockRecorder) BucketObjectCount(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectCount", reflect.TypeOf((*MockbucketObjectCounter)(nil).BucketObjectCount), bucket)
+}
+
+// MockclipboardWriter is a mock of clipboardWriter interface
+type MockclipboardWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockclipboardWriterMockRecorder
+}
+
+// MockclipboardWriterMockRecorder is the mock recorder for MockclipboardWriter
+type MockclipboardWriterMockRecorder struct {
+	mock *MockclipboardWriter
+}
+
+// NewMockclipboardWriter creates a new mock instance
+func NewMockclipboardWriter(ctrl *gomock.Controller) *MockclipboardWriter {
+	mock := &MockclipboardWriter{ctrl: ctrl}
+	mock.recorder = &MockclipboardWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockclipboardWriter) EXPECT() *MockclipboardWriterMockRecorder {
+	return m.recorder
+}
+
+// Copy mocks base method
+func (m *MockclipboardWriter) Copy(text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockclipboardWriterMockRecorder) Copy(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockclipboardWriter)(nil).Copy), text)
+}
+
+// MockoutputPager is a mock of outputPager interface
+type MockoutputPager struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutputPagerMockRecorder
+}
+
+// MockoutputPagerMockRecorder is the mock recorder for MockoutputPager
+type MockoutputPagerMockRecorder struct {
+	mock *MockoutputPager
+}
+
+// NewMockoutputPager creates a new mock instance
+func NewMockoutputPager(ctrl *gomock.Controller) *MockoutputPager {
+	mock := &MockoutputPager{ctrl: ctrl}
+	mock.recorder = &MockoutputPagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockoutputPager) EXPECT() *MockoutputPagerMockRecorder {
+	return m.recorder
+}
+
+// Page mocks base method
+func (m *MockoutputPager) Page(text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Page indicates an expected call of Page
+func (mr *MockoutputPagerMockRecorder) Page(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockoutputPager)(nil).Page), text)
+}
+
+// MockconnectionGetter is a mock of connectionGetter interface
+type MockconnectionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockconnectionGetterMockRecorder
+}
+
+// MockconnectionGetterMockRecorder is the mock recorder for MockconnectionGetter
+type MockconnectionGetterMockRecorder struct {
+	mock *MockconnectionGetter
+}
+
+// NewMockconnectionGetter creates a new mock instance
+func NewMockconnectionGetter(ctrl *gomock.Controller) *MockconnectionGetter {
+	mock := &MockconnectionGetter{ctrl: ctrl}
+	mock.recorder = &MockconnectionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockconnectionGetter) EXPECT() *MockconnectionGetterMockRecorder {
+	return m.recorder
+}
+
+// GetConnection mocks base method
+func (m *MockconnectionGetter) GetConnection(connectionARN string) (*codestar.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", connectionARN)
+	ret0, _ := ret[0].(*codestar.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection
+func (mr *MockconnectionGetterMockRecorder) GetConnection(connectionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockconnectionGetter)(nil).GetConnection), connectionARN)
 }
 
 // MockappEnvSelector is a mock of appEnvSelector interface
@@ -4612,6 +5782,21 @@ func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
 }
 
+// ApplicationFrom mocks base method
+func (m *MockappEnvSelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationFrom", prompt, help, appNames)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationFrom indicates an expected call of ApplicationFrom
+func (mr *MockappEnvSelectorMockRecorder) ApplicationFrom(prompt, help, appNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationFrom", reflect.TypeOf((*MockappEnvSelector)(nil).ApplicationFrom), prompt, help, appNames)
+}
+
 // Environment mocks base method
 func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
@@ -4675,6 +5860,21 @@ func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
 }
 
+// ApplicationFrom mocks base method
+func (m *MockconfigSelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationFrom", prompt, help, appNames)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationFrom indicates an expected call of ApplicationFrom
+func (mr *MockconfigSelectorMockRecorder) ApplicationFrom(prompt, help, appNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationFrom", reflect.TypeOf((*MockconfigSelector)(nil).ApplicationFrom), prompt, help, appNames)
+}
+
 // Environment mocks base method
 func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
@@ -4753,6 +5953,21 @@ func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
 }
 
+// ApplicationFrom mocks base method
+func (m *MockdeploySelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationFrom", prompt, help, appNames)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationFrom indicates an expected call of ApplicationFrom
+func (mr *MockdeploySelectorMockRecorder) ApplicationFrom(prompt, help, appNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationFrom", reflect.TypeOf((*MockdeploySelector)(nil).ApplicationFrom), prompt, help, appNames)
+}
+
 // DeployedService mocks base method
 func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
 	m.ctrl.T.Helper()
@@ -4854,6 +6069,21 @@ func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, addi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
 }
 
+// ApplicationFrom mocks base method
+func (m *MockwsSelector) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationFrom", prompt, help, appNames)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationFrom indicates an expected call of ApplicationFrom
+func (mr *MockwsSelectorMockRecorder) ApplicationFrom(prompt, help, appNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationFrom", reflect.TypeOf((*MockwsSelector)(nil).ApplicationFrom), prompt, help, appNames)
+}
+
 // Environment mocks base method
 func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
@@ -3,7 +3,11 @@
 
 package cli
 
-import "github.com/aws/copilot-cli/internal/pkg/term/prompt"
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
 
 type prompter interface {
 	Get(message, help string, validator prompt.ValidatorFunc, promptOpts ...prompt.Option) (string, error)
@@ -12,3 +16,50 @@ type prompter interface {
 	MultiSelect(message, help string, options []string, promptOpts ...prompt.Option) ([]string, error)
 	Confirm(message, help string, promptOpts ...prompt.Option) (bool, error)
 }
+
+// assumedAnswerPrompter answers the confirmation prompts without asking, like for --yes or --no.
+// The other prompts fail rather than wait for an input, so that the command never blocks.
+type assumedAnswerPrompter struct {
+	answer bool
+	flag   string // Flag that assumes the answer, to explain why the other prompts fail.
+}
+
+// newAssumedAnswerPrompter returns a prompter that answers yes with --yes, no with --no, or nil if neither is set.
+func newAssumedAnswerPrompter(assumeYes, assumeNo bool) *assumedAnswerPrompter {
+	switch {
+	case assumeYes:
+		return &assumedAnswerPrompter{answer: true, flag: yesFlag}
+	case assumeNo:
+		return &assumedAnswerPrompter{answer: false, flag: noFlag}
+	}
+	return nil
+}
+
+// Get fails as the input can't be assumed.
+func (p *assumedAnswerPrompter) Get(message, _ string, _ prompt.ValidatorFunc, _ ...prompt.Option) (string, error) {
+	return "", p.errNoInput(message)
+}
+
+// GetSecret fails as the input can't be assumed.
+func (p *assumedAnswerPrompter) GetSecret(message, _ string, _ ...prompt.Option) (string, error) {
+	return "", p.errNoInput(message)
+}
+
+// SelectOne fails as the selection can't be assumed.
+func (p *assumedAnswerPrompter) SelectOne(message, _ string, _ []string, _ ...prompt.Option) (string, error) {
+	return "", p.errNoInput(message)
+}
+
+// MultiSelect fails as the selection can't be assumed.
+func (p *assumedAnswerPrompter) MultiSelect(message, _ string, _ []string, _ ...prompt.Option) ([]string, error) {
+	return nil, p.errNoInput(message)
+}
+
+// Confirm returns the assumed answer.
+func (p *assumedAnswerPrompter) Confirm(_, _ string, _ ...prompt.Option) (bool, error) {
+	return p.answer, nil
+}
+
+func (p *assumedAnswerPrompter) errNoInput(message string) error {
+	return fmt.Errorf("prompt %q needs an input, which can't be assumed with --%s", message, p.flag)
+}
@@ -0,0 +1,55 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAssumedAnswerPrompter(t *testing.T) {
+	require.Nil(t, newAssumedAnswerPrompter(false, false))
+
+	yes, err := newAssumedAnswerPrompter(true, false).Confirm("Continue?", "")
+	require.NoError(t, err)
+	require.True(t, yes)
+
+	no, err := newAssumedAnswerPrompter(false, true).Confirm("Continue?", "")
+	require.NoError(t, err)
+	require.False(t, no)
+}
+
+func TestAssumedAnswerPrompter_FailsWithoutBlocking(t *testing.T) {
+	p := newAssumedAnswerPrompter(true, false)
+
+	_, err := p.Get("Name?", "", nil)
+	require.EqualError(t, err, `prompt "Name?" needs an input, which can't be assumed with --yes`)
+	_, err = p.GetSecret("Password?", "")
+	require.EqualError(t, err, `prompt "Password?" needs an input, which can't be assumed with --yes`)
+	_, err = p.MultiSelect("Which environments?", "", []string{"test", "prod"})
+	require.EqualError(t, err, `prompt "Which environments?" needs an input, which can't be assumed with --yes`)
+	_, err = newAssumedAnswerPrompter(false, true).SelectOne("Which application?", "", []string{"app1", "app2"})
+	require.EqualError(t, err, `prompt "Which application?" needs an input, which can't be assumed with --no`)
+}
+
+func TestAssumedAnswerPrompter_SelectsTheOnlyApplication(t *testing.T) {
+	sel := selector.NewSelect(newAssumedAnswerPrompter(true, false), fakeAppLister{apps: []*config.Application{{Name: "my-app"}}})
+
+	app, err := sel.Application("Which application?", "")
+
+	require.NoError(t, err)
+	require.Equal(t, "my-app", app)
+}
+
+type fakeAppLister struct {
+	selector.ConfigLister
+	apps []*config.Application
+}
+
+func (f fakeAppLister) ListApplications() ([]*config.Application, error) {
+	return f.apps, nil
+}
@@ -11,9 +11,13 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"net/url"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -39,6 +43,10 @@ const (
 	fmtWkldParamPath    = "/copilot/applications/%s/components/%s" // path for a workload in an application
 )
 
+// EndpointEnvVar is the environment variable that overrides the endpoint of the SSM API of the store,
+// for example to run against LocalStack or an SSM-compatible proxy.
+const EndpointEnvVar = "COPILOT_STORE_ENDPOINT"
+
 type identityGetter interface {
 	Get() (identity.Caller, error)
 }
@@ -51,6 +59,7 @@ type Store struct {
 }
 
 // NewStore returns a new store, allowing you to query or create Applications, Environments, Services, and other workloads.
+// The store queries the endpoint in $COPILOT_STORE_ENDPOINT if it's set.
 func NewStore() (*Store, error) {
 	p := sessions.NewProvider()
 	sess, err := p.Default()
@@ -58,12 +67,49 @@ func NewStore() (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	endpoint := os.Getenv(EndpointEnvVar)
+	if endpoint == "" {
+		return NewStoreFromSession(sess), nil
+	}
+	if err := ValidateEndpoint(endpoint); err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", EndpointEnvVar, err)
+	}
+	return NewStoreFromSession(sess, WithEndpoint(endpoint)), nil
+}
+
+// StoreOption customizes the SSM client of a Store.
+type StoreOption func(cfg *aws.Config)
+
+// WithEndpoint sends the SSM calls of the store to endpoint instead of the SSM endpoint of the session's region.
+func WithEndpoint(endpoint string) StoreOption {
+	return func(cfg *aws.Config) {
+		cfg.Endpoint = aws.String(endpoint)
+	}
+}
 
+// ValidateEndpoint returns an error if endpoint isn't an absolute HTTP or HTTPS URL.
+func ValidateEndpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("parse endpoint %q: %w", endpoint, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("endpoint %q must be an absolute URL starting with http:// or https://", endpoint)
+	}
+	return nil
+}
+
+// NewStoreFromSession returns a new store that queries SSM with the given session.
+func NewStoreFromSession(sess *session.Session, options ...StoreOption) *Store {
+	cfg := aws.NewConfig()
+	for _, option := range options {
+		option(cfg)
+	}
 	return &Store{
 		idClient:      identity.New(sess),
-		ssmClient:     ssm.New(sess),
+		ssmClient:     ssm.New(sess, cfg),
 		sessionRegion: *sess.Config.Region,
-	}, nil
+	}
 }
 
 func (s *Store) listParams(path string) ([]*string, error) {
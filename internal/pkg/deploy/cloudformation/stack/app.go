@@ -149,7 +149,7 @@ func (c *AppStackConfig) Tags() []*cloudformation.Tag {
 
 // StackName returns the name of the CloudFormation stack (based on the application name).
 func (c *AppStackConfig) StackName() string {
-	return fmt.Sprintf("%s-infrastructure-roles", c.Name)
+	return NameForApp(c.Name)
 }
 
 // StackSetName returns the name of the CloudFormation StackSet (based on the application name).
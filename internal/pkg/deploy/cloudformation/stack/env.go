@@ -43,6 +43,9 @@ const (
 	EnvOutputVPCID               = "VpcId"
 	EnvOutputPublicSubnets       = "PublicSubnets"
 	EnvOutputPrivateSubnets      = "PrivateSubnets"
+	EnvOutputNamespaceID         = "ServiceDiscoveryNamespaceID"
+	EnvOutputPublicLBDNSName     = "PublicLoadBalancerDNSName"
+	EnvOutputSubdomain           = "EnvironmentSubdomain"
 	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
 
@@ -36,6 +36,11 @@ func NameForEnv(app, env string) string {
 	return fmt.Sprintf("%s-%s", app, env)
 }
 
+// NameForApp returns the stack name for the roles of an application.
+func NameForApp(app string) string {
+	return fmt.Sprintf("%s-infrastructure-roles", app)
+}
+
 // NameForTask returns the stack name for a task.
 func NameForTask(task string) TaskStackName {
 	return TaskStackName(taskStackPrefix + task)
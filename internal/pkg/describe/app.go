@@ -5,66 +5,1571 @@ package describe
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 // App contains serialized parameters for an application.
 type App struct {
-	Name      string                   `json:"name"`
-	URI       string                   `json:"uri"`
+	Name string `json:"name"`
+	URI  string `json:"uri,omitempty"`
+	// Owner is the value of the owner tag of the application, or AppUnowned if it doesn't have the tag.
+	Owner     string                   `json:"owner,omitempty"`
 	Envs      []*config.Environment    `json:"environments"`
 	Services  []*config.Workload       `json:"services"`
 	Pipelines []*codepipeline.Pipeline `json:"pipelines"`
+	// PipelinesSkipped is true if the pipelines weren't looked up, in which case Pipelines is nil.
+	PipelinesSkipped bool `json:"pipelinesSkipped,omitempty"`
+	// PipelineStages are the stages of each pipeline and the services they deploy.
+	PipelineStages []*AppPipelineStages `json:"pipelineStages,omitempty"`
+	Secrets        []*AppSecret         `json:"secrets,omitempty"`
+
+	// Tags are the tags applied to all the resources of the application.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Dependencies are what the services depend on and should be deployed after.
+	Dependencies []*ServiceDependencies `json:"dependencies,omitempty"`
+
+	// CrossAccountRefs are the services whose addons reference resources in other accounts than the ones of the environments.
+	CrossAccountRefs []*ServiceCrossAccountRefs `json:"crossAccountRefs,omitempty"`
+
+	// DomainConflicts are the domain names claimed by several deployments of services.
+	DomainConflicts []*AppDomainConflict `json:"domainConflicts,omitempty"`
+
+	// InsecureEndpoints are the public endpoints of the services that only serve HTTP.
+	InsecureEndpoints []*AppInsecureEndpoint `json:"insecureEndpoints,omitempty"`
+
+	// EnvStatuses is the status of the stack of each environment by name, or EnvStatusUnknown if it couldn't be retrieved
+	// and EnvStatusAccountUnreachable if the account of the environment couldn't be accessed.
+	EnvStatuses map[string]string `json:"environmentStatuses,omitempty"`
+
+	// LastDeployedBy is who or what last deployed to each environment by name, or LastDeployedByUnknown if it couldn't be found.
+	LastDeployedBy map[string]string `json:"lastDeployedBy,omitempty"`
+
+	// Namespaces is the service discovery namespace of each environment by name, for the environments whose stack has one.
+	Namespaces map[string]*AppEnvNamespace `json:"serviceDiscoveryNamespaces,omitempty"`
+
+	// EnvTags are the tags of the stack of each environment by name that differ from the tags of the application,
+	// for the environments that have any. They're only retrieved with the tags.
+	EnvTags map[string]map[string]string `json:"environmentTags,omitempty"`
+
+	Deployments []*AppDeployment `json:"deployments,omitempty"`
+
+	// Allocation is the total CPU and memory allocated to the deployments, only retrieved with their resources.
+	Allocation *AppAllocation `json:"allocation,omitempty"`
+
+	AppRunnerServices []*AppRunnerService `json:"appRunnerServices,omitempty"`
+
+	// ServiceConnect is the Service Connect configuration of the services that enabled it, only retrieved with their resources.
+	ServiceConnect []*AppServiceConnect `json:"serviceConnect,omitempty"`
+
+	// TerminationProtection is whether termination protection is enabled on the stack of the application and on the
+	// stack of each environment by stack name, only retrieved with the resources.
+	TerminationProtection map[string]bool `json:"terminationProtection,omitempty"`
+
+	// ArtifactBuckets are the buckets of the pipeline artifacts of the environments, only retrieved with their resources.
+	ArtifactBuckets []*AppArtifactBucket `json:"artifactBuckets,omitempty"`
+
+	// StackSetEnvs are the names of the environments that are instances of a stack set rather than environments
+	// of the config store.
+	StackSetEnvs []string `json:"stackSetEnvironments,omitempty"`
+
+	// NotDeployed are the names of the services that are only in the workspace, like the ones that were
+	// initialized but never deployed.
+	NotDeployed []string `json:"notDeployed,omitempty"`
+
+	// ManuallyDeployed are the names of the deployed services that none of the pipelines deploy.
+	ManuallyDeployed []string `json:"manuallyDeployed,omitempty"`
+
+	// Jobs are the jobs deployed in each environment with their recent runs, only retrieved if asked for.
+	Jobs []*AppJob `json:"jobs,omitempty"`
+
+	// Warnings are non-fatal advisories found while describing the application.
+	Warnings []*AppWarning `json:"warnings,omitempty"`
+
+	// DerivedFromStacks is true if the environments and services were derived from the stacks of the application
+	// because the config store was unavailable.
+	DerivedFromStacks bool `json:"derivedFromStacks,omitempty"`
+
+	// WorkspaceCommit is the commit that the manifests of the workspace were read at, if not the working tree.
+	WorkspaceCommit string `json:"workspaceCommit,omitempty"`
+
+	// HealthScore is the health of the application from 0 to 100, only scored if asked for. See ScoreHealth.
+	HealthScore *int `json:"healthScore,omitempty"`
+
+	// CostControls are the budgets and cost anomaly monitors of the application, only retrieved if asked for.
+	CostControls *AppCostControls `json:"costControls,omitempty"`
+
+	// EnvLastDeployedAt is when a stack of each environment by name was last deployed, to sort them by recency.
+	EnvLastDeployedAt map[string]time.Time `json:"-"`
+}
+
+// AppRenderOptions are how the description of an application is rendered. They aren't part of the description,
+// so the same description can be rendered with different options.
+type AppRenderOptions struct {
+	// ShowResources renders the resources of the deployments, like their task definitions, in the human readable format.
+	ShowResources bool
+
+	// ShowTags renders the tags of the application and of the deployments in the human readable format.
+	ShowTags bool
+
+	// ShowPipelineStages renders the stages of the pipelines in the human readable format.
+	ShowPipelineStages bool
+
+	// Width is the number of characters that the tables of the human readable format are truncated to fit in.
+	// The tables are not truncated if it's zero.
+	Width int
+
+	// IndentJSON indents the json format for humans to read it. It's compact on a single line otherwise.
+	IndentJSON bool
+
+	// OmitJSON are the dotted paths of the fields left out of the json format, like "deployments.storage".
+	OmitJSON []string
+
+	// FormatVersion is the version of the layout of the output formats, one of AppFormatVersions.
+	// It's AppFormatVersionLatest if it's empty.
+	FormatVersion string
+
+	// EnvSort is how the environments are ordered in the human readable formats, one of EnvSortOrders.
+	// They're in the order of Envs if it's empty. The json format always keeps the order of Envs.
+	EnvSort string
+
+	// HideLegend omits the legend explaining the symbols and colors from the human readable format.
+	HideLegend bool
+
+	// Sources records where the values were retrieved from, to annotate the human readable format.
+	Sources *AppSources
+}
+
+// Sourced is a value annotated with the AWS resource it was retrieved from.
+type Sourced struct {
+	Value  string
+	Source string
+}
+
+// AppSources contains where the values of an application's description were retrieved from.
+type AppSources struct {
+	Name         Sourced
+	URI          Sourced
+	Environments []Sourced // Sources of the environments by name.
+	Services     []Sourced // Sources of the services by name.
+	Pipelines    []Sourced // Sources of the pipelines by name.
+}
+
+// AppEnvNamespace is the Cloud Map namespace of an environment that its services are reachable at,
+// like "api.my-app.local" for service api.
+type AppEnvNamespace struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
 }
 
-// JSONString returns the stringified App struct with json format.
-func (a *App) JSONString() (string, error) {
+// AppDeployment contains the state of a service deployed in an environment.
+type AppDeployment struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+	StackStatus string `json:"stackStatus"`
+	// CertExpiry is the expiry date of the certificate serving the custom domain of a load balanced service.
+	CertExpiry string `json:"certExpiry,omitempty"`
+	// TaskDefinition is the family and revision of the active task definition, for example "my-app-test-api:3".
+	TaskDefinition string `json:"taskDefinition,omitempty"`
+	// CPU is the number of CPU units allocated to each task, or to each instance of an App Runner service,
+	// and Memory its MiB of memory. Either is AllocationUnknown if it isn't set. They're only retrieved with the resources.
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+	// DeploymentController is how the Amazon ECS service is deployed, like "ECS" for rolling updates or "CODE_DEPLOY"
+	// for blue/green deployments, or DeploymentControllerUnknown. It's only retrieved with the resources.
+	DeploymentController string `json:"deploymentController,omitempty"`
+	// DeploymentState is the state of the current deployment of the services deployed with task sets,
+	// like "STEADY_STATE" or "IN_PROGRESS".
+	DeploymentState string `json:"deploymentState,omitempty"`
+	// Endpoints are all the URLs that the service is reached at, only retrieved with its resources.
+	Endpoints []*AppEndpoint `json:"endpoints,omitempty"`
+	// Offline is ServiceScaledToZero or ServicePaused if the service is deployed but doesn't serve any traffic,
+	// only retrieved with its resources.
+	Offline string `json:"offline,omitempty"`
+	// Tags are the tags of the service stack that differ from the tags of the application.
+	Tags map[string]string `json:"tags,omitempty"`
+	// Logging is whether the main container of the service ships its logs: LoggingEnabled, LoggingDisabled or LoggingUnknown.
+	Logging string `json:"logging,omitempty"`
+	// LogGroup is the CloudWatch Logs log group that the logs are shipped to, if any.
+	LogGroup string `json:"logGroup,omitempty"`
+	// LogRetention is how long the log group keeps the logs, like "30 days", LogRetentionNever or LogRetentionUnknown.
+	LogRetention string `json:"logRetention,omitempty"`
+	// Alarms are the CloudWatch alarms defined in the stacks of the service and of its addons, only retrieved with its resources.
+	Alarms []*AppAlarm `json:"alarms,omitempty"`
+	// Storage are the persistent volumes that the tasks of the service mount, only retrieved with its resources.
+	Storage []*AppVolume `json:"storage,omitempty"`
+	// WebACL is the name of the AWS WAF web ACL associated with the load balancer of a public-facing service,
+	// WebACLNone or WebACLUnknown, only retrieved with its resources.
+	WebACL string `json:"wafAcl,omitempty"`
+	// Drift are the fields of the manifest of the service in the workspace that differ from its deployed configuration,
+	// only checked with --check-drift.
+	Drift []*AppDrift `json:"drift,omitempty"`
+	// PreviousTaskDefinition is the family and revision of the task definition deployed before TaskDefinition,
+	// and Changes the fields that the last deployment changed since then, only retrieved with --since-last-deploy.
+	PreviousTaskDefinition string       `json:"previousTaskDefinition,omitempty"`
+	Changes                []*AppChange `json:"changes,omitempty"`
+}
+
+// AppAllocation is the CPU and memory allocated to the deployments of an application, to estimate its footprint.
+type AppAllocation struct {
+	CPU     int `json:"cpu"`               // CPU units.
+	Memory  int `json:"memory"`            // MiB.
+	Unknown int `json:"unknown,omitempty"` // Number of deployments whose CPU or memory is unknown, and left out of the totals.
+}
+
+// String returns the CPU units and MiB of memory of the allocation like "1280/2560", followed by the number of
+// deployments left out of them if any. It's AllocationUnknown if none of the deployments is known.
+func (a *AppAllocation) String() string {
+	if a.CPU == 0 && a.Memory == 0 && a.Unknown != 0 {
+		return AllocationUnknown
+	}
+	s := fmt.Sprintf(fmtAllocation, strconv.Itoa(a.CPU), strconv.Itoa(a.Memory))
+	if a.Unknown != 0 {
+		s += fmt.Sprintf(" (%d %s)", a.Unknown, AllocationUnknown)
+	}
+	return s
+}
+
+// AppVolume is a persistent volume backed by an EFS file system.
+type AppVolume struct {
+	Name          string `json:"name"`
+	FileSystemID  string `json:"fileSystemID"`
+	AccessPointID string `json:"accessPointID,omitempty"`
+}
+
+// AppAlarm is a CloudWatch alarm that monitors a service.
+type AppAlarm struct {
+	Name string `json:"name"`
+	// Metric and Threshold are only set for the alarms that compare a metric to a static threshold.
+	Metric    string   `json:"metric,omitempty"`
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// ServiceDependencies contains what a service depends on.
+type ServiceDependencies struct {
+	Service string `json:"service"`
+	// DependsOn are the names of the addons of the service and of the services whose stack outputs it imports.
+	DependsOn []string `json:"dependsOn"`
+}
+
+// ServiceCrossAccountRefs contains the accounts whose resources a service references.
+type ServiceCrossAccountRefs struct {
+	Service string `json:"service"`
+	// Accounts are the IDs of the referenced accounts that differ from the account of an environment, sorted.
+	Accounts []string `json:"accounts"`
+}
+
+// AppDomainConflict is a domain name claimed by several deployments of services, of which only one can receive its traffic.
+type AppDomainConflict struct {
+	Domain string            `json:"domain"`
+	Claims []*AppDomainClaim `json:"claims"`
+}
+
+// AppDomainClaim is a deployment of a service that claims a domain name.
+type AppDomainClaim struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+}
+
+// Statuses of the environments whose stack couldn't be retrieved.
+const (
+	EnvStatusUnknown = "unknown"
+	// EnvStatusAccountUnreachable is the status of an environment whose account can't be accessed at all,
+	// like an account that was closed.
+	EnvStatusAccountUnreachable = "account unreachable"
+)
+
+// Orders of the environments in the human readable format.
+const (
+	EnvSortName    = "name"    // Alphabetically.
+	EnvSortRecency = "recency" // From the most to the least recently deployed, the never deployed ones last.
+	EnvSortProd    = "prod"    // Production environments first, then alphabetically.
+)
+
+// EnvSortOrders are the supported orders of the environments.
+var EnvSortOrders = []string{EnvSortName, EnvSortRecency, EnvSortProd}
+
+// Versions of the layout of the output formats of an application. The fields and the columns of each format
+// are kept stable within a version, so that the parsers of a pinned version don't break when the CLI is upgraded.
+const (
+	AppFormatVersion1      = "1"
+	AppFormatVersionLatest = AppFormatVersion1
+)
+
+// AppFormatVersions are the supported versions of the layout of the output formats.
+var AppFormatVersions = []string{AppFormatVersion1}
+
+// EnvStackSetInstance marks the environments that are instances of a stack set.
+const EnvStackSetInstance = "stack set instance"
+
+// ServiceNotDeployed is the status of the services that are only in the workspace.
+const ServiceNotDeployed = "not deployed"
+
+// States of the services that are deployed but don't serve any traffic.
+const (
+	ServiceScaledToZero = "scaled to zero" // The desired count of the Amazon ECS service is 0.
+	ServicePaused       = "paused"         // The App Runner service is paused.
+)
+
+// AppUnowned is the owner of an application without an owner tag.
+const AppUnowned = "unowned"
+
+// LastDeployedByUnknown is the deployer of an environment that wasn't found in the CloudTrail event history.
+const LastDeployedByUnknown = "unknown"
+
+// Logging configurations of the services.
+const (
+	LoggingEnabled  = "enabled"
+	LoggingDisabled = "disabled"
+	LoggingUnknown  = "unknown"
+)
+
+// Termination protection of the stacks, as shown in the human readable output.
+const (
+	terminationProtectionEnabled  = "enabled"
+	terminationProtectionDisabled = "disabled"
+)
+
+// Retentions of the log groups of the services.
+const (
+	LogRetentionNever   = "never expires"
+	LogRetentionUnknown = "unknown"
+)
+
+// Web ACLs of the public-facing services.
+const (
+	WebACLNone    = "none"
+	WebACLUnknown = "unknown"
+)
+
+// DeploymentControllerUnknown is the deployment controller of a service whose Amazon ECS service couldn't be described.
+const DeploymentControllerUnknown = "unknown"
+
+// AppDerivedFromStacks labels the description of an application whose environments and services were derived from its stacks.
+const AppDerivedFromStacks = "derived from stacks (config store unavailable)"
+
+// fmtWorkspaceCommit describes the manifests of the workspace read at a commit rather than from the working tree.
+const fmtWorkspaceCommit = "as of commit %s"
+
+// AllocationUnknown is the CPU or memory of a deployment that isn't set or couldn't be retrieved.
+const AllocationUnknown = "unknown"
+
+// fmtAllocation is the CPU units and MiB of memory of a deployment in the human readable format.
+const fmtAllocation = "%s/%s"
+
+// TaskDefinitionNotApplicable is the task definition of the services that don't run on Amazon ECS, like App Runner services.
+const TaskDefinitionNotApplicable = "N/A"
+
+// Sources of the secrets referenced by services.
+const (
+	SecretSourceSSM            = "SSM"
+	SecretSourceSecretsManager = "Secrets Manager"
+)
+
+// AppSecret contains a reference to a secret used by a service. It never holds the secret value.
+type AppSecret struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+	Container   string `json:"container"`
+	Name        string `json:"name"`
+	ValueFrom   string `json:"valueFrom"`
+	Source      string `json:"source"`
+}
+
+// SecretSource returns where the secret referenced by valueFrom is stored.
+// Secrets Manager secrets are always referenced by ARN, while SSM parameters can be referenced by name or ARN.
+func SecretSource(valueFrom string) string {
+	parsed, err := arn.Parse(valueFrom)
+	if err == nil && parsed.Service == "secretsmanager" {
+		return SecretSourceSecretsManager
+	}
+	return SecretSourceSSM
+}
+
+// Severities of the warnings found while describing an application.
+const (
+	WarningSeverityInfo    = "info"    // Expected transient states, like a service that is still being created.
+	WarningSeverityWarning = "warning" // Issues that need attention but don't affect the running services yet.
+	WarningSeverityError   = "error"   // Failures of the application's resources, like a rolled back deployment.
+)
+
+// WarningSeverities are the severities of the warnings ordered from the least to the most severe.
+var WarningSeverities = []string{WarningSeverityInfo, WarningSeverityWarning, WarningSeverityError}
+
+// AppWarning is a non-fatal advisory found while describing an application.
+type AppWarning struct {
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// AtLeast returns true if the warning is as severe as the severity or more. Unknown severities are never reached.
+func (w *AppWarning) AtLeast(severity string) bool {
+	rank, threshold := -1, len(WarningSeverities)
+	for i, s := range WarningSeverities {
+		if s == w.Severity {
+			rank = i
+		}
+		if s == severity {
+			threshold = i
+		}
+	}
+	return rank >= threshold
+}
+
+// Statuses of the runs of the jobs.
+const (
+	JobRunSucceeded = "SUCCEEDED"
+	JobRunRunning   = "RUNNING"
+	JobRunFailed    = "FAILED"
+	JobRunTimedOut  = "TIMED_OUT"
+	JobRunAborted   = "ABORTED"
+)
+
+// Symbols of the statuses of the runs in the strip of recent runs of a job.
+const (
+	jobRunSucceededSymbol = "✔"
+	jobRunFailedSymbol    = "✘"
+	jobRunRunningSymbol   = "●"
+)
+
+// AppJob is a job deployed in an environment, with its most recent runs.
+type AppJob struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	// RecentRuns are the runs of the job from the most to the least recent.
+	RecentRuns []*AppJobRun `json:"recentRuns"`
+}
+
+// AppJobRun is the outcome of a run of a job.
+type AppJobRun struct {
+	StartedAt time.Time `json:"startedAt"`
+	Status    string    `json:"status"`
+}
+
+// symbol returns the colored symbol of the status of the run.
+func (r *AppJobRun) symbol() string {
+	switch r.Status {
+	case JobRunSucceeded:
+		return color.Green.Sprint(jobRunSucceededSymbol)
+	case JobRunRunning:
+		return color.Yellow.Sprint(jobRunRunningSymbol)
+	}
+	return color.Red.Sprint(jobRunFailedSymbol)
+}
+
+// AppArtifactBucket is the bucket that the pipelines store their artifacts in for an environment.
+// The bucket is shared by the environments of the same region, and has no environment if none is in its region.
+type AppArtifactBucket struct {
+	Region      string `json:"region"`
+	Bucket      string `json:"bucket"`
+	Environment string `json:"environment,omitempty"`
+	// ApproximateObjectCount is the latest daily count of objects in the bucket reported to CloudWatch, or nil if there's none.
+	ApproximateObjectCount *int64 `json:"approximateObjectCount,omitempty"`
+}
+
+// AppRunnerService contains the App Runner specifics of a Request-Driven Web Service deployed in an environment.
+type AppRunnerService struct {
+	Service       string                   `json:"service"`
+	Environment   string                   `json:"environment"`
+	ServiceARN    string                   `json:"serviceArn"`
+	Status        string                   `json:"status"`
+	URL           string                   `json:"url"`
+	CPU           string                   `json:"cpu,omitempty"`    // CPU units of the instances.
+	Memory        string                   `json:"memory,omitempty"` // MiB of memory of the instances.
+	CustomDomains []*AppRunnerCustomDomain `json:"customDomains"`
+}
+
+// AppRunnerCustomDomain is a custom domain associated with an App Runner service.
+type AppRunnerCustomDomain struct {
+	DomainName string `json:"domainName"`
+	Status     string `json:"status"`
+}
+
+// EnvCount returns the number of environments in the application.
+func (a *App) EnvCount() int {
+	return len(a.Envs)
+}
+
+// ServiceCount returns the number of services in the application, excluding jobs.
+func (a *App) ServiceCount() int {
+	var count int
+	for _, wkld := range a.Services {
+		if !isJob(wkld) {
+			count++
+		}
+	}
+	return count
+}
+
+// JobCount returns the number of jobs in the application.
+func (a *App) JobCount() int {
+	var count int
+	for _, wkld := range a.Services {
+		if isJob(wkld) {
+			count++
+		}
+	}
+	return count
+}
+
+// HealthyServiceCount returns the number of services that are deployed in at least one environment
+// and whose stacks aren't in a failed state in any of them.
+func (a *App) HealthyServiceCount() int {
+	deployed := make(map[string]bool)
+	for _, d := range a.Deployments {
+		if _, ok := deployed[d.Service]; !ok {
+			deployed[d.Service] = true
+		}
+		if cloudformation.StackStatus(d.StackStatus).Failure() {
+			deployed[d.Service] = false
+		}
+	}
+	var count int
+	for _, wkld := range a.Services {
+		if !isJob(wkld) && deployed[wkld.Name] {
+			count++
+		}
+	}
+	return count
+}
+
+func isJob(wkld *config.Workload) bool {
+	for _, jobType := range manifest.JobTypes {
+		if wkld.Type == jobType {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if the applications have the same description. Only the fields of the json format are compared,
+// and the order of the warnings is ignored since they're found concurrently.
+func (a *App) Equal(other *App) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	b1, err := json.Marshal(a.withSortedWarnings())
+	if err != nil {
+		return false
+	}
+	b2, err := json.Marshal(other.withSortedWarnings())
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(b1, b2)
+}
+
+// withSortedWarnings returns a shallow copy of the application whose warnings are sorted from the most to
+// the least severe, and by message.
+func (a *App) withSortedWarnings() *App {
+	sorted := *a
+	if a.Warnings != nil {
+		sorted.Warnings = make([]*AppWarning, len(a.Warnings))
+		copy(sorted.Warnings, a.Warnings)
+	}
+	sort.SliceStable(sorted.Warnings, func(i, j int) bool {
+		wi, wj := sorted.Warnings[i], sorted.Warnings[j]
+		if wi.Severity != wj.Severity {
+			return wi.AtLeast(wj.Severity)
+		}
+		return wi.Message < wj.Message
+	})
+	return &sorted
+}
+
+// AppAggregate contains the descriptions of several applications.
+type AppAggregate struct {
+	Apps []*App `json:"applications"`
+}
+
+// Merge combines the descriptions of applications into an aggregate sorted by application name, with the warnings
+// of each application sorted, so that the aggregate doesn't depend on the order the applications were described in.
+// An application described more than once is only included once, and it's an error if its descriptions aren't equal.
+func Merge(apps ...*App) (*AppAggregate, error) {
+	byName := make(map[string]*App)
+	var names []string
+	for _, app := range apps {
+		if prev, ok := byName[app.Name]; ok {
+			if !prev.Equal(app) {
+				return nil, fmt.Errorf("application %s is described more than once with different descriptions", app.Name)
+			}
+			continue
+		}
+		byName[app.Name] = app.withSortedWarnings()
+		names = append(names, app.Name)
+	}
+	sort.Strings(names)
+	aggregate := &AppAggregate{}
+	for _, name := range names {
+		aggregate.Apps = append(aggregate.Apps, byName[name])
+	}
+	return aggregate, nil
+}
+
+// JSONString returns the stringified App struct with json format, without the fields of opts.OmitJSON.
+// The keys of the map fields, like the tags, are emitted in sorted order by encoding/json and kept in that order
+// when fields are omitted or indented, so the same description is always byte-identical for diffs between snapshots.
+func (a *App) JSONString(opts AppRenderOptions) (string, error) {
 	b, err := json.Marshal(a)
 	if err != nil {
 		return "", fmt.Errorf("marshal application description: %w", err)
 	}
+	if len(opts.OmitJSON) != 0 {
+		paths := make([][]string, len(opts.OmitJSON))
+		for i, path := range opts.OmitJSON {
+			paths[i] = strings.Split(path, jsonPathSep)
+		}
+		b, err = omitJSON(b, paths)
+		if err != nil {
+			return "", fmt.Errorf("omit fields of application description: %w", err)
+		}
+	}
+	if opts.IndentJSON {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, b, "", "  "); err != nil {
+			return "", fmt.Errorf("indent application description: %w", err)
+		}
+		b = indented.Bytes()
+	}
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+// CSVString returns the deployments of the App struct with csv format, one row per service deployed in an environment.
+// The endpoint is all the endpoints of the deployment separated by spaces if they were retrieved, or else the URL of
+// the App Runner service if it was described. It's empty for the other services.
+func (a *App) CSVString() (string, error) {
+	types := make(map[string]string)
+	for _, svc := range a.Services {
+		types[svc.Name] = svc.Type
+	}
+	type deployedIn struct {
+		svc string
+		env string
+	}
+	endpoints := make(map[deployedIn]string)
+	for _, svc := range a.AppRunnerServices {
+		endpoints[deployedIn{svc: svc.Service, env: svc.Environment}] = svc.URL
+	}
+	records := [][]string{{"app", "environment", "service", "type", "endpoint", "status"}}
+	for _, d := range a.Deployments {
+		endpoint := endpoints[deployedIn{svc: d.Service, env: d.Environment}]
+		if len(d.Endpoints) != 0 {
+			urls := make([]string, len(d.Endpoints))
+			for i, e := range d.Endpoints {
+				urls[i] = e.URL
+			}
+			endpoint = strings.Join(urls, " ")
+		}
+		records = append(records, []string{a.Name, d.Environment, d.Service, types[d.Service], endpoint, d.StackStatus})
+	}
+	var b bytes.Buffer
+	if err := csv.NewWriter(&b).WriteAll(records); err != nil {
+		return "", fmt.Errorf("write csv: %w", err)
+	}
+	return b.String(), nil
+}
+
 // HumanString returns the stringified App struct with human readable format.
-func (a *App) HumanString() string {
+func (a *App) HumanString(opts AppRenderOptions) string {
+	sources := opts.Sources
+	if sources == nil {
+		sources = &AppSources{}
+	}
 	var b bytes.Buffer
 	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
 	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
 	writer.Flush()
-	fmt.Fprintf(writer, "  %s\t%s\n", "Name", a.Name)
-	fmt.Fprintf(writer, "  %s\t%s\n", "URI", a.URI)
+	rows := [][]string{append([]string{"Name", a.Name}, sources.Name.annotation()...)}
+	if a.URI != "" {
+		rows = append(rows, append([]string{"URI", a.URI}, sources.URI.annotation()...))
+	}
+	if a.Owner != "" {
+		rows = append(rows, []string{"Owner", a.Owner})
+	}
+	if a.DerivedFromStacks {
+		rows = append(rows, []string{"Source", color.Yellow.Sprint(AppDerivedFromStacks)})
+	}
+	if a.WorkspaceCommit != "" {
+		rows = append(rows, []string{"Workspace", fmt.Sprintf(fmtWorkspaceCommit, a.WorkspaceCommit)})
+	}
+	if a.HealthScore != nil {
+		rows = append(rows, []string{"Health", healthGauge(*a.HealthScore)})
+	}
+	if opts.ShowTags && len(a.Tags) != 0 {
+		rows = append(rows, []string{"Tags", compactTags(a.Tags)})
+	}
+	if opts.ShowResources && a.Allocation != nil {
+		rows = append(rows, []string{"Allocation", a.Allocation.String()})
+	}
+	writeTable(writer, rows, opts.Width)
 	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "AccountID", "Region"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, env := range a.Envs {
-		fmt.Fprintf(writer, "  %s\t%s\t%s\n", env.Name, env.AccountID, env.Region)
+	if len(a.Namespaces) != 0 {
+		headers = append(headers, "Namespace")
+	}
+	if len(a.LastDeployedBy) != 0 {
+		headers = append(headers, "Last Deployed By")
+	}
+	rows = [][]string{headers, underline(headers)}
+	isStackSetEnv := make(map[string]bool)
+	for _, name := range a.StackSetEnvs {
+		isStackSetEnv[name] = true
 	}
+	for _, env := range a.sortedEnvs(opts.EnvSort) {
+		row := []string{env.Name, env.AccountID, env.Region}
+		if len(a.Namespaces) != 0 {
+			namespace := "-"
+			if ns, ok := a.Namespaces[env.Name]; ok {
+				namespace = ns.Name
+			}
+			row = append(row, namespace)
+		}
+		if len(a.LastDeployedBy) != 0 {
+			row = append(row, valueOrDash(a.LastDeployedBy[env.Name]))
+		}
+		row = append(row, a.envStatusAnnotation(env.Name)...)
+		if isStackSetEnv[env.Name] {
+			row = append(row, color.Faint.Sprint(EnvStackSetInstance))
+		}
+		rows = append(rows, append(row, sourceOf(sources.Environments, env.Name).annotation()...))
+	}
+	writeTable(writer, rows, opts.Width)
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	headers = []string{"Name", "Type"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	rows = [][]string{headers, underline(headers)}
+	notDeployed := make(map[string]bool)
+	for _, name := range a.NotDeployed {
+		notDeployed[name] = true
+	}
 	for _, svc := range a.Services {
-		fmt.Fprintf(writer, "  %s\t%s\n", svc.Name, svc.Type)
+		row := []string{svc.Name, valueOrDash(svc.Type)}
+		if notDeployed[svc.Name] {
+			row = append(row, color.Faint.Sprint(ServiceNotDeployed))
+		}
+		row = append(row, a.offlineAnnotation(svc.Name)...)
+		rows = append(rows, append(row, sourceOf(sources.Services, svc.Name).annotation()...))
 	}
+	writeTable(writer, rows, opts.Width)
 	fmt.Fprint(writer, color.Bold.Sprint("\nPipelines\n\n"))
 	writer.Flush()
-	headers = []string{"Name"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, pipeline := range a.Pipelines {
-		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
+	if a.PipelinesSkipped {
+		rows = [][]string{{pipelinesSkipped}}
+	} else {
+		withConnections, withBuilds := hasConnection(a.Pipelines), hasBuild(a.Pipelines)
+		headers = []string{"Name"}
+		if withConnections {
+			headers = append(headers, "Provider", "Connection Status", "Connection ARN")
+		}
+		if withBuilds {
+			headers = append(headers, "Build Project", "Last Build", "Build Link")
+		}
+		rows = [][]string{headers, underline(headers)}
+		for _, pipeline := range a.Pipelines {
+			row := []string{pipeline.Name}
+			if withConnections {
+				provider, status, connARN := "-", "-", "-"
+				if conn := pipeline.Connection; conn != nil {
+					provider, status, connARN = valueOrDash(conn.ProviderType), valueOrDash(conn.Status), conn.ARN
+				}
+				row = append(row, provider, status, connARN)
+			}
+			if withBuilds {
+				project, status, link := "-", "-", "-"
+				if build := pipeline.Build; build != nil {
+					project = build.Project
+					if last := build.Last; last != nil {
+						status, link = fmtBuildStatus(last.Status), last.URL
+					}
+				}
+				row = append(row, project, status, link)
+			}
+			rows = append(rows, append(row, sourceOf(sources.Pipelines, pipeline.Name).annotation()...))
+		}
+	}
+	writeTable(writer, rows, opts.Width)
+	writer.Flush()
+	var dittoed bool
+	if opts.ShowPipelineStages && len(a.PipelineStages) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPipeline Stages\n\n"))
+		writer.Flush()
+		dittoed = appPipelineStages(a.PipelineStages).humanString(writer, opts.Width)
+	}
+	if len(a.Dependencies) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		appDependencies(a.Dependencies).humanString(writer, opts.Width)
+	}
+	if len(a.DomainConflicts) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDomain Conflicts\n\n"))
+		writer.Flush()
+		dittoed = appDomainConflicts(a.DomainConflicts).humanString(writer, opts.Width) || dittoed
+	}
+	if endpoints := appEndpoints(a.Deployments); opts.ShowResources && endpoints.any() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEndpoints\n\n"))
+		writer.Flush()
+		dittoed = endpoints.humanString(writer, opts.Width) || dittoed
+	}
+	if len(a.InsecureEndpoints) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nInsecure Endpoints\n\n"))
+		writer.Flush()
+		dittoed = appInsecureEndpoints(a.InsecureEndpoints).humanString(writer, opts.Width) || dittoed
+	}
+	if len(a.Secrets) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecrets\n\n"))
+		writer.Flush()
+		dittoed = appSecrets(a.Secrets).humanString(writer, opts.Width) || dittoed
+	}
+	if opts.ShowResources && len(a.Deployments) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nTask Definitions\n\n"))
+		writer.Flush()
+		dittoed = appTaskDefinitions(a.Deployments).humanString(writer, opts.Width) || dittoed
+	}
+	if alarms := appAlarms(a.Deployments); opts.ShowResources && alarms.any() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
+		writer.Flush()
+		dittoed = alarms.humanString(writer, opts.Width) || dittoed
+	}
+	if storage := appStorage(a.Deployments); opts.ShowResources && storage.any() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nStorage\n\n"))
+		writer.Flush()
+		dittoed = storage.humanString(writer, opts.Width) || dittoed
+	}
+	if acls := appWebACLs(a.Deployments).protected(); opts.ShowResources && len(acls) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nWeb ACLs\n\n"))
+		writer.Flush()
+		dittoed = acls.humanString(writer, opts.Width) || dittoed
+	}
+	if drifted := appDrift(a.Deployments); drifted.any() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDrift\n\n"))
+		writer.Flush()
+		dittoed = drifted.humanString(writer, opts.Width) || dittoed
+	}
+	if changed := appChanges(a.Deployments); changed.any() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSince Last Deploy\n\n"))
+		writer.Flush()
+		dittoed = changed.humanString(writer, opts.Width) || dittoed
+	}
+	if opts.ShowResources && len(a.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		dittoed = appServiceConnect(a.ServiceConnect).humanString(writer, opts.Width) || dittoed
+	}
+	if len(a.Jobs) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nJob Runs\n\n"))
+		writer.Flush()
+		dittoed = appJobs(a.Jobs).humanString(writer, opts.Width) || dittoed
+	}
+	if opts.ShowResources && len(a.ArtifactBuckets) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nArtifact Buckets\n\n"))
+		writer.Flush()
+		dittoed = appArtifactBuckets(a.ArtifactBuckets).humanString(writer, opts.Width) || dittoed
+	}
+	if artifacts := appPipelineArtifacts(a.Pipelines).stored(); opts.ShowResources && len(artifacts) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPipeline Artifacts\n\n"))
+		writer.Flush()
+		artifacts.humanString(writer, opts.Width)
+	}
+	if opts.ShowResources && len(a.TerminationProtection) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nTermination Protection\n\n"))
+		writer.Flush()
+		appTerminationProtection(a.TerminationProtection).humanString(writer, opts.Width)
+	}
+	if logged := appLogging(a.Deployments).logged(); len(logged) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nLogging\n\n"))
+		writer.Flush()
+		dittoed = logged.humanString(writer, opts.Width) || dittoed
+	}
+	if opts.ShowTags && len(a.EnvTags) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEnvironment Tags\n\n"))
+		writer.Flush()
+		headers := []string{"Environment", "Tags"}
+		rows := [][]string{headers, underline(headers)}
+		for _, env := range a.sortedEnvs(opts.EnvSort) {
+			if tags, ok := a.EnvTags[env.Name]; ok {
+				rows = append(rows, []string{env.Name, compactTags(tags)})
+			}
+		}
+		writeTable(writer, rows, opts.Width)
+	}
+	if tagged := appServiceTags(a.Deployments).tagged(); opts.ShowTags && len(tagged) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Tags\n\n"))
+		writer.Flush()
+		dittoed = tagged.humanString(writer, opts.Width) || dittoed
+	}
+	if len(a.AppRunnerServices) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nApp Runner Services\n\n"))
+		writer.Flush()
+		dittoed = appRunnerServices(a.AppRunnerServices).humanString(writer, opts.Width) || dittoed
+	}
+	if a.CostControls != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCost Controls\n\n"))
+		writer.Flush()
+		a.CostControls.humanString(writer, opts.Width)
+	}
+	if len(a.Warnings) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nWarnings\n\n"))
+		writer.Flush()
+		for _, warning := range a.Warnings {
+			fmt.Fprintf(writer, "  %s\t%s\n", warning.Severity, warning.colored())
+		}
+	}
+	writer.Flush()
+	if legend := a.legend(dittoed, opts.Sources != nil); !opts.HideLegend && len(legend) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nLegend\n\n"))
+		writer.Flush()
+		for _, entry := range legend {
+			entry.humanString(writer)
+		}
 	}
 	writer.Flush()
 	return b.String()
 }
+
+// pipelinesSkipped replaces the table of the pipelines when they weren't looked up.
+const pipelinesSkipped = "(skipped)"
+
+// legendGlyph is colored to explain the colors of the warnings in the legend.
+const legendGlyph = "●"
+
+// legendEntry explains a symbol or color of the human readable format.
+type legendEntry struct {
+	symbol      string // Can be colored.
+	description string
+}
+
+// Descriptions of the severities of the warnings in the legend.
+var warningSeverityDescriptions = map[string]string{
+	WarningSeverityInfo:    "An expected transient state, like a service that is still being created.",
+	WarningSeverityWarning: "An issue that needs attention but doesn't affect the running services yet.",
+	WarningSeverityError:   "A failure of the application's resources, like a rolled back deployment.",
+}
+
+// sortedEnvs returns a copy of the environments in an order of EnvSortOrders. Ties are broken by name.
+func (a *App) sortedEnvs(order string) []*config.Environment {
+	envs := make([]*config.Environment, len(a.Envs))
+	copy(envs, a.Envs)
+	var less func(i, j int) bool
+	switch order {
+	case EnvSortName:
+		less = func(i, j int) bool { return envs[i].Name < envs[j].Name }
+	case EnvSortRecency:
+		less = func(i, j int) bool {
+			ti, tj := a.EnvLastDeployedAt[envs[i].Name], a.EnvLastDeployedAt[envs[j].Name]
+			if !ti.Equal(tj) {
+				return ti.After(tj)
+			}
+			return envs[i].Name < envs[j].Name
+		}
+	case EnvSortProd:
+		less = func(i, j int) bool {
+			if envs[i].Prod != envs[j].Prod {
+				return envs[i].Prod
+			}
+			return envs[i].Name < envs[j].Name
+		}
+	default:
+		return envs
+	}
+	sort.SliceStable(envs, less)
+	return envs
+}
+
+// legend returns the entries explaining the symbols and colors that are rendered, from the most severe warnings
+// to the annotations. Warnings are explained by their color, or by their severity label if colors are disabled.
+func (a *App) legend(dittoed, sourced bool) []legendEntry {
+	severities := make(map[string]bool)
+	for _, warning := range a.Warnings {
+		severities[warning.Severity] = true
+	}
+	var entries []legendEntry
+	for i := len(WarningSeverities) - 1; i >= 0; i-- {
+		severity := WarningSeverities[i]
+		if !severities[severity] {
+			continue
+		}
+		description := warningSeverityDescriptions[severity]
+		if !color.Enabled() {
+			entries = append(entries, legendEntry{symbol: severity, description: description})
+			continue
+		}
+		glyph := (&AppWarning{Severity: severity, Message: legendGlyph}).colored()
+		entries = append(entries, legendEntry{symbol: glyph, description: fmt.Sprintf("%s: %s", severity, description)})
+	}
+	entries = append(entries, appJobs(a.Jobs).runSymbols()...)
+	if dittoed {
+		entries = append(entries, legendEntry{symbol: strings.TrimSpace(dittoSymbol), description: "The same value as in the row above."})
+	}
+	if sourced {
+		entries = append(entries, legendEntry{symbol: color.Faint.Sprint("(from ...)"), description: "The AWS resource that the value is retrieved from."})
+	}
+	return entries
+}
+
+// humanString writes the entry on a line of its own. The symbol is padded by hand instead of with a tab,
+// since the escape sequences of colored symbols would throw off the alignment of the tabwriter.
+func (e legendEntry) humanString(w io.Writer) {
+	padding := minCellWidth - len(tableIndent) - utf8.RuneCountInString(color.Strip(e.symbol))
+	if padding < cellPaddingWidth {
+		padding = cellPaddingWidth
+	}
+	fmt.Fprintf(w, "%s%s%s%s\n", tableIndent, e.symbol, strings.Repeat(" ", padding), e.description)
+}
+
+// envStatusAnnotation returns a highlighted cell with the status of the environment's stack unless it was
+// provisioned successfully. There is no cell if the status of the environment wasn't retrieved.
+func (a *App) envStatusAnnotation(env string) []string {
+	status, ok := a.EnvStatuses[env]
+	stackStatus := cloudformation.StackStatus(status)
+	if !ok || stackStatus.Success() {
+		return nil
+	}
+	switch {
+	case stackStatus.Failure(), status == EnvStatusAccountUnreachable:
+		return []string{color.Red.Sprint(status)}
+	case stackStatus.InProgress():
+		return []string{color.Yellow.Sprint(status)}
+	}
+	return []string{color.Faint.Sprint(status)}
+}
+
+// offlineAnnotation returns a highlighted cell with the environments that the service is deployed in without serving
+// any traffic, like "scaled to zero in test, prod". There is no cell if it serves in all of them.
+func (a *App) offlineAnnotation(svc string) []string {
+	envsByState := make(map[string][]string)
+	var states []string
+	for _, d := range a.Deployments {
+		if d.Service != svc || d.Offline == "" {
+			continue
+		}
+		if _, ok := envsByState[d.Offline]; !ok {
+			states = append(states, d.Offline)
+		}
+		envsByState[d.Offline] = append(envsByState[d.Offline], d.Environment)
+	}
+	if len(states) == 0 {
+		return nil
+	}
+	offline := make([]string, len(states))
+	for i, state := range states {
+		offline[i] = fmt.Sprintf("%s in %s", state, strings.Join(envsByState[state], ", "))
+	}
+	return []string{color.Yellow.Sprint(strings.Join(offline, "; "))}
+}
+
+// colored returns the message of the warning highlighted according to its severity.
+func (w *AppWarning) colored() string {
+	switch w.Severity {
+	case WarningSeverityError:
+		return color.Red.Sprint(w.Message)
+	case WarningSeverityInfo:
+		return color.Faint.Sprint(w.Message)
+	}
+	return color.Yellow.Sprint(w.Message)
+}
+
+// hasConnection returns true if any of the pipelines has a source connection.
+func hasConnection(pipelines []*codepipeline.Pipeline) bool {
+	for _, pipeline := range pipelines {
+		if pipeline.Connection != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBuild returns true if any of the pipelines has a build project.
+func hasBuild(pipelines []*codepipeline.Pipeline) bool {
+	for _, pipeline := range pipelines {
+		if pipeline.Build != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// fmtBuildStatus highlights the status of a build that failed.
+func fmtBuildStatus(status string) string {
+	if status == "Failed" {
+		return color.Red.Sprint(status)
+	}
+	return valueOrDash(status)
+}
+
+// valueOrDash returns the value, or a dash if it's empty.
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// sourceOf returns the sourced value matching value, or an empty Sourced if its source isn't known.
+func sourceOf(sourced []Sourced, value string) Sourced {
+	for _, s := range sourced {
+		if s.Value == value {
+			return s
+		}
+	}
+	return Sourced{}
+}
+
+// annotation returns a dim cell with the source of the value, or no cell if its source isn't known.
+func (s Sourced) annotation() []string {
+	if s.Source == "" {
+		return nil
+	}
+	return []string{color.Faint.Sprintf("(from %s)", s.Source)}
+}
+
+type appRunnerServices []*AppRunnerService
+
+// humanString writes a row for each App Runner service grouped by service. Repeated service names are dittoed.
+// It returns true if any service name was dittoed.
+func (s appRunnerServices) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Status", "Custom Domains", "Service ARN"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appRunnerServices, len(s))
+	copy(sorted, s)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for i, svc := range sorted {
+		domains := "-"
+		if len(svc.CustomDomains) != 0 {
+			var names []string
+			for _, domain := range svc.CustomDomains {
+				names = append(names, fmt.Sprintf("%s (%s)", domain.DomainName, domain.Status))
+			}
+			domains = strings.Join(names, ", ")
+		}
+		name := svc.Service
+		if i > 0 && sorted[i-1].Service == svc.Service {
+			name = dittoSymbol
+			dittoed = true
+		}
+		rows = append(rows, []string{name, svc.Environment, svc.Status, domains, svc.ServiceARN})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appTaskDefinitions []*AppDeployment
+
+// humanString writes the task definition of each deployment grouped by service, and its allocation and deployment
+// controller if any were retrieved. Repeated service names are dittoed. It returns true if any service name was dittoed.
+func (d appTaskDefinitions) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Task Definition"}
+	var withAllocation, withController bool
+	for _, deployment := range d {
+		withAllocation = withAllocation || deployment.CPU != "" || deployment.Memory != ""
+		withController = withController || deployment.DeploymentController != ""
+	}
+	if withAllocation {
+		headers = append(headers, "CPU/Memory")
+	}
+	if withController {
+		headers = append(headers, "Deployment Controller")
+	}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appTaskDefinitions, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for i, deployment := range sorted {
+		name := deployment.Service
+		if i > 0 && sorted[i-1].Service == deployment.Service {
+			name = dittoSymbol
+			dittoed = true
+		}
+		row := []string{name, deployment.Environment, valueOrDash(deployment.TaskDefinition)}
+		if withAllocation {
+			allocation := "-"
+			switch {
+			case deployment.CPU == AllocationUnknown && deployment.Memory == AllocationUnknown:
+				allocation = AllocationUnknown
+			case deployment.CPU != "" || deployment.Memory != "":
+				allocation = fmt.Sprintf(fmtAllocation, valueOrDash(deployment.CPU), valueOrDash(deployment.Memory))
+			}
+			row = append(row, allocation)
+		}
+		if withController {
+			controller := valueOrDash(deployment.DeploymentController)
+			if deployment.DeploymentState != "" {
+				controller = fmt.Sprintf("%s (%s)", controller, deployment.DeploymentState)
+			}
+			row = append(row, controller)
+		}
+		rows = append(rows, row)
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appAlarms []*AppDeployment
+
+// any returns true if any of the deployments has alarms.
+func (d appAlarms) any() bool {
+	for _, deployment := range d {
+		if len(deployment.Alarms) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// humanString writes a row for each alarm of the deployments grouped by service. Repeated service names are dittoed.
+// It returns true if any service name was dittoed.
+func (d appAlarms) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Alarm", "Metric", "Threshold"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appAlarms, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	var prevSvc string
+	for _, deployment := range sorted {
+		for _, alarm := range deployment.Alarms {
+			name := deployment.Service
+			if len(rows) > 2 && prevSvc == deployment.Service {
+				name = dittoSymbol
+				dittoed = true
+			}
+			prevSvc = deployment.Service
+			threshold := "-"
+			if alarm.Threshold != nil {
+				threshold = strconv.FormatFloat(*alarm.Threshold, 'f', -1, 64)
+			}
+			rows = append(rows, []string{name, deployment.Environment, alarm.Name, valueOrDash(alarm.Metric), threshold})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appStorage []*AppDeployment
+
+// any returns true if any of the deployments mounts a persistent volume.
+func (d appStorage) any() bool {
+	for _, deployment := range d {
+		if len(deployment.Storage) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// humanString writes a row for each persistent volume of the deployments grouped by service. Repeated service names
+// are dittoed. It returns true if any service name was dittoed.
+func (d appStorage) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Volume", "File System", "Access Point"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appStorage, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	var prevSvc string
+	for _, deployment := range sorted {
+		for _, volume := range deployment.Storage {
+			name := deployment.Service
+			if len(rows) > 2 && prevSvc == deployment.Service {
+				name = dittoSymbol
+				dittoed = true
+			}
+			prevSvc = deployment.Service
+			rows = append(rows, []string{name, deployment.Environment, volume.Name, volume.FileSystemID, valueOrDash(volume.AccessPointID)})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appTerminationProtection map[string]bool
+
+// humanString writes a row with whether termination protection is enabled on each stack, sorted by stack name.
+func (p appTerminationProtection) humanString(w io.Writer, width int) {
+	headers := []string{"Stack", "Termination Protection"}
+	rows := [][]string{headers, underline(headers)}
+	stacks := make([]string, 0, len(p))
+	for name := range p {
+		stacks = append(stacks, name)
+	}
+	sort.Strings(stacks)
+	for _, name := range stacks {
+		protection := terminationProtectionDisabled
+		if p[name] {
+			protection = terminationProtectionEnabled
+		}
+		rows = append(rows, []string{name, protection})
+	}
+	writeTable(w, rows, width)
+}
+
+type appWebACLs []*AppDeployment
+
+// protected returns the deployments whose web ACL was looked up, sorted by service.
+func (d appWebACLs) protected() appWebACLs {
+	var protected appWebACLs
+	for _, deployment := range d {
+		if deployment.WebACL != "" {
+			protected = append(protected, deployment)
+		}
+	}
+	sort.SliceStable(protected, func(i, j int) bool { return protected[i].Service < protected[j].Service })
+	return protected
+}
+
+// humanString writes a row with the web ACL of each deployment. Repeated service names are dittoed.
+// It returns true if any service name was dittoed.
+func (d appWebACLs) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Web ACL"}
+	rows := [][]string{headers, underline(headers)}
+	for i, deployment := range d {
+		name := deployment.Service
+		if i > 0 && d[i-1].Service == deployment.Service {
+			name = dittoSymbol
+			dittoed = true
+		}
+		rows = append(rows, []string{name, deployment.Environment, deployment.WebACL})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appDependencies []*ServiceDependencies
+
+// humanString writes a row with what each service depends on, sorted by service.
+func (d appDependencies) humanString(w io.Writer, width int) {
+	headers := []string{"Service", "Depends On"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appDependencies, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for _, dependency := range sorted {
+		rows = append(rows, []string{dependency.Service, strings.Join(dependency.DependsOn, ", ")})
+	}
+	writeTable(w, rows, width)
+}
+
+type appDomainConflicts []*AppDomainConflict
+
+// humanString writes a row for each claim of the conflicting domains, in red. Repeated domains are dittoed.
+// It returns true if any domain was dittoed.
+func (c appDomainConflicts) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Domain", "Service", "Environment"}
+	rows := [][]string{headers, underline(headers)}
+	for _, conflict := range c {
+		for i, claim := range conflict.Claims {
+			domain := color.Red.Sprint(conflict.Domain)
+			if i > 0 {
+				domain = dittoSymbol
+				dittoed = true
+			}
+			rows = append(rows, []string{domain, claim.Service, claim.Environment})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appServiceTags []*AppDeployment
+
+// tagged returns the deployments that have tags.
+func (d appServiceTags) tagged() appServiceTags {
+	var tagged appServiceTags
+	for _, deployment := range d {
+		if len(deployment.Tags) != 0 {
+			tagged = append(tagged, deployment)
+		}
+	}
+	return tagged
+}
+
+// humanString writes the tags of each deployment grouped by service. Repeated service names are dittoed.
+// It returns true if any service name was dittoed.
+func (d appServiceTags) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Tags"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appServiceTags, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for i, deployment := range sorted {
+		name := deployment.Service
+		if i > 0 && sorted[i-1].Service == deployment.Service {
+			name = dittoSymbol
+			dittoed = true
+		}
+		rows = append(rows, []string{name, deployment.Environment, compactTags(deployment.Tags)})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appJobs []*AppJob
+
+// humanString writes the recent runs of each job grouped by job, as a strip from the least to the most recent run
+// followed by the start time of the last run. Repeated job names are dittoed. It returns true if any value was dittoed.
+func (j appJobs) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Job", "Environment", "Recent Runs", "Last Run"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appJobs, len(j))
+	copy(sorted, j)
+	sort.SliceStable(sorted, func(i, k int) bool { return sorted[i].Name < sorted[k].Name })
+	for i, job := range sorted {
+		name := job.Name
+		if i > 0 && sorted[i-1].Name == job.Name {
+			name = dittoSymbol
+			dittoed = true
+		}
+		strip, lastRun := "-", "-"
+		if len(job.RecentRuns) != 0 {
+			symbols := make([]string, len(job.RecentRuns))
+			for k, run := range job.RecentRuns {
+				symbols[len(job.RecentRuns)-1-k] = run.symbol()
+			}
+			strip = strings.Join(symbols, " ")
+			lastRun = job.RecentRuns[0].StartedAt.UTC().Format(time.RFC3339)
+		}
+		rows = append(rows, []string{name, job.Environment, strip, lastRun})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+// runSymbols returns the legend entries of the statuses of the runs of the jobs.
+func (j appJobs) runSymbols() []legendEntry {
+	statuses := make(map[string]bool)
+	for _, job := range j {
+		for _, run := range job.RecentRuns {
+			switch run.Status {
+			case JobRunSucceeded, JobRunRunning:
+				statuses[run.Status] = true
+			default:
+				statuses[JobRunFailed] = true
+			}
+		}
+	}
+	var entries []legendEntry
+	if statuses[JobRunSucceeded] {
+		entries = append(entries, legendEntry{symbol: color.Green.Sprint(jobRunSucceededSymbol), description: "A run of the job that succeeded."})
+	}
+	if statuses[JobRunFailed] {
+		entries = append(entries, legendEntry{symbol: color.Red.Sprint(jobRunFailedSymbol), description: "A run of the job that failed, timed out or was aborted."})
+	}
+	if statuses[JobRunRunning] {
+		entries = append(entries, legendEntry{symbol: color.Yellow.Sprint(jobRunRunningSymbol), description: "A run of the job that is still running."})
+	}
+	return entries
+}
+
+type appArtifactBuckets []*AppArtifactBucket
+
+// humanString writes the artifact bucket of each environment grouped by region. Repeated regions and buckets are dittoed.
+// It returns true if any value was dittoed.
+func (b appArtifactBuckets) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Region", "Bucket", "Environment", "Objects"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appArtifactBuckets, len(b))
+	copy(sorted, b)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Region < sorted[j].Region })
+	for i, bucket := range sorted {
+		region, name := bucket.Region, bucket.Bucket
+		if i > 0 && sorted[i-1].Region == bucket.Region {
+			region, name = dittoSymbol, dittoSymbol
+			dittoed = true
+		}
+		objects := "-"
+		if bucket.ApproximateObjectCount != nil {
+			objects = fmt.Sprintf("~%d", *bucket.ApproximateObjectCount)
+		}
+		rows = append(rows, []string{region, name, valueOrDash(bucket.Environment), objects})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+type appLogging []*AppDeployment
+
+// logged returns the deployments whose logging configuration was looked up.
+func (d appLogging) logged() appLogging {
+	var logged appLogging
+	for _, deployment := range d {
+		if deployment.Logging != "" {
+			logged = append(logged, deployment)
+		}
+	}
+	return logged
+}
+
+// humanString writes the logging configuration of each deployment grouped by service. Repeated service names are dittoed.
+// It returns true if any service name was dittoed.
+func (d appLogging) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Logging", "Log Group", "Retention"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appLogging, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for i, deployment := range sorted {
+		name := deployment.Service
+		if i > 0 && sorted[i-1].Service == deployment.Service {
+			name = dittoSymbol
+			dittoed = true
+		}
+		logging := deployment.Logging
+		if logging == LoggingDisabled {
+			logging = color.Yellow.Sprint(logging)
+		}
+		rows = append(rows, []string{name, deployment.Environment, logging, valueOrDash(deployment.LogGroup), valueOrDash(deployment.LogRetention)})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+// compactTags returns the tags as comma-separated key=value pairs sorted by key.
+func compactTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+type appSecrets []*AppSecret
+
+// humanString writes the secrets grouped by service. Values repeated from the previous row are dittoed.
+// It returns true if any value was dittoed.
+func (s appSecrets) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Name", "Source", "Value From"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appSecrets, len(s))
+	copy(sorted, s)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Environment < sorted[j].Environment })
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	for i, secret := range sorted {
+		cols := []string{secret.Service, secret.Environment, secret.Name, secret.Source, secret.ValueFrom}
+		if i > 0 && sorted[i-1].Service == secret.Service {
+			cols[0] = dittoSymbol
+			dittoed = true
+			if sorted[i-1].Environment == secret.Environment {
+				cols[1] = dittoSymbol
+			}
+		}
+		rows = append(rows, cols)
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
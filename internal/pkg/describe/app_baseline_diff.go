@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	fmtBaselineMatches = "Application %s matches baseline %s.\n"
+	fmtBaselineDiffers = "Application %s differs from baseline %s in %d %s.\n\n"
+)
+
+// AppDifference is a field of the json description of an application whose value differs from a baseline.
+type AppDifference struct {
+	Path     string `json:"path"`     // Path of the field, like "environments[prod].region" or "warnings[0]".
+	Baseline string `json:"baseline"` // Json value of the field in the baseline, empty if the field isn't in the baseline.
+	Live     string `json:"live"`     // Json value of the field in the live description, empty if the field isn't in it.
+}
+
+// Diff returns the fields of the json description of the application that differ from the baseline.
+// Like Equal, the order of the warnings is ignored. The elements of the lists whose elements all have a name,
+// like the environments and services, are matched by name, and the elements of the other lists by index.
+func (a *App) Diff(baseline *App) ([]*AppDifference, error) {
+	if a.Equal(baseline) {
+		return nil, nil
+	}
+	live, err := genericJSON(a)
+	if err != nil {
+		return nil, err
+	}
+	base, err := genericJSON(baseline)
+	if err != nil {
+		return nil, err
+	}
+	return diffJSON("", base, true, live, true, nil), nil
+}
+
+// genericJSON returns the json description of the application decoded into maps, slices and values.
+func genericJSON(a *App) (interface{}, error) {
+	if a == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(a.withSortedWarnings())
+	if err != nil {
+		return nil, fmt.Errorf("marshal application description: %w", err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("unmarshal application description: %w", err)
+	}
+	return v, nil
+}
+
+// diffJSON appends the differences between the baseline and live values at the path to diffs.
+// inBaseline and inLive are false if the field is missing from the baseline or the live description.
+func diffJSON(path string, baseline interface{}, inBaseline bool, live interface{}, inLive bool, diffs []*AppDifference) []*AppDifference {
+	if inBaseline && inLive {
+		switch b := baseline.(type) {
+		case map[string]interface{}:
+			if l, ok := live.(map[string]interface{}); ok {
+				return diffJSONObjects(path, b, l, diffs)
+			}
+		case []interface{}:
+			if l, ok := live.([]interface{}); ok {
+				return diffJSONLists(path, b, l, diffs)
+			}
+		}
+		if reflect.DeepEqual(baseline, live) {
+			return diffs
+		}
+	}
+	return append(diffs, &AppDifference{
+		Path:     path,
+		Baseline: jsonValue(baseline, inBaseline),
+		Live:     jsonValue(live, inLive),
+	})
+}
+
+// diffJSONObjects compares the fields of the two objects, sorted by key.
+func diffJSONObjects(path string, baseline, live map[string]interface{}, diffs []*AppDifference) []*AppDifference {
+	keys := make(map[string]bool)
+	for key := range baseline {
+		keys[key] = true
+	}
+	for key := range live {
+		keys[key] = true
+	}
+	var sorted []string
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	for _, key := range sorted {
+		b, inBaseline := baseline[key]
+		l, inLive := live[key]
+		field := key
+		if path != "" {
+			field = path + "." + key
+		}
+		diffs = diffJSON(field, b, inBaseline, l, inLive, diffs)
+	}
+	return diffs
+}
+
+// diffJSONLists compares the elements of the two lists by name if they all have one, and by index otherwise.
+// Matched by name, the elements of the baseline come first, followed by the ones only in the live description.
+func diffJSONLists(path string, baseline, live []interface{}, diffs []*AppDifference) []*AppDifference {
+	baseNames, baseNamed := jsonNames(baseline)
+	liveNames, liveNamed := jsonNames(live)
+	if !baseNamed || !liveNamed {
+		for i := 0; i < len(baseline) || i < len(live); i++ {
+			var b, l interface{}
+			if i < len(baseline) {
+				b = baseline[i]
+			}
+			if i < len(live) {
+				l = live[i]
+			}
+			diffs = diffJSON(fmt.Sprintf("%s[%d]", path, i), b, i < len(baseline), l, i < len(live), diffs)
+		}
+		return diffs
+	}
+	liveByName := make(map[string]interface{})
+	for i, name := range liveNames {
+		liveByName[name] = live[i]
+	}
+	inBaseline := make(map[string]bool)
+	for i, name := range baseNames {
+		inBaseline[name] = true
+		l, inLive := liveByName[name]
+		diffs = diffJSON(fmt.Sprintf("%s[%s]", path, name), baseline[i], true, l, inLive, diffs)
+	}
+	for i, name := range liveNames {
+		if !inBaseline[name] {
+			diffs = diffJSON(fmt.Sprintf("%s[%s]", path, name), nil, false, live[i], true, diffs)
+		}
+	}
+	return diffs
+}
+
+// jsonNames returns the names of the elements of the list, and false if an element isn't an object with a unique name.
+func jsonNames(list []interface{}) ([]string, bool) {
+	names := make([]string, len(list))
+	seen := make(map[string]bool)
+	for i, elem := range list {
+		obj, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok || name == "" || seen[name] {
+			return nil, false
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names, true
+}
+
+// jsonValue returns the compact json encoding of the value, or an empty string if it's missing.
+func jsonValue(v interface{}, ok bool) string {
+	if !ok {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// AppBaselineDiff contains the differences between the live description of an application and a baseline snapshot
+// of its json description.
+type AppBaselineDiff struct {
+	App         string           `json:"app"`
+	Baseline    string           `json:"baseline"` // Path of the baseline file.
+	Differences []*AppDifference `json:"differences"`
+
+	// Width is the number of characters that the table of the human readable format is truncated to fit in.
+	// The table is not truncated if it's zero.
+	Width int `json:"-"`
+}
+
+// NewAppBaselineDiff returns the differences of the application from the baseline file.
+func NewAppBaselineDiff(app, baseline string, diffs []*AppDifference) *AppBaselineDiff {
+	return &AppBaselineDiff{
+		App:         app,
+		Baseline:    baseline,
+		Differences: diffs,
+	}
+}
+
+// JSONString returns the stringified AppBaselineDiff struct with json format.
+func (d *AppBaselineDiff) JSONString() (string, error) {
+	diffs := d.Differences
+	if diffs == nil {
+		// The differences are an empty list rather than null, for the consumers to iterate over them without a check.
+		diffs = []*AppDifference{}
+	}
+	b, err := json.Marshal(&AppBaselineDiff{
+		App:         d.App,
+		Baseline:    d.Baseline,
+		Differences: diffs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal baseline differences: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppBaselineDiff struct with human readable format.
+func (d *AppBaselineDiff) HumanString() string {
+	if len(d.Differences) == 0 {
+		return fmt.Sprintf(fmtBaselineMatches, color.HighlightUserInput(d.App), d.Baseline)
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, fmtBaselineDiffers, color.HighlightUserInput(d.App), d.Baseline, len(d.Differences), plural(len(d.Differences), "field"))
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Field", "Baseline", "Live"}
+	rows := [][]string{headers, underline(headers)}
+	for _, diff := range d.Differences {
+		rows = append(rows, []string{diff.Path, valueOrDash(diff.Baseline), valueOrDash(diff.Live)})
+	}
+	writeTable(writer, rows, d.Width)
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Diff(t *testing.T) {
+	testCases := map[string]struct {
+		inBaseline *App
+		inLive     *App
+
+		wantedDiffs []*AppDifference
+	}{
+		"returns no differences if only the order of the warnings differs": {
+			inBaseline: &App{
+				Name: "my-app",
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityInfo, Message: "a"},
+					{Severity: WarningSeverityError, Message: "b"},
+				},
+			},
+			inLive: &App{
+				Name: "my-app",
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityError, Message: "b"},
+					{Severity: WarningSeverityInfo, Message: "a"},
+				},
+			},
+		},
+		"matches the environments by name and the warnings by index": {
+			inBaseline: &App{
+				Name:  "my-app",
+				Owner: "team-a",
+				Envs: []*config.Environment{
+					{Name: "test", Region: "us-west-2"},
+					{Name: "prod", Region: "us-east-1"},
+				},
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityInfo, Message: "a"},
+				},
+			},
+			inLive: &App{
+				Name:  "my-app",
+				Owner: "team-b",
+				Envs: []*config.Environment{
+					{Name: "staging", Region: "us-west-2"},
+					{Name: "test", Region: "eu-west-1"},
+				},
+			},
+			wantedDiffs: []*AppDifference{
+				{Path: "environments[test].region", Baseline: `"us-west-2"`, Live: `"eu-west-1"`},
+				{Path: "environments[prod]", Baseline: `{"accountID":"","app":"","executionRoleARN":"","managerRoleARN":"","name":"prod","prod":false,"region":"us-east-1","registryURL":""}`},
+				{Path: "environments[staging]", Live: `{"accountID":"","app":"","executionRoleARN":"","managerRoleARN":"","name":"staging","prod":false,"region":"us-west-2","registryURL":""}`},
+				{Path: "owner", Baseline: `"team-a"`, Live: `"team-b"`},
+				{Path: "warnings", Baseline: `[{"message":"a","severity":"info"}]`},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diffs, err := tc.inLive.Diff(tc.inBaseline)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiffs, diffs)
+		})
+	}
+}
+
+func TestAppBaselineDiff_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inDiffs []*AppDifference
+
+		wantedContent string
+	}{
+		"reports that the application matches the baseline": {
+			wantedContent: "Application my-app matches baseline snapshot.json.\n",
+		},
+		"writes a row for each difference": {
+			inDiffs: []*AppDifference{
+				{Path: "environments[test].region", Baseline: `"us-west-2"`, Live: `"eu-west-1"`},
+				{Path: "owner", Live: `"team-b"`},
+			},
+			wantedContent: `Application my-app differs from baseline snapshot.json in 2 fields.
+
+  Field                      Baseline            Live
+  -----                      --------            ----
+  environments[test].region  "us-west-2"         "eu-west-1"
+  owner                      -                   "team-b"
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff := NewAppBaselineDiff("my-app", "snapshot.json", tc.inDiffs)
+			require.Equal(t, tc.wantedContent, diff.HumanString())
+		})
+	}
+}
+
+func TestAppBaselineDiff_JSONString(t *testing.T) {
+	diff := NewAppBaselineDiff("my-app", "snapshot.json", nil)
+
+	out, err := diff.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"my-app","baseline":"snapshot.json","differences":[]}`+"\n", out)
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io"
+	"sort"
+)
+
+// AppChange is a field of the configuration of a service that its last deployment changed.
+type AppChange struct {
+	Field    string `json:"field"`              // Path of the field in the manifest, like "image.location" or "variables.LOG_LEVEL".
+	Previous string `json:"previous,omitempty"` // Empty if the field wasn't deployed before.
+	Current  string `json:"current,omitempty"`  // Empty if the last deployment removed the field.
+}
+
+type appChanges []*AppDeployment
+
+// any returns true if the last deployment of any of the services changed its configuration.
+func (c appChanges) any() bool {
+	for _, deployment := range c {
+		if len(deployment.Changes) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// humanString writes a row for each field changed by the last deployment of the services grouped by service,
+// with its previous and current values. Repeated service names are dittoed. It returns true if any service name was dittoed.
+func (c appChanges) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Field", "Previous", "Current"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appChanges, len(c))
+	copy(sorted, c)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	var prevSvc string
+	for _, deployment := range sorted {
+		for _, change := range deployment.Changes {
+			name := deployment.Service
+			if len(rows) > 2 && prevSvc == deployment.Service {
+				name = dittoSymbol
+				dittoed = true
+			}
+			prevSvc = deployment.Service
+			rows = append(rows, []string{name, deployment.Environment, change.Field, valueOrDash(change.Previous), valueOrDash(change.Current)})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
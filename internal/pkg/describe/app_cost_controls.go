@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// budgetUsedFair and budgetUsedFull are the lowest percentages of the limit of a budget rendered in yellow and in red.
+	budgetUsedFair = 80
+	budgetUsedFull = 100
+)
+
+// AppCostControls are the budgets and the cost anomaly monitors that track the spend of the application,
+// found by the cost allocation tag of the application.
+type AppCostControls struct {
+	Budgets         []*AppBudget         `json:"budgets"`
+	AnomalyMonitors []*AppAnomalyMonitor `json:"anomalyMonitors"`
+}
+
+// AppBudget is a budget filtered on the application with its spend of the current period.
+type AppBudget struct {
+	Name   string `json:"name"`
+	Period string `json:"period"` // Like "MONTHLY".
+	Unit   string `json:"unit,omitempty"`
+	// Limit is zero if the budget has no fixed limit.
+	Limit    float64  `json:"limit,omitempty"`
+	Actual   float64  `json:"actual"`
+	Forecast *float64 `json:"forecast,omitempty"`
+}
+
+// AppAnomalyMonitor is a cost anomaly monitor whose specification selects the application.
+type AppAnomalyMonitor struct {
+	Name          string `json:"name"`
+	ARN           string `json:"arn"`
+	LastEvaluated string `json:"lastEvaluated,omitempty"`
+}
+
+// UsedPercent returns how much of the limit of the budget was spent, or nil if the budget has no fixed limit.
+func (b *AppBudget) UsedPercent() *float64 {
+	if b.Limit == 0 {
+		return nil
+	}
+	used := b.Actual / b.Limit * 100
+	return &used
+}
+
+// humanString writes a table of the budgets with their spend against their limit, where the budgets close to
+// their limit are in yellow and the ones over it in red, followed by a table of the anomaly monitors.
+func (c *AppCostControls) humanString(w io.Writer, width int) {
+	headers := []string{"Budget", "Period", "Spend", "Limit", "Used"}
+	rows := [][]string{headers, underline(headers)}
+	for _, budget := range c.Budgets {
+		limit, used := "", ""
+		if percent := budget.UsedPercent(); percent != nil {
+			limit = amountString(budget.Limit, budget.Unit)
+			used = fmt.Sprintf("%.0f%%", *percent)
+			switch {
+			case *percent >= budgetUsedFull:
+				used = color.Red.Sprint(used)
+			case *percent >= budgetUsedFair:
+				used = color.Yellow.Sprint(used)
+			}
+		}
+		rows = append(rows, []string{budget.Name, budget.Period, amountString(budget.Actual, budget.Unit), valueOrDash(limit), valueOrDash(used)})
+	}
+	writeTable(w, rows, width)
+	if len(c.AnomalyMonitors) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	headers = []string{"Anomaly Monitor", "Last Evaluated"}
+	rows = [][]string{headers, underline(headers)}
+	for _, monitor := range c.AnomalyMonitors {
+		rows = append(rows, []string{monitor.Name, valueOrDash(monitor.LastEvaluated)})
+	}
+	writeTable(w, rows, width)
+}
+
+// amountString returns the amount with two decimals followed by its unit, like "42.50 USD".
+func amountString(amount float64, unit string) string {
+	if unit == "" {
+		return fmt.Sprintf("%.2f", amount)
+	}
+	return fmt.Sprintf("%.2f %s", amount, unit)
+}
@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppBudget_UsedPercent(t *testing.T) {
+	require.Nil(t, (&AppBudget{Actual: 12}).UsedPercent())
+	require.Equal(t, aws.Float64(42.5), (&AppBudget{Limit: 200, Actual: 85}).UsedPercent())
+}
+
+func TestApp_HumanString_CostControls(t *testing.T) {
+	app := &App{
+		Name: "my-app",
+		CostControls: &AppCostControls{
+			Budgets: []*AppBudget{
+				{Name: "monthly", Period: "MONTHLY", Unit: "USD", Limit: 100, Actual: 42.5},
+				{Name: "close", Period: "MONTHLY", Unit: "USD", Limit: 100, Actual: 85},
+				{Name: "over", Period: "DAILY", Unit: "USD", Limit: 10, Actual: 12},
+				{Name: "planned", Period: "QUARTERLY", Actual: 300},
+			},
+			AnomalyMonitors: []*AppAnomalyMonitor{
+				{Name: "my-app", ARN: "arn:aws:ce::123456789012:anomalymonitor/abc", LastEvaluated: "2021-06-01"},
+				{Name: "new"},
+			},
+		},
+	}
+
+	require.Equal(t, `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Cost Controls
+
+  Budget            Period              Spend               Limit               Used
+  ------            ------              -----               -----               ----
+  monthly           MONTHLY             42.50 USD           100.00 USD          42%
+  close             MONTHLY             85.00 USD           100.00 USD          85%
+  over              DAILY               12.00 USD           10.00 USD           120%
+  planned           QUARTERLY           300.00              -                   -
+
+  Anomaly Monitor   Last Evaluated
+  ---------------   --------------
+  my-app            2021-06-01
+  new               -
+`, app.HumanString(AppRenderOptions{}))
+}
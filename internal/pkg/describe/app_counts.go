@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+)
+
+// AppCounts contains the tallies of an application for app show --count-only, without any of its details.
+type AppCounts struct {
+	App          string         `json:"app"`
+	Environments int            `json:"environments"`
+	Services     map[string]int `json:"services"` // Number of services by type.
+	Jobs         int            `json:"jobs"`
+	Pipelines    *int           `json:"pipelines,omitempty"` // Nil if the pipelines were skipped.
+	Healthy      int            `json:"healthy"`             // Deployments whose stack was deployed successfully.
+	Unhealthy    int            `json:"unhealthy"`           // Deployments whose stack failed, was rolled back or is being deployed.
+}
+
+// JSONString returns the stringified AppCounts struct with json format.
+func (c *AppCounts) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal counts: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppCounts struct with human readable format, one tally per line.
+// The services are tallied by type in alphabetical order.
+func (c *AppCounts) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Environments", c.Environments)
+	var total int
+	var types []string
+	for svcType, count := range c.Services {
+		total += count
+		types = append(types, svcType)
+	}
+	sort.Strings(types)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Services", total)
+	for _, svcType := range types {
+		fmt.Fprintf(writer, "    %s\t%d\n", svcType, c.Services[svcType])
+	}
+	fmt.Fprintf(writer, "  %s\t%d\n", "Jobs", c.Jobs)
+	if c.Pipelines != nil {
+		fmt.Fprintf(writer, "  %s\t%d\n", "Pipelines", *c.Pipelines)
+	}
+	fmt.Fprintf(writer, "  %s\t%d\n", "Healthy", c.Healthy)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Unhealthy", c.Unhealthy)
+	writer.Flush()
+	return b.String()
+}
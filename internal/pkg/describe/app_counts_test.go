@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppCounts_HumanString(t *testing.T) {
+	pipelines := 2
+	testCases := map[string]struct {
+		inCounts *AppCounts
+
+		wantedContent string
+	}{
+		"tallies the services by type in alphabetical order": {
+			inCounts: &AppCounts{
+				App:          "my-app",
+				Environments: 2,
+				Services:     map[string]int{"Worker Service": 1, "Backend Service": 3},
+				Jobs:         1,
+				Pipelines:    &pipelines,
+				Healthy:      5,
+				Unhealthy:    1,
+			},
+			wantedContent: `  Environments       2
+  Services           4
+    Backend Service  3
+    Worker Service   1
+  Jobs               1
+  Pipelines          2
+  Healthy            5
+  Unhealthy          1
+`,
+		},
+		"leaves the pipelines out if they were skipped": {
+			inCounts: &AppCounts{
+				App: "my-app",
+			},
+			wantedContent: `  Environments      0
+  Services          0
+  Jobs              0
+  Healthy           0
+  Unhealthy         0
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inCounts.HumanString())
+		})
+	}
+}
+
+func TestAppCounts_JSONString(t *testing.T) {
+	pipelines := 0
+	counts := &AppCounts{
+		App:          "my-app",
+		Environments: 1,
+		Services:     map[string]int{"Load Balanced Web Service": 1},
+		Pipelines:    &pipelines,
+		Healthy:      1,
+	}
+
+	got, err := counts.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"my-app","environments":1,"services":{"Load Balanced Web Service":1},"jobs":0,"pipelines":0,"healthy":1,"unhealthy":0}`+"\n", got)
+}
@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	treeBranch     = "├── "
+	treeLastBranch = "└── "
+	treeIndent     = "│   "
+	treeLastIndent = "    "
+
+	noServicesDeployed = "(no services deployed)"
+	fmtDashboardBanner = "%s  %d healthy / %d degraded / %d failing\n\n"
+)
+
+// Health of the nodes of the dashboard, derived from the status of their stacks.
+const (
+	healthHealthy  = "healthy"  // The stack was deployed successfully.
+	healthDegraded = "degraded" // The stack is being deployed, or its status is unknown.
+	healthFailing  = "failing"  // The stack failed to deploy or was rolled back.
+)
+
+// DashboardString returns the App struct as a tree of its environments and of the services deployed in them,
+// colored by health, under a banner that counts the healthy, degraded and failing deployments.
+func (a *App) DashboardString() string {
+	counts := make(map[string]int)
+	deployed := make(map[string][]*AppDeployment)
+	for _, d := range a.Deployments {
+		counts[health(d.StackStatus)]++
+		deployed[d.Environment] = append(deployed[d.Environment], d)
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, fmtDashboardBanner, color.Bold.Sprint(a.Name), counts[healthHealthy], counts[healthDegraded], counts[healthFailing])
+	fmt.Fprintln(&b, a.Name)
+	for i, env := range a.Envs {
+		branch, indent := treeBranch, treeIndent
+		if i == len(a.Envs)-1 {
+			branch, indent = treeLastBranch, treeLastIndent
+		}
+		writeTreeNode(&b, branch, env.Name, a.EnvStatuses[env.Name])
+		deployments := deployed[env.Name]
+		if len(deployments) == 0 {
+			fmt.Fprintf(&b, "%s%s%s\n", indent, treeLastBranch, color.Faint.Sprint(noServicesDeployed))
+			continue
+		}
+		for j, d := range deployments {
+			svcBranch := treeBranch
+			if j == len(deployments)-1 {
+				svcBranch = treeLastBranch
+			}
+			writeTreeNode(&b, indent+svcBranch, d.Service, d.StackStatus)
+		}
+	}
+	return b.String()
+}
+
+// writeTreeNode writes a node of the tree followed by the status of its stack, colored by health.
+// There is no status if it wasn't retrieved.
+func writeTreeNode(w io.Writer, prefix, name, status string) {
+	if status == "" {
+		fmt.Fprintf(w, "%s%s\n", prefix, name)
+		return
+	}
+	fmt.Fprintf(w, "%s%s  %s\n", prefix, name, healthColored(status))
+}
+
+// health returns whether a stack in the status is healthy, degraded or failing.
+func health(status string) string {
+	stackStatus := cloudformation.StackStatus(status)
+	switch {
+	case stackStatus.Failure():
+		return healthFailing
+	case stackStatus.Success():
+		return healthHealthy
+	}
+	return healthDegraded
+}
+
+// healthColored returns the status highlighted according to the health of the stack.
+func healthColored(status string) string {
+	switch health(status) {
+	case healthFailing:
+		return color.Red.Sprint(status)
+	case healthHealthy:
+		return color.Green.Sprint(status)
+	}
+	return color.Yellow.Sprint(status)
+}
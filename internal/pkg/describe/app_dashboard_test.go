@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_DashboardString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedContent string
+	}{
+		"renders a tree of the deployments under a banner of their health": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test"},
+					{Name: "staging"},
+					{Name: "prod"},
+				},
+				EnvStatuses: map[string]string{
+					"test":    "UPDATE_COMPLETE",
+					"staging": EnvStatusUnknown,
+					"prod":    "UPDATE_COMPLETE",
+				},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_IN_PROGRESS"},
+					{Service: "frontend", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_COMPLETE"},
+				},
+			},
+			wantedContent: `my-app  1 healthy / 1 degraded / 1 failing
+
+my-app
+├── test  UPDATE_COMPLETE
+│   ├── frontend  UPDATE_COMPLETE
+│   └── api  UPDATE_IN_PROGRESS
+├── staging  unknown
+│   └── (no services deployed)
+└── prod  UPDATE_COMPLETE
+    └── frontend  UPDATE_ROLLBACK_COMPLETE
+`,
+		},
+		"omits the status of the environments that weren't retrieved": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test"},
+				},
+			},
+			wantedContent: `my-app  0 healthy / 0 degraded / 0 failing
+
+my-app
+└── test
+    └── (no services deployed)
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.DashboardString())
+		})
+	}
+}
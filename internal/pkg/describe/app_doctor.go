@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Statuses of the diagnostics of app show --doctor.
+const (
+	DiagnosticPass = "pass"
+	DiagnosticWarn = "warn"
+	DiagnosticFail = "fail" // A hard failure that keeps the application from being described.
+)
+
+const fmtDiagnosticsSummary = "Ran %d %s: %d passed, %d %s, %d failed.\n\n"
+
+// AppDiagnostic is the result of a check of what is needed to describe an application, like valid credentials.
+type AppDiagnostic struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// AppDiagnostics contains the checklist of the diagnostics run by app show --doctor, in the order they were run.
+type AppDiagnostics struct {
+	App         string           `json:"app,omitempty"` // Empty if the checks didn't need an application.
+	Diagnostics []*AppDiagnostic `json:"diagnostics"`
+}
+
+// Count returns the number of diagnostics with the status.
+func (d *AppDiagnostics) Count(status string) int {
+	var count int
+	for _, diagnostic := range d.Diagnostics {
+		if diagnostic.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// JSONString returns the stringified AppDiagnostics struct with json format.
+func (d *AppDiagnostics) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal diagnostics: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppDiagnostics struct with human readable format, as a checklist.
+func (d *AppDiagnostics) HumanString() string {
+	var b bytes.Buffer
+	warnings := d.Count(DiagnosticWarn)
+	fmt.Fprintf(&b, fmtDiagnosticsSummary, len(d.Diagnostics), plural(len(d.Diagnostics), "check"),
+		d.Count(DiagnosticPass), warnings, plural(warnings, "warning"), d.Count(DiagnosticFail))
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	for _, diagnostic := range d.Diagnostics {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", diagnostic.coloredStatus(), diagnostic.Check, diagnostic.Detail)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (d *AppDiagnostic) coloredStatus() string {
+	switch d.Status {
+	case DiagnosticPass:
+		return color.Green.Sprint(d.Status)
+	case DiagnosticWarn:
+		return color.Yellow.Sprint(d.Status)
+	case DiagnosticFail:
+		return color.Red.Sprint(d.Status)
+	}
+	return d.Status
+}
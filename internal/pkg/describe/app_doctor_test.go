@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDiagnostics_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inDiagnostics *AppDiagnostics
+
+		wantedContent string
+	}{
+		"lists the diagnostics in the order they were run": {
+			inDiagnostics: &AppDiagnostics{
+				Diagnostics: []*AppDiagnostic{
+					{Check: "CLI version", Status: DiagnosticPass, Detail: "v1.8.0"},
+					{Check: "Clock skew", Status: DiagnosticWarn, Detail: "the local clock is 2m0s ahead of AWS"},
+					{Check: "Config store", Status: DiagnosticFail, Detail: "list applications: some error"},
+				},
+			},
+			wantedContent: `Ran 3 checks: 1 passed, 1 warning, 1 failed.
+
+  pass              CLI version         v1.8.0
+  warn              Clock skew          the local clock is 2m0s ahead of AWS
+  fail              Config store        list applications: some error
+`,
+		},
+		"counts a single check": {
+			inDiagnostics: &AppDiagnostics{
+				Diagnostics: []*AppDiagnostic{
+					{Check: "Credentials", Status: DiagnosticPass, Detail: "arn:aws:iam::123456789012:user/jane"},
+				},
+			},
+			wantedContent: `Ran 1 check: 1 passed, 0 warnings, 0 failed.
+
+  pass              Credentials         arn:aws:iam::123456789012:user/jane
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inDiagnostics.HumanString())
+		})
+	}
+}
+
+func TestAppDiagnostics_JSONString(t *testing.T) {
+	diagnostics := &AppDiagnostics{
+		App: "my-app",
+		Diagnostics: []*AppDiagnostic{
+			{Check: "Config store", Status: DiagnosticPass, Detail: "application my-app found"},
+		},
+	}
+
+	got, err := diagnostics.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"my-app","diagnostics":[{"check":"Config store","status":"pass","detail":"application my-app found"}]}`+"\n", got)
+}
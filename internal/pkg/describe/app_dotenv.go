@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// dotenvSafeValueRegexp matches the values that a shell reads as is, without quotes.
+var dotenvSafeValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]*$`)
+
+// DotenvString returns the top-level metadata of the App struct as KEY=value lines of an env file, to be sourced
+// by shell scripts. The collections are summarized as their count and their comma-separated names, and the values
+// are single-quoted if the shell would otherwise interpret them.
+func (a *App) DotenvString(opts AppRenderOptions) string {
+	var envs []string
+	for _, env := range a.sortedEnvs(opts.EnvSort) {
+		envs = append(envs, env.Name)
+	}
+	var svcs []string
+	for _, svc := range a.Services {
+		svcs = append(svcs, svc.Name)
+	}
+	vars := [][2]string{
+		{"APP_NAME", a.Name},
+		{"APP_DOMAIN", a.URI},
+		{"APP_OWNER", a.Owner},
+		{"APP_ENV_COUNT", strconv.Itoa(len(envs))},
+		{"APP_ENVS", strings.Join(envs, ",")},
+		{"APP_SERVICE_COUNT", strconv.Itoa(len(svcs))},
+		{"APP_SERVICES", strings.Join(svcs, ",")},
+	}
+	if !a.PipelinesSkipped {
+		var pipelines []string
+		for _, pipeline := range a.Pipelines {
+			pipelines = append(pipelines, pipeline.Name)
+		}
+		vars = append(vars,
+			[2]string{"APP_PIPELINE_COUNT", strconv.Itoa(len(pipelines))},
+			[2]string{"APP_PIPELINES", strings.Join(pipelines, ",")})
+	}
+	vars = append(vars, [2]string{"APP_WARNING_COUNT", strconv.Itoa(len(a.Warnings))})
+	if a.HealthScore != nil {
+		vars = append(vars, [2]string{"APP_HEALTH_SCORE", strconv.Itoa(*a.HealthScore)})
+	}
+
+	var b bytes.Buffer
+	for _, v := range vars {
+		fmt.Fprintf(&b, "%s=%s\n", v[0], shellQuote(v[1]))
+	}
+	return b.String()
+}
+
+// shellQuote returns the value single-quoted for a shell to read it literally, unless it's safe without quotes.
+func shellQuote(value string) string {
+	if dotenvSafeValueRegexp.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_DotenvString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"summarizes an empty application": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: `APP_NAME=my-app
+APP_DOMAIN=
+APP_OWNER=
+APP_ENV_COUNT=0
+APP_ENVS=
+APP_SERVICE_COUNT=0
+APP_SERVICES=
+APP_PIPELINE_COUNT=0
+APP_PIPELINES=
+APP_WARNING_COUNT=0
+`,
+		},
+		"summarizes the environments, services and pipelines": {
+			inApp: &App{
+				Name:  "my-app",
+				URI:   "example.com",
+				Owner: "platform-team",
+				Envs: []*config.Environment{
+					{Name: "staging"},
+					{Name: "prod", Prod: true},
+				},
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "worker", Type: "Worker Service"},
+				},
+				Pipelines: []*codepipeline.Pipeline{{Name: "release"}},
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityWarning, Message: "some warning"},
+				},
+				HealthScore: aws.Int(87),
+			},
+			inOpts: AppRenderOptions{
+				EnvSort: EnvSortName,
+			},
+			wantedContent: `APP_NAME=my-app
+APP_DOMAIN=example.com
+APP_OWNER=platform-team
+APP_ENV_COUNT=2
+APP_ENVS=prod,staging
+APP_SERVICE_COUNT=2
+APP_SERVICES=api,worker
+APP_PIPELINE_COUNT=1
+APP_PIPELINES=release
+APP_WARNING_COUNT=1
+APP_HEALTH_SCORE=87
+`,
+		},
+		"quotes the values that the shell would interpret": {
+			inApp: &App{
+				Name:             "my-app",
+				Owner:            "Jane's team $(whoami)",
+				PipelinesSkipped: true,
+			},
+			wantedContent: `APP_NAME=my-app
+APP_DOMAIN=
+APP_OWNER='Jane'\''s team $(whoami)'
+APP_ENV_COUNT=0
+APP_ENVS=
+APP_SERVICE_COUNT=0
+APP_SERVICES=
+APP_WARNING_COUNT=0
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.DotenvString(tc.inOpts))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io"
+	"sort"
+)
+
+// AppDrift is a field of the manifest of a service whose value differs from the deployed configuration of the service.
+type AppDrift struct {
+	Field    string `json:"field"`              // Path of the field in the manifest, like "cpu" or "variables.LOG_LEVEL".
+	Manifest string `json:"manifest,omitempty"` // Empty if the field isn't in the manifest.
+	Deployed string `json:"deployed,omitempty"` // Empty if the field isn't deployed.
+}
+
+type appDrift []*AppDeployment
+
+// any returns true if any of the deployments drifted from its manifest.
+func (d appDrift) any() bool {
+	for _, deployment := range d {
+		if len(deployment.Drift) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// humanString writes a row for each drifted field of the deployments grouped by service, with its value in the manifest
+// and its deployed value. Repeated service names are dittoed. It returns true if any service name was dittoed.
+func (d appDrift) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Service", "Environment", "Field", "Manifest", "Deployed"}
+	rows := [][]string{headers, underline(headers)}
+	sorted := make(appDrift, len(d))
+	copy(sorted, d)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	var prevSvc string
+	for _, deployment := range sorted {
+		for _, drift := range deployment.Drift {
+			name := deployment.Service
+			if len(rows) > 2 && prevSvc == deployment.Service {
+				name = dittoSymbol
+				dittoed = true
+			}
+			prevSvc = deployment.Service
+			rows = append(rows, []string{name, deployment.Environment, drift.Field, valueOrDash(drift.Manifest), valueOrDash(drift.Deployed)})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
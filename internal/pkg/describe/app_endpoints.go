@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io"
+	"sort"
+	"strings"
+)
+
+// Kinds of the endpoints of the services.
+const (
+	EndpointCustomDomain = "custom domain" // A domain name of the application or a custom domain of an App Runner service.
+	EndpointLoadBalancer = "load balancer" // The DNS name of the public load balancer of the environment.
+	EndpointAppRunner    = "app runner"    // The default domain of an App Runner service.
+)
+
+// AppEndpoint is one of the URLs that a service deployed in an environment is reached at.
+// A service can have several, like the domain of its environment and the DNS name of its load balancer.
+type AppEndpoint struct {
+	URL  string `json:"url"`
+	Kind string `json:"kind"`
+}
+
+type appEndpoints []*AppDeployment
+
+// any returns true if any of the deployments has endpoints.
+func (d appEndpoints) any() bool {
+	for _, deployment := range d {
+		if len(deployment.Endpoints) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// humanString writes a row with all the endpoints of each deployment that has any, sorted by service.
+// Repeated services are dittoed. It returns true if any service was dittoed.
+func (d appEndpoints) humanString(w io.Writer, width int) (dittoed bool) {
+	var sorted appEndpoints
+	for _, deployment := range d {
+		if len(deployment.Endpoints) != 0 {
+			sorted = append(sorted, deployment)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Service < sorted[j].Service })
+	headers := []string{"Service", "Environment", "Endpoints"}
+	rows := [][]string{headers, underline(headers)}
+	for i, deployment := range sorted {
+		svc := deployment.Service
+		if i > 0 && sorted[i-1].Service == deployment.Service {
+			svc = dittoSymbol
+			dittoed = true
+		}
+		urls := make([]string, len(deployment.Endpoints))
+		for j, endpoint := range deployment.Endpoints {
+			urls[j] = endpoint.URL
+		}
+		rows = append(rows, []string{svc, deployment.Environment, strings.Join(urls, ", ")})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	comparedAttributeType     = "type"
+	fmtComparedAttributeImage = "image (%s)"
+)
+
+// ComparedService contains the attributes of a service deployed in one of the compared environments.
+type ComparedService struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
+	Images map[string]string `json:"images,omitempty"` // Container name to image.
+}
+
+// ServiceDifference is an attribute of a service that differs between the compared environments.
+type ServiceDifference struct {
+	Service   string            `json:"service"`
+	Attribute string            `json:"attribute"`
+	Values    map[string]string `json:"values"` // Environment name to the value of the attribute.
+}
+
+// AppEnvComparison contains the differences between the services deployed in two environments of an application.
+type AppEnvComparison struct {
+	App          string               `json:"app"`
+	Environments []string             `json:"environments"`
+	OnlyInFirst  []*ComparedService   `json:"onlyInFirst"`
+	OnlyInSecond []*ComparedService   `json:"onlyInSecond"`
+	Differences  []*ServiceDifference `json:"differences"`
+	Matching     []*ComparedService   `json:"matching"`
+
+	// Width is the number of characters that the tables of the human readable format are truncated to fit in.
+	// The tables are not truncated if it's zero.
+	Width int `json:"-"`
+}
+
+// NewAppEnvComparison compares the services deployed in the first environment with the ones deployed in the second.
+func NewAppEnvComparison(app, firstEnv string, first []*ComparedService, secondEnv string, second []*ComparedService) *AppEnvComparison {
+	comparison := &AppEnvComparison{
+		App:          app,
+		Environments: []string{firstEnv, secondEnv},
+	}
+	inSecond := make(map[string]*ComparedService)
+	for _, svc := range second {
+		inSecond[svc.Name] = svc
+	}
+	inFirst := make(map[string]bool)
+	for _, svc := range first {
+		inFirst[svc.Name] = true
+		other, ok := inSecond[svc.Name]
+		if !ok {
+			comparison.OnlyInFirst = append(comparison.OnlyInFirst, svc)
+			continue
+		}
+		diffs := compareServices(firstEnv, svc, secondEnv, other)
+		if len(diffs) == 0 {
+			comparison.Matching = append(comparison.Matching, svc)
+			continue
+		}
+		comparison.Differences = append(comparison.Differences, diffs...)
+	}
+	for _, svc := range second {
+		if !inFirst[svc.Name] {
+			comparison.OnlyInSecond = append(comparison.OnlyInSecond, svc)
+		}
+	}
+	return comparison
+}
+
+// compareServices returns the attributes of the service that differ between the two environments.
+// The images are compared container by container, sorted by container name.
+func compareServices(firstEnv string, first *ComparedService, secondEnv string, second *ComparedService) []*ServiceDifference {
+	var diffs []*ServiceDifference
+	if first.Type != second.Type {
+		diffs = append(diffs, &ServiceDifference{
+			Service:   first.Name,
+			Attribute: comparedAttributeType,
+			Values:    map[string]string{firstEnv: first.Type, secondEnv: second.Type},
+		})
+	}
+	containers := make(map[string]bool)
+	for container := range first.Images {
+		containers[container] = true
+	}
+	for container := range second.Images {
+		containers[container] = true
+	}
+	var names []string
+	for container := range containers {
+		names = append(names, container)
+	}
+	sort.Strings(names)
+	for _, container := range names {
+		if first.Images[container] == second.Images[container] {
+			continue
+		}
+		diffs = append(diffs, &ServiceDifference{
+			Service:   first.Name,
+			Attribute: fmt.Sprintf(fmtComparedAttributeImage, container),
+			Values:    map[string]string{firstEnv: first.Images[container], secondEnv: second.Images[container]},
+		})
+	}
+	return diffs
+}
+
+// JSONString returns the stringified AppEnvComparison struct with json format.
+func (c *AppEnvComparison) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment comparison: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppEnvComparison struct with human readable format.
+func (c *AppEnvComparison) HumanString() string {
+	firstEnv, secondEnv := c.Environments[0], c.Environments[1]
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprintf("Only in %s\n\n", firstEnv))
+	writer.Flush()
+	comparedServices(c.OnlyInFirst).humanString(writer, c.Width)
+	fmt.Fprint(writer, color.Bold.Sprintf("\nOnly in %s\n\n", secondEnv))
+	writer.Flush()
+	comparedServices(c.OnlyInSecond).humanString(writer, c.Width)
+	fmt.Fprint(writer, color.Bold.Sprint("\nDifferences\n\n"))
+	writer.Flush()
+	headers := []string{"Service", "Attribute", firstEnv, secondEnv}
+	rows := [][]string{headers, underline(headers)}
+	for _, diff := range c.Differences {
+		rows = append(rows, []string{diff.Service, diff.Attribute, valueOrDash(diff.Values[firstEnv]), valueOrDash(diff.Values[secondEnv])})
+	}
+	writeTable(writer, rows, c.Width)
+	fmt.Fprint(writer, color.Bold.Sprint("\nMatching\n\n"))
+	writer.Flush()
+	comparedServices(c.Matching).humanString(writer, c.Width)
+	writer.Flush()
+	return b.String()
+}
+
+type comparedServices []*ComparedService
+
+// humanString writes a row with the name and type of each service.
+func (s comparedServices) humanString(w io.Writer, width int) {
+	headers := []string{"Name", "Type"}
+	rows := [][]string{headers, underline(headers)}
+	for _, svc := range s {
+		rows = append(rows, []string{svc.Name, svc.Type})
+	}
+	writeTable(w, rows, width)
+}
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAppEnvComparison(t *testing.T) {
+	testCases := map[string]struct {
+		inFirst  []*ComparedService
+		inSecond []*ComparedService
+
+		wanted *AppEnvComparison
+	}{
+		"no services in either environment": {
+			wanted: &AppEnvComparison{
+				App:          "my-app",
+				Environments: []string{"test", "prod"},
+			},
+		},
+		"services present in a single environment": {
+			inFirst: []*ComparedService{
+				{Name: "worker", Type: "Backend Service"},
+			},
+			inSecond: []*ComparedService{
+				{Name: "api", Type: "Request-Driven Web Service"},
+			},
+			wanted: &AppEnvComparison{
+				App:          "my-app",
+				Environments: []string{"test", "prod"},
+				OnlyInFirst: []*ComparedService{
+					{Name: "worker", Type: "Backend Service"},
+				},
+				OnlyInSecond: []*ComparedService{
+					{Name: "api", Type: "Request-Driven Web Service"},
+				},
+			},
+		},
+		"services with differing and matching images": {
+			inFirst: []*ComparedService{
+				{Name: "frontend", Type: "Load Balanced Web Service", Images: map[string]string{"frontend": "frontend:v2", "nginx": "nginx:latest"}},
+				{Name: "backend", Type: "Backend Service", Images: map[string]string{"backend": "backend:v1"}},
+			},
+			inSecond: []*ComparedService{
+				{Name: "backend", Type: "Backend Service", Images: map[string]string{"backend": "backend:v1"}},
+				{Name: "frontend", Type: "Load Balanced Web Service", Images: map[string]string{"frontend": "frontend:v1"}},
+			},
+			wanted: &AppEnvComparison{
+				App:          "my-app",
+				Environments: []string{"test", "prod"},
+				Differences: []*ServiceDifference{
+					{Service: "frontend", Attribute: "image (frontend)", Values: map[string]string{"test": "frontend:v2", "prod": "frontend:v1"}},
+					{Service: "frontend", Attribute: "image (nginx)", Values: map[string]string{"test": "nginx:latest", "prod": ""}},
+				},
+				Matching: []*ComparedService{
+					{Name: "backend", Type: "Backend Service", Images: map[string]string{"backend": "backend:v1"}},
+				},
+			},
+		},
+		"services with differing types": {
+			inFirst: []*ComparedService{
+				{Name: "api", Type: "Load Balanced Web Service"},
+			},
+			inSecond: []*ComparedService{
+				{Name: "api", Type: "Request-Driven Web Service"},
+			},
+			wanted: &AppEnvComparison{
+				App:          "my-app",
+				Environments: []string{"test", "prod"},
+				Differences: []*ServiceDifference{
+					{Service: "api", Attribute: "type", Values: map[string]string{"test": "Load Balanced Web Service", "prod": "Request-Driven Web Service"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, NewAppEnvComparison("my-app", "test", tc.inFirst, "prod", tc.inSecond))
+		})
+	}
+}
+
+func TestAppEnvComparison_JSONString(t *testing.T) {
+	comparison := &AppEnvComparison{
+		App:          "my-app",
+		Environments: []string{"test", "prod"},
+		OnlyInFirst: []*ComparedService{
+			{Name: "worker", Type: "Backend Service", Images: map[string]string{"worker": "worker:v1"}},
+		},
+		Differences: []*ServiceDifference{
+			{Service: "frontend", Attribute: "image (frontend)", Values: map[string]string{"test": "frontend:v2", "prod": "frontend:v1"}},
+		},
+	}
+
+	out, err := comparison.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"my-app","environments":["test","prod"],"onlyInFirst":[{"name":"worker","type":"Backend Service","images":{"worker":"worker:v1"}}],"onlyInSecond":null,"differences":[{"service":"frontend","attribute":"image (frontend)","values":{"prod":"frontend:v1","test":"frontend:v2"}}],"matching":null}`+"\n", out)
+}
+
+func TestAppEnvComparison_HumanString(t *testing.T) {
+	comparison := &AppEnvComparison{
+		App:          "my-app",
+		Environments: []string{"test", "prod"},
+		OnlyInFirst: []*ComparedService{
+			{Name: "worker", Type: "Backend Service"},
+		},
+		Differences: []*ServiceDifference{
+			{Service: "frontend", Attribute: "image (nginx)", Values: map[string]string{"test": "nginx:latest", "prod": ""}},
+		},
+		Matching: []*ComparedService{
+			{Name: "backend", Type: "Backend Service"},
+		},
+	}
+
+	require.Equal(t, `Only in test
+
+  Name              Type
+  ----              ----
+  worker            Backend Service
+
+Only in prod
+
+  Name              Type
+  ----              ----
+
+Differences
+
+  Service           Attribute           test                prod
+  -------           ---------           ----                ----
+  frontend          image (nginx)       nginx:latest        -
+
+Matching
+
+  Name              Type
+  ----              ----
+  backend           Backend Service
+`, comparison.HumanString())
+}
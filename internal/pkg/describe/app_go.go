@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// awsHelpers are the functions of the AWS SDK that return a pointer to a value of each kind.
+var awsHelpers = map[reflect.Kind]string{
+	reflect.String:  "String",
+	reflect.Bool:    "Bool",
+	reflect.Int:     "Int",
+	reflect.Int64:   "Int64",
+	reflect.Float64: "Float64",
+}
+
+// GoLiteralString returns the App struct as the source of a gofmt'ed Go composite literal, like
+// describe.App{Name: "my-app"}, to be pasted in table tests. The zero fields are omitted, and so are the fields
+// that aren't part of the json description either, like EnvLastDeployedAt.
+func (a *App) GoLiteralString() (string, error) {
+	var b bytes.Buffer
+	if err := writeGoValue(&b, reflect.ValueOf(*a), false); err != nil {
+		return "", fmt.Errorf("write Go literal: %w", err)
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("format Go literal: %w", err)
+	}
+	return fmt.Sprintf("%s\n", src), nil
+}
+
+// writeGoValue writes the Go expression of the value. The type of a struct or of a pointer to a struct is elided
+// if elide is true, like for the elements of a slice.
+func writeGoValue(b *bytes.Buffer, v reflect.Value, elide bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		elem := v.Elem()
+		if elem.Kind() == reflect.Struct && elem.Type() != timeType {
+			if !elide {
+				b.WriteString("&")
+			}
+			return writeGoValue(b, elem, elide)
+		}
+		// There are no literals of pointers to basic types, so they're built with the helpers of the AWS SDK.
+		helper, ok := awsHelpers[elem.Kind()]
+		if !ok {
+			return fmt.Errorf("unsupported pointer to %s", elem.Type())
+		}
+		fmt.Fprintf(b, "aws.%s(", helper)
+		if err := writeGoValue(b, elem, false); err != nil {
+			return err
+		}
+		b.WriteString(")")
+	case reflect.Struct:
+		if v.Type() == timeType {
+			t := v.Interface().(time.Time).UTC()
+			fmt.Fprintf(b, "time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+			return nil
+		}
+		if !elide {
+			b.WriteString(v.Type().String())
+		}
+		b.WriteString("{\n")
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" || field.Tag.Get("json") == "-" || v.Field(i).IsZero() {
+				continue
+			}
+			fmt.Fprintf(b, "%s: ", field.Name)
+			if err := writeGoValue(b, v.Field(i), false); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString("}")
+	case reflect.Slice:
+		b.WriteString(v.Type().String())
+		b.WriteString("{\n")
+		for i := 0; i < v.Len(); i++ {
+			if err := writeGoValue(b, v.Index(i), true); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString("}")
+	case reflect.Map:
+		b.WriteString(v.Type().String())
+		b.WriteString("{\n")
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			if err := writeGoValue(b, key, true); err != nil {
+				return err
+			}
+			b.WriteString(": ")
+			if err := writeGoValue(b, v.MapIndex(key), true); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString("}")
+	case reflect.String:
+		b.WriteString(strconv.Quote(v.String()))
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		b.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	default:
+		return fmt.Errorf("unsupported kind %s of type %s", v.Kind(), v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"go/parser"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_GoLiteralString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedContent string
+	}{
+		"omits the zero fields and the fields that aren't described": {
+			inApp: &App{
+				Name:              "my-app",
+				EnvLastDeployedAt: map[string]time.Time{"test": time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC)},
+			},
+			wantedContent: `describe.App{
+	Name: "my-app",
+}
+`,
+		},
+		"writes the nested structs, pointers, maps and times": {
+			inApp: &App{
+				Name:     "my-app",
+				Envs:     []*config.Environment{{Name: "test", Region: "us-west-2", Prod: true}},
+				Services: []*config.Workload{{Name: "api", Type: "Load Balanced Web Service"}},
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "release", CreatedAt: time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC)},
+				},
+				EnvStatuses: map[string]string{"test": "CREATE_COMPLETE", "prod": "unknown"},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", Alarms: []*AppAlarm{{Name: "HighCPU", Threshold: aws.Float64(80.5)}}},
+				},
+				ArtifactBuckets: []*AppArtifactBucket{{Bucket: "my-bucket", ApproximateObjectCount: aws.Int64(42)}},
+				NotDeployed:     []string{"worker"},
+				Warnings:        []*AppWarning{{Severity: WarningSeverityInfo, Message: `Service "api" has no alarms`}},
+			},
+			wantedContent: `describe.App{
+	Name: "my-app",
+	Envs: []*config.Environment{
+		{
+			Name:   "test",
+			Region: "us-west-2",
+			Prod:   true,
+		},
+	},
+	Services: []*config.Workload{
+		{
+			Name: "api",
+			Type: "Load Balanced Web Service",
+		},
+	},
+	Pipelines: []*codepipeline.Pipeline{
+		{
+			Name:      "release",
+			CreatedAt: time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC),
+		},
+	},
+	EnvStatuses: map[string]string{
+		"prod": "unknown",
+		"test": "CREATE_COMPLETE",
+	},
+	Deployments: []*describe.AppDeployment{
+		{
+			Service:     "api",
+			Environment: "test",
+			Alarms: []*describe.AppAlarm{
+				{
+					Name:      "HighCPU",
+					Threshold: aws.Float64(80.5),
+				},
+			},
+		},
+	},
+	ArtifactBuckets: []*describe.AppArtifactBucket{
+		{
+			Bucket:                 "my-bucket",
+			ApproximateObjectCount: aws.Int64(42),
+		},
+	},
+	NotDeployed: []string{
+		"worker",
+	},
+	Warnings: []*describe.AppWarning{
+		{
+			Severity: "info",
+			Message:  "Service \"api\" has no alarms",
+		},
+	},
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			content, err := tc.inApp.GoLiteralString()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, content)
+			_, err = parser.ParseExpr(content)
+			require.NoError(t, err, "expected a valid Go expression")
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// healthGaugeWidth is the number of cells of the gauge of the health score in the human readable format.
+	healthGaugeWidth = 10
+	// healthScoreGood and healthScoreFair are the lowest scores rendered in green and in yellow, the others are red.
+	healthScoreGood = 80
+	healthScoreFair = 50
+
+	pipelineBuildFailed = "Failed"
+)
+
+// AppHealthWeights are the weights of the components of the health score of an application.
+// A component with a weight of 0 is left out of the score.
+type AppHealthWeights struct {
+	Services     float64 `yaml:"services"`     // Deployments whose stack was deployed successfully.
+	Stacks       float64 `yaml:"stacks"`       // Stacks of the environments and services that aren't in a failed state.
+	Pipelines    float64 `yaml:"pipelines"`    // Pipelines whose last build didn't fail.
+	Certificates float64 `yaml:"certificates"` // Certificates of the custom domains of the environments that haven't expired.
+}
+
+// DefaultHealthWeights returns the weights of the health score if none are configured.
+func DefaultHealthWeights() AppHealthWeights {
+	return AppHealthWeights{
+		Services:     40,
+		Stacks:       30,
+		Pipelines:    20,
+		Certificates: 10,
+	}
+}
+
+// Validate returns an error if a weight is negative or if all of them are 0.
+func (w AppHealthWeights) Validate() error {
+	for _, weight := range []struct {
+		name  string
+		value float64
+	}{
+		{"services", w.Services},
+		{"stacks", w.Stacks},
+		{"pipelines", w.Pipelines},
+		{"certificates", w.Certificates},
+	} {
+		if weight.value < 0 {
+			return fmt.Errorf("weight of %s must be non-negative, got %v", weight.name, weight.value)
+		}
+	}
+	if w.Services+w.Stacks+w.Pipelines+w.Certificates == 0 {
+		return errors.New("at least one weight must be positive")
+	}
+	return nil
+}
+
+// ScoreHealth returns the health score of the application from 0 to 100: the average of the ratios of healthy items
+// of each component, weighted by the weights. The components without any item, like the pipelines of an application
+// that has none, are left out of the average. It returns nil if none of the components has an item.
+func (a *App) ScoreHealth(weights AppHealthWeights, now time.Time) *int {
+	var healthySvcs, deployments, healthyStacks, stacks int
+	certs := make(map[string]bool) // Environment name to whether its certificate is valid.
+	for _, deployment := range a.Deployments {
+		if deployment.StackStatus == "" {
+			continue
+		}
+		status := cloudformation.StackStatus(deployment.StackStatus)
+		deployments++
+		stacks++
+		if status.Success() {
+			healthySvcs++
+		}
+		if !status.Failure() {
+			healthyStacks++
+		}
+		// The certificate is the one of the environment, shared by its services.
+		if expiry, err := time.Parse(time.RFC3339, deployment.CertExpiry); err == nil {
+			certs[deployment.Environment] = expiry.After(now)
+		}
+	}
+	for _, status := range a.EnvStatuses {
+		switch {
+		case status == EnvStatusUnknown:
+			continue
+		case status == EnvStatusAccountUnreachable, cloudformation.StackStatus(status).Failure():
+		default:
+			healthyStacks++
+		}
+		stacks++
+	}
+	var healthyPipelines, pipelines int
+	for _, pipeline := range a.Pipelines {
+		if pipeline.Build == nil || pipeline.Build.Last == nil {
+			continue
+		}
+		pipelines++
+		if pipeline.Build.Last.Status != pipelineBuildFailed {
+			healthyPipelines++
+		}
+	}
+	var validCerts int
+	for _, valid := range certs {
+		if valid {
+			validCerts++
+		}
+	}
+
+	var score, total float64
+	for _, component := range []struct {
+		weight         float64
+		healthy, count int
+	}{
+		{weights.Services, healthySvcs, deployments},
+		{weights.Stacks, healthyStacks, stacks},
+		{weights.Pipelines, healthyPipelines, pipelines},
+		{weights.Certificates, validCerts, len(certs)},
+	} {
+		if component.count == 0 || component.weight == 0 {
+			continue
+		}
+		score += component.weight * float64(component.healthy) / float64(component.count)
+		total += component.weight
+	}
+	if total == 0 {
+		return nil
+	}
+	rounded := int(math.Round(score / total * 100))
+	return &rounded
+}
+
+// healthGauge returns the health score as a gauge like "[########--] 80/100", colored by how healthy it is.
+func healthGauge(score int) string {
+	filled := score * healthGaugeWidth / 100
+	gauge := fmt.Sprintf("[%s%s] %d/100", strings.Repeat("#", filled), strings.Repeat("-", healthGaugeWidth-filled), score)
+	switch {
+	case score >= healthScoreGood:
+		return color.Green.Sprint(gauge)
+	case score >= healthScoreFair:
+		return color.Yellow.Sprint(gauge)
+	}
+	return color.Red.Sprint(gauge)
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_ScoreHealth(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inApp     *App
+		inWeights AppHealthWeights
+
+		wanted *int
+	}{
+		"nothing to score": {
+			inApp:     &App{Name: "my-app"},
+			inWeights: DefaultHealthWeights(),
+		},
+		"a healthy application scores 100": {
+			inApp: &App{
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE", CertExpiry: "2023-06-01T00:00:00Z"},
+				},
+				EnvStatuses: map[string]string{"test": "CREATE_COMPLETE"},
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "pipeline", Build: &codepipeline.PipelineBuild{Last: &codepipeline.BuildExecution{Status: "Succeeded"}}},
+				},
+			},
+			inWeights: DefaultHealthWeights(),
+			wanted:    aws.Int(100),
+		},
+		"weighs the ratio of healthy items of each component": {
+			inApp: &App{
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE", CertExpiry: "2023-06-01T00:00:00Z"},
+					{Service: "web", Environment: "test", StackStatus: "UPDATE_IN_PROGRESS", CertExpiry: "2023-06-01T00:00:00Z"},
+					{Service: "api", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_FAILED", CertExpiry: "2023-02-01T00:00:00Z"},
+					{Service: "web", Environment: "prod", StackStatus: "CREATE_COMPLETE", CertExpiry: "2023-02-01T00:00:00Z"},
+				},
+				EnvStatuses: map[string]string{"test": "UPDATE_COMPLETE", "prod": EnvStatusUnknown},
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "pipeline-main", Build: &codepipeline.PipelineBuild{Last: &codepipeline.BuildExecution{Status: "Failed"}}},
+					{Name: "pipeline-release", Build: &codepipeline.PipelineBuild{Last: &codepipeline.BuildExecution{Status: "Succeeded"}}},
+					{Name: "pipeline-new", Build: &codepipeline.PipelineBuild{}},
+				},
+			},
+			inWeights: DefaultHealthWeights(),
+			// Services: 2/4, stacks: 4/5, pipelines: 1/2, certificates: 1/2.
+			// (40*0.5 + 30*0.8 + 20*0.5 + 10*0.5) / 100 = 0.59
+			wanted: aws.Int(59),
+		},
+		"leaves out the components without items or weight": {
+			inApp: &App{
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "web", Environment: "test", StackStatus: "UPDATE_ROLLBACK_COMPLETE"},
+				},
+				EnvStatuses: map[string]string{"test": EnvStatusAccountUnreachable},
+			},
+			inWeights: AppHealthWeights{Services: 1, Pipelines: 1, Certificates: 1},
+			wanted:    aws.Int(50),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inApp.ScoreHealth(tc.inWeights, now))
+		})
+	}
+}
+
+func TestAppHealthWeights_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inWeights AppHealthWeights
+
+		wantedError error
+	}{
+		"default weights": {
+			inWeights: DefaultHealthWeights(),
+		},
+		"negative weight": {
+			inWeights:   AppHealthWeights{Services: 1, Pipelines: -1},
+			wantedError: errors.New("weight of pipelines must be non-negative, got -1"),
+		},
+		"all weights are 0": {
+			inWeights:   AppHealthWeights{},
+			wantedError: errors.New("at least one weight must be positive"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.inWeights.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApp_HumanString_HealthScore(t *testing.T) {
+	app := &App{
+		Name:        "my-app",
+		HealthScore: aws.Int(73),
+	}
+
+	require.Equal(t, `About
+
+  Name              my-app
+  Health            [#######---] 73/100
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`, app.HumanString(AppRenderOptions{}))
+}
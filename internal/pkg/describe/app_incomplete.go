@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// incompleteValues are the values of the fields that couldn't be retrieved. Several of them are the same "unknown",
+// so they're listed rather than keyed.
+var incompleteValues = []string{
+	EnvStatusUnknown,
+	EnvStatusAccountUnreachable,
+	LastDeployedByUnknown,
+	LoggingUnknown,
+	LogRetentionUnknown,
+	WebACLUnknown,
+	DeploymentControllerUnknown,
+	AllocationUnknown,
+}
+
+// identifierKeys are the keys of the json format that name a resource rather than describe it, so that a service
+// named "unknown" isn't incomplete.
+var identifierKeys = map[string]bool{
+	"name":        true,
+	"app":         true,
+	"service":     true,
+	"environment": true,
+}
+
+// IncompleteFields returns the paths of the fields of the json format whose value couldn't be retrieved, like
+// "environmentStatuses.prod" for an environment whose stack status is EnvStatusUnknown, in lexical order of their keys.
+// The elements of the arrays are identified by their index, like "deployments[0].stackStatus". An application derived
+// from its stacks has the "derivedFromStacks" field, as its environments and services are partial.
+func (a *App) IncompleteFields() ([]string, error) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("marshal application description: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal application description: %w", err)
+	}
+	// The warnings explain the incomplete fields rather than being fields of the application.
+	delete(doc, "warnings")
+	var paths []string
+	if a.DerivedFromStacks {
+		paths = append(paths, "derivedFromStacks")
+	}
+	return append(paths, incompletePaths("", doc)...), nil
+}
+
+func incompletePaths(path string, value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		for _, incomplete := range incompleteValues {
+			if v == incomplete {
+				return []string{path}
+			}
+		}
+	case []interface{}:
+		var paths []string
+		for i, elem := range v {
+			paths = append(paths, incompletePaths(fmt.Sprintf("%s[%d]", path, i), elem)...)
+		}
+		return paths
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			if !identifierKeys[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		var paths []string
+		for _, key := range keys {
+			sub := key
+			if path != "" {
+				sub = path + jsonPathSep + key
+			}
+			paths = append(paths, incompletePaths(sub, v[key])...)
+		}
+		return paths
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_IncompleteFields(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wanted []string
+	}{
+		"a complete application": {
+			inApp: &App{
+				Name:        "my-app",
+				EnvStatuses: map[string]string{"test": "CREATE_COMPLETE"},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+				},
+			},
+		},
+		"lists the fields that couldn't be retrieved": {
+			inApp: &App{
+				Name:     "my-app",
+				Services: []*config.Workload{{Name: "unknown", Type: "Backend Service"}},
+				EnvStatuses: map[string]string{
+					"test":    "CREATE_COMPLETE",
+					"staging": EnvStatusUnknown,
+					"prod":    EnvStatusAccountUnreachable,
+				},
+				LastDeployedBy: map[string]string{"test": LastDeployedByUnknown},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE", Logging: LoggingEnabled},
+					{Service: "unknown", Environment: "test", StackStatus: "UPDATE_COMPLETE", Logging: LoggingUnknown, WebACL: WebACLUnknown},
+				},
+				Warnings: []*AppWarning{{Severity: WarningSeverityWarning, Message: "unknown"}},
+			},
+			wanted: []string{
+				"deployments[1].logging",
+				"deployments[1].wafAcl",
+				"environmentStatuses.prod",
+				"environmentStatuses.staging",
+				"lastDeployedBy.test",
+			},
+		},
+		"an application derived from its stacks is incomplete": {
+			inApp: &App{
+				Name:              "my-app",
+				DerivedFromStacks: true,
+			},
+			wanted: []string{"derivedFromStacks"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.inApp.IncompleteFields()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
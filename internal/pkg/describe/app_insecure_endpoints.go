@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io"
+	"sort"
+)
+
+// AppInsecureEndpoint is a public endpoint of a service in an environment that only serves HTTP,
+// without an HTTPS listener nor a redirect to one.
+type AppInsecureEndpoint struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+	URL         string `json:"url,omitempty"` // Empty if the load balancer of the environment isn't in its stack outputs.
+}
+
+type appInsecureEndpoints []*AppInsecureEndpoint
+
+// humanString writes a row for each insecure endpoint sorted by service and environment. Repeated services are dittoed.
+// It returns true if any service was dittoed.
+func (e appInsecureEndpoints) humanString(w io.Writer, width int) (dittoed bool) {
+	sorted := make(appInsecureEndpoints, len(e))
+	copy(sorted, e)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Service != sorted[j].Service {
+			return sorted[i].Service < sorted[j].Service
+		}
+		return sorted[i].Environment < sorted[j].Environment
+	})
+	headers := []string{"Service", "Environment", "URL"}
+	rows := [][]string{headers, underline(headers)}
+	for i, endpoint := range sorted {
+		svc := endpoint.Service
+		if i > 0 && sorted[i-1].Service == endpoint.Service {
+			svc = dittoSymbol
+			dittoed = true
+		}
+		rows = append(rows, []string{svc, endpoint.Environment, valueOrDash(endpoint.URL)})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
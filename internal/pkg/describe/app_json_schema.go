@@ -0,0 +1,192 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	jsonSchemaDefsRef = "#/$defs/"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// jsonSchema is a node of a JSON Schema document.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Comment              string                 `json:"$comment,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 interface{}            `json:"type,omitempty"` // A type, or a list of types if the value can be null.
+	Format               string                 `json:"format,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// AppJSONSchema returns the JSON Schema of the json format of the App struct for a version of AppFormatVersions,
+// or AppFormatVersionLatest if it's empty. The schema is generated from the json tags of the struct so that it never
+// drifts from the output: each struct is a definition whose required properties are the fields without omitempty,
+// and the slices, maps and pointers without omitempty can be null.
+func AppJSONSchema(formatVersion string) (string, error) {
+	if formatVersion == "" {
+		formatVersion = AppFormatVersionLatest
+	}
+	g := &jsonSchemaGenerator{
+		defs:  make(map[string]*jsonSchema),
+		types: make(map[string]reflect.Type),
+	}
+	root, err := g.schema(reflect.TypeOf(App{}))
+	if err != nil {
+		return "", fmt.Errorf("generate json schema of application description: %w", err)
+	}
+	root.Schema = jsonSchemaDialect
+	root.Title = "Application description"
+	root.Comment = fmt.Sprintf("Format version %s of the json output of copilot app show.", formatVersion)
+	root.Defs = g.defs
+	b, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal json schema of application description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// jsonSchemaGenerator generates the schemas of Go types, with a definition for each struct.
+type jsonSchemaGenerator struct {
+	defs  map[string]*jsonSchema  // Definition name to the schema of the struct.
+	types map[string]reflect.Type // Definition name to the struct, to catch the structs of different packages with the same name.
+}
+
+func (g *jsonSchemaGenerator) schema(t reflect.Type) (*jsonSchema, error) {
+	if t == timeType {
+		return &jsonSchema{Type: "string", Format: "date-time"}, nil
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return nil, fmt.Errorf("type %s has a custom json encoding", t)
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return &jsonSchema{Type: "string"}, nil
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}, nil
+	case reflect.Interface:
+		return &jsonSchema{}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSchema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map %s doesn't have string keys", t)
+		}
+		values, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSchema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structRef(t)
+	}
+	return nil, fmt.Errorf("type %s can't be encoded in json", t)
+}
+
+// structRef returns a reference to the definition of a struct, adding the definition the first time.
+func (g *jsonSchemaGenerator) structRef(t reflect.Type) (*jsonSchema, error) {
+	ref := &jsonSchema{Ref: jsonSchemaDefsRef + t.Name()}
+	if seen, ok := g.types[t.Name()]; ok {
+		if seen != t {
+			return nil, fmt.Errorf("types %s and %s have the same name", seen, t)
+		}
+		return ref, nil
+	}
+	g.types[t.Name()] = t
+	def := &jsonSchema{
+		Type:       "object",
+		Properties: make(map[string]*jsonSchema),
+	}
+	g.defs[t.Name()] = def // Added before its fields so that a struct can reference itself.
+	if err := g.addFields(def, t); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+// addFields adds the exported fields of a struct to the properties of its definition, including the fields of
+// the structs it embeds, which are encoded inline.
+func (g *jsonSchemaGenerator) addFields(def *jsonSchema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := g.addFields(def, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" { // Unexported.
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema, err := g.schema(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s of %s: %w", field.Name, t.Name(), err)
+		}
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+		if !omitEmpty {
+			def.Required = append(def.Required, name)
+			switch field.Type.Kind() {
+			case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+				schema = nullable(schema)
+			}
+		}
+		def.Properties[name] = schema
+	}
+	return nil
+}
+
+// nullable returns a schema that also accepts null.
+func nullable(schema *jsonSchema) *jsonSchema {
+	switch typ := schema.Type.(type) {
+	case string:
+		schema.Type = []string{typ, "null"}
+		return schema
+	case nil:
+		if schema.Ref == "" { // Already accepts anything.
+			return schema
+		}
+	}
+	return &jsonSchema{AnyOf: []*jsonSchema{schema, {Type: "null"}}}
+}
@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppJSONSchema(t *testing.T) {
+	testCases := map[string]struct {
+		inFormatVersion string
+
+		wantedComment string
+	}{
+		"defaults to the latest format version": {
+			wantedComment: "Format version 1 of the json output of copilot app show.",
+		},
+		"pinned format version": {
+			inFormatVersion: AppFormatVersion1,
+			wantedComment:   "Format version 1 of the json output of copilot app show.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := AppJSONSchema(tc.inFormatVersion)
+
+			// THEN
+			require.NoError(t, err)
+			var schema map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(out), &schema))
+			require.Equal(t, jsonSchemaDialect, schema["$schema"])
+			require.Equal(t, tc.wantedComment, schema["$comment"])
+			require.Equal(t, "#/$defs/App", schema["$ref"])
+		})
+	}
+}
+
+func TestAppJSONSchema_Fields(t *testing.T) {
+	out, err := AppJSONSchema("")
+	require.NoError(t, err)
+	var schema struct {
+		Defs map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Required   []string                   `json:"required"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &schema))
+
+	app := schema.Defs["App"]
+	require.Equal(t, []string{"name", "environments", "services", "pipelines"}, app.Required, "expected the fields without omitempty to be required")
+	require.NotContains(t, app.Properties, "FormatVersion", "expected the fields left out of the json to be left out of the schema")
+	require.JSONEq(t, `{"type": ["array", "null"], "items": {"$ref": "#/$defs/Pipeline"}}`, string(app.Properties["pipelines"]),
+		"expected the skipped pipelines to be null")
+	require.JSONEq(t, `{"type": "integer"}`, string(app.Properties["healthScore"]))
+	require.JSONEq(t, `{"type": "object", "additionalProperties": {"type": "string"}}`, string(app.Properties["environmentStatuses"]))
+	require.JSONEq(t, `{"type": "string", "format": "date-time"}`, string(schema.Defs["Pipeline"].Properties["createdAt"]))
+}
+
+func TestAppJSONSchema_DescribesJSONString(t *testing.T) {
+	app := &App{
+		Name: "my-app",
+		URI:  "my-app.example.com",
+		Envs: []*config.Environment{
+			{App: "my-app", Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+		},
+		Services: []*config.Workload{
+			{App: "my-app", Name: "api", Type: "Load Balanced Web Service"},
+		},
+		Pipelines: []*codepipeline.Pipeline{
+			{
+				Name:      "pipeline-my-app",
+				CreatedAt: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+				Stages:    []*codepipeline.Stage{{Name: "Source", Category: "Source"}},
+				Build:     &codepipeline.PipelineBuild{Last: &codepipeline.BuildExecution{Status: "Succeeded"}},
+			},
+		},
+		EnvStatuses: map[string]string{"test": "UPDATE_COMPLETE"},
+		Deployments: []*AppDeployment{
+			{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE", Endpoints: []*AppEndpoint{
+				{URL: "https://api.example.com", Kind: EndpointCustomDomain},
+			}},
+		},
+		Allocation:  &AppAllocation{CPU: 256, Memory: 512},
+		Warnings:    []*AppWarning{{Severity: WarningSeverityInfo, Message: "some warning"}},
+		HealthScore: aws.Int(100),
+	}
+	out, err := AppJSONSchema("")
+	require.NoError(t, err)
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &schema))
+	defs := schema["$defs"].(map[string]interface{})
+
+	for name, description := range map[string]*App{
+		"described application":         app,
+		"application without pipelines": {Name: "my-app", PipelinesSkipped: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, err := description.JSONString(AppRenderOptions{})
+			require.NoError(t, err)
+			var value interface{}
+			require.NoError(t, json.Unmarshal([]byte(s), &value))
+			requireConforms(t, defs, schema, value, "$")
+		})
+	}
+}
+
+// requireConforms fails the test if the value doesn't have the types, required properties and declared properties
+// of the schema, which is all the keywords that AppJSONSchema generates.
+func requireConforms(t *testing.T, defs map[string]interface{}, schema map[string]interface{}, value interface{}, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		requireConforms(t, defs, defs[strings.TrimPrefix(ref, jsonSchemaDefsRef)].(map[string]interface{}), value, path)
+		return
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		if value == nil {
+			return
+		}
+		requireConforms(t, defs, anyOf[0].(map[string]interface{}), value, path)
+		return
+	}
+	var types []string
+	switch typ := schema["type"].(type) {
+	case string:
+		types = []string{typ}
+	case []interface{}:
+		for _, tt := range typ {
+			types = append(types, tt.(string))
+		}
+	default:
+		return
+	}
+	var actual string
+	switch value.(type) {
+	case nil:
+		actual = "null"
+	case bool:
+		actual = "boolean"
+	case float64:
+		actual = "number"
+		if v := value.(float64); v == float64(int64(v)) && !contains(types, "number") {
+			actual = "integer"
+		}
+	case string:
+		actual = "string"
+	case []interface{}:
+		actual = "array"
+	case map[string]interface{}:
+		actual = "object"
+	}
+	require.Contains(t, types, actual, "unexpected type of %s", path)
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			requireConforms(t, defs, schema["items"].(map[string]interface{}), item, path+"[]")
+		}
+	case map[string]interface{}:
+		if values, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			for key, item := range v {
+				requireConforms(t, defs, values, item, path+"."+key)
+			}
+			return
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]interface{})
+		for _, key := range required {
+			require.Contains(t, v, key, "missing required property of %s", path)
+		}
+		for key, item := range v {
+			property, ok := properties[key]
+			require.True(t, ok, "undeclared property %s of %s", key, path)
+			requireConforms(t, defs, property.(map[string]interface{}), item, path+"."+key)
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
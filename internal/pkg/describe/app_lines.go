@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// svcTypeAbbreviations are the short names of the service types in the summary lines.
+var svcTypeAbbreviations = map[string]string{
+	manifest.LoadBalancedWebServiceType:  "LBWS",
+	manifest.BackendServiceType:          "Backend",
+	manifest.RequestDrivenWebServiceType: "RDWS",
+	manifest.ScheduledJobType:            "Job",
+}
+
+// Lines returns a human readable summary of the App struct in a few lines, like "Services: api (LBWS), worker (Backend)".
+func (a *App) Lines(opts AppRenderOptions) []string {
+	var envs []string
+	for _, env := range a.sortedEnvs(opts.EnvSort) {
+		envs = append(envs, env.Name)
+	}
+	var svcs []string
+	for _, svc := range a.Services {
+		svcType := svc.Type
+		if abbreviation, ok := svcTypeAbbreviations[svc.Type]; ok {
+			svcType = abbreviation
+		}
+		if svcType == "" {
+			svcs = append(svcs, svc.Name)
+			continue
+		}
+		svcs = append(svcs, fmt.Sprintf("%s (%s)", svc.Name, svcType))
+	}
+	var pipelines []string
+	for _, pipeline := range a.Pipelines {
+		pipelines = append(pipelines, pipeline.Name)
+	}
+	lines := []string{
+		fmt.Sprintf("App: %s", a.Name),
+		fmt.Sprintf("Envs: %s", valueOrDash(strings.Join(envs, ", "))),
+		fmt.Sprintf("Services: %s", valueOrDash(strings.Join(svcs, ", "))),
+	}
+	if !a.PipelinesSkipped {
+		lines = append(lines, fmt.Sprintf("Pipelines: %s", valueOrDash(strings.Join(pipelines, ", "))))
+	}
+	if len(a.Warnings) != 0 {
+		lines = append(lines, fmt.Sprintf("Warnings: %d", len(a.Warnings)))
+	}
+	return lines
+}
+
+// LinesString returns the summary lines of the App struct as a JSON array of strings, to be posted as is to a chat.
+func (a *App) LinesString(opts AppRenderOptions) (string, error) {
+	b, err := json.Marshal(a.Lines(opts))
+	if err != nil {
+		return "", fmt.Errorf("marshal summary lines: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
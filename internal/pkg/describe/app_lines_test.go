@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_LinesString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"summarizes an empty application with dashes": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: `["App: my-app","Envs: -","Services: -","Pipelines: -"]` + "\n",
+		},
+		"summarizes the environments, services and pipelines": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "staging"},
+					{Name: "prod", Prod: true},
+				},
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "worker", Type: "Worker Service"},
+					{Name: "draft"},
+				},
+				Pipelines: []*codepipeline.Pipeline{{Name: "release"}},
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityWarning, Message: "some warning"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				EnvSort: EnvSortName,
+			},
+			wantedContent: `["App: my-app","Envs: prod, staging","Services: api (LBWS), worker (Worker Service), draft","Pipelines: release","Warnings: 1"]` + "\n",
+		},
+		"omits the pipelines if they were skipped": {
+			inApp: &App{
+				Name:             "my-app",
+				PipelinesSkipped: true,
+			},
+			wantedContent: `["App: my-app","Envs: -","Services: -"]` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out, err := tc.inApp.LinesString(tc.inOpts)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, out)
+		})
+	}
+}
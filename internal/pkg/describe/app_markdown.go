@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// markdownCellReplacer escapes the pipes that would end a cell of a GitHub-flavored Markdown table,
+// and the line breaks that would end its row.
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// MarkdownString returns the environments, services and pipelines of the App struct as GitHub-flavored Markdown tables,
+// to be pasted in pull requests and wikis.
+func (a *App) MarkdownString(opts AppRenderOptions) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "## %s\n", markdownCellReplacer.Replace(a.Name))
+	b.WriteString("\n### Environments\n\n")
+	rows := [][]string{{"Name", "AccountID", "Region", "Status"}}
+	for _, env := range a.sortedEnvs(opts.EnvSort) {
+		rows = append(rows, []string{env.Name, env.AccountID, env.Region, valueOrDash(a.EnvStatuses[env.Name])})
+	}
+	writeMarkdownTable(&b, rows)
+	b.WriteString("\n### Services\n\n")
+	rows = [][]string{{"Name", "Type"}}
+	for _, svc := range a.Services {
+		rows = append(rows, []string{svc.Name, valueOrDash(svc.Type)})
+	}
+	writeMarkdownTable(&b, rows)
+	b.WriteString("\n### Pipelines\n\n")
+	if a.PipelinesSkipped {
+		fmt.Fprintf(&b, "%s\n", pipelinesSkipped)
+		return b.String()
+	}
+	rows = [][]string{{"Name"}}
+	for _, pipeline := range a.Pipelines {
+		rows = append(rows, []string{pipeline.Name})
+	}
+	writeMarkdownTable(&b, rows)
+	return b.String()
+}
+
+// writeMarkdownTable writes the rows as a Markdown table whose header is the first row.
+func writeMarkdownTable(b *bytes.Buffer, rows [][]string) {
+	separators := make([]string, len(rows[0]))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	writeMarkdownRow(b, rows[0])
+	writeMarkdownRow(b, separators)
+	for _, row := range rows[1:] {
+		writeMarkdownRow(b, row)
+	}
+}
+
+func writeMarkdownRow(b *bytes.Buffer, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = markdownCellReplacer.Replace(cell)
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_MarkdownString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"renders the environments, services and pipelines as tables": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				EnvStatuses: map[string]string{"test": "UPDATE_COMPLETE"},
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "draft"},
+				},
+				Pipelines: []*codepipeline.Pipeline{{Name: "release"}},
+			},
+			wantedContent: `## my-app
+
+### Environments
+
+| Name | AccountID | Region | Status |
+| --- | --- | --- | --- |
+| test | 123456789012 | us-west-2 | UPDATE_COMPLETE |
+| prod | 123456789012 | us-east-1 | - |
+
+### Services
+
+| Name | Type |
+| --- | --- |
+| api | Load Balanced Web Service |
+| draft | - |
+
+### Pipelines
+
+| Name |
+| --- |
+| release |
+`,
+		},
+		"escapes the pipes and line breaks of the values": {
+			inApp: &App{
+				Name:      "my-app",
+				Services:  []*config.Workload{{Name: "a|b", Type: "line\nbreak"}},
+				Pipelines: []*codepipeline.Pipeline{},
+			},
+			wantedContent: `## my-app
+
+### Environments
+
+| Name | AccountID | Region | Status |
+| --- | --- | --- | --- |
+
+### Services
+
+| Name | Type |
+| --- | --- |
+| a\|b | line<br>break |
+
+### Pipelines
+
+| Name |
+| --- |
+`,
+		},
+		"replaces the pipelines if they were skipped": {
+			inApp: &App{
+				Name:             "my-app",
+				PipelinesSkipped: true,
+			},
+			wantedContent: `## my-app
+
+### Environments
+
+| Name | AccountID | Region | Status |
+| --- | --- | --- | --- |
+
+### Services
+
+| Name | Type |
+| --- | --- |
+
+### Pipelines
+
+(skipped)
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.MarkdownString(tc.inOpts))
+		})
+	}
+}
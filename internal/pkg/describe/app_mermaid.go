@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// mermaidLabelReplacer escapes the quotes that would end the label of a Mermaid node,
+// and the line breaks that would end its statement.
+var mermaidLabelReplacer = strings.NewReplacer(`"`, "#quot;", "\r\n", "<br/>", "\n", "<br/>")
+
+// MermaidString returns the topology of the App struct as a Mermaid flowchart definition, to be pasted in
+// architecture docs. Each environment is a subgraph of the application with a node for each service deployed in it,
+// labeled with the endpoints of the service if they were retrieved, or else the URL of the App Runner service.
+// The services that aren't deployed in any environment are linked to the application directly.
+// The nodes are identified by position rather than by name, so that any name renders.
+func (a *App) MermaidString(opts AppRenderOptions) string {
+	urls := make(map[string][]string) // Service and environment to the URLs of the deployment.
+	for _, svc := range a.AppRunnerServices {
+		urls[svc.Service+"/"+svc.Environment] = []string{svc.URL}
+	}
+	deployedIn := make(map[string][]string) // Environment name to the services deployed in it.
+	deployed := make(map[string]bool)
+	for _, d := range a.Deployments {
+		deployedIn[d.Environment] = append(deployedIn[d.Environment], d.Service)
+		deployed[d.Service] = true
+		if len(d.Endpoints) == 0 {
+			continue
+		}
+		endpoints := make([]string, len(d.Endpoints))
+		for i, e := range d.Endpoints {
+			endpoints[i] = e.URL
+		}
+		urls[d.Service+"/"+d.Environment] = endpoints
+	}
+
+	var b bytes.Buffer
+	b.WriteString("graph TD\n")
+	fmt.Fprintf(&b, "  app[%s]\n", mermaidLabel(a.Name))
+	for i, env := range a.sortedEnvs(opts.EnvSort) {
+		fmt.Fprintf(&b, "  subgraph env%d[%s]\n", i, mermaidLabel(env.Name))
+		for j, svc := range deployedIn[env.Name] {
+			lines := append([]string{svc}, urls[svc+"/"+env.Name]...)
+			fmt.Fprintf(&b, "    env%d_svc%d[%s]\n", i, j, mermaidLabel(strings.Join(lines, "\n")))
+		}
+		b.WriteString("  end\n")
+		fmt.Fprintf(&b, "  app --> env%d\n", i)
+	}
+	for i, svc := range a.Services {
+		if deployed[svc.Name] {
+			continue
+		}
+		fmt.Fprintf(&b, "  app --> svc%d[%s]\n", i, mermaidLabel(svc.Name))
+	}
+	return b.String()
+}
+
+// mermaidLabel returns the quoted label of a Mermaid node.
+func mermaidLabel(text string) string {
+	return fmt.Sprintf(`"%s"`, mermaidLabelReplacer.Replace(text))
+}
@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_MermaidString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"renders the environments as subgraphs of the services deployed in them": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "frontend", Type: "Request-Driven Web Service"},
+					{Name: "draft", Type: "Backend Service"},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", Endpoints: []*AppEndpoint{
+						{URL: "https://api.test.my-app.example.com", Kind: EndpointCustomDomain},
+						{URL: "http://my-lb.us-west-2.elb.amazonaws.com", Kind: EndpointLoadBalancer},
+					}},
+					{Service: "frontend", Environment: "test"},
+					{Service: "api", Environment: "prod"},
+				},
+				AppRunnerServices: []*AppRunnerService{
+					{Service: "frontend", Environment: "test", URL: "abc.us-west-2.awsapprunner.com"},
+				},
+			},
+			wantedContent: `graph TD
+  app["my-app"]
+  subgraph env0["test"]
+    env0_svc0["api<br/>https://api.test.my-app.example.com<br/>http://my-lb.us-west-2.elb.amazonaws.com"]
+    env0_svc1["frontend<br/>abc.us-west-2.awsapprunner.com"]
+  end
+  app --> env0
+  subgraph env1["prod"]
+    env1_svc0["api"]
+  end
+  app --> env1
+  app --> svc2["draft"]
+`,
+		},
+		"escapes the quotes and line breaks of the names": {
+			inApp: &App{
+				Name:     `my "app"`,
+				Services: []*config.Workload{{Name: "line\nbreak"}},
+			},
+			wantedContent: `graph TD
+  app["my #quot;app#quot;"]
+  app --> svc0["line<br/>break"]
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.MermaidString(tc.inOpts))
+		})
+	}
+}
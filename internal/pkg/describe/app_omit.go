@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// jsonPathSep separates the fields of a path of the json format, like "deployments.storage".
+const jsonPathSep = "."
+
+// IsJSONField returns true if the dotted path, like "deployments.storage", is a field of the json format of App.
+// The fields of the elements of a list are fields of the list, and any key of a map is a field of the map.
+func IsJSONField(path string) bool {
+	t := reflect.TypeOf(App{})
+	for _, name := range strings.Split(path, jsonPathSep) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := jsonField(t, name)
+			if !ok {
+				return false
+			}
+			t = field.Type
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// jsonField returns the field of the struct that is encoded with the name in json, including the fields of its
+// embedded structs.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName := strings.Split(tag, ",")[0]
+		if field.Anonymous && tagName == "" && field.Type.Kind() == reflect.Struct {
+			if embedded, ok := jsonField(field.Type, name); ok {
+				return embedded, true
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			// Unexported fields aren't encoded.
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// omitJSON returns the json value without the fields at the paths, each split into the names of its fields.
+// The other fields are kept in the same order, and the fields of the elements of a list are omitted from each element.
+func omitJSON(data []byte, paths [][]string) ([]byte, error) {
+	if len(paths) == 0 {
+		return data, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("read json value: %w", err)
+	}
+	var b bytes.Buffer
+	switch tok {
+	case json.Delim('{'):
+		b.WriteByte('{')
+		for first := true; dec.More(); {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("read json key: %w", err)
+			}
+			key := keyTok.(string)
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil, fmt.Errorf("read json value of %s: %w", key, err)
+			}
+			var omitted bool
+			var rest [][]string
+			for _, path := range paths {
+				if path[0] != key {
+					continue
+				}
+				if len(path) == 1 {
+					omitted = true
+					break
+				}
+				rest = append(rest, path[1:])
+			}
+			if omitted {
+				continue
+			}
+			trimmed, err := omitJSON(value, rest)
+			if err != nil {
+				return nil, err
+			}
+			if !first {
+				b.WriteByte(',')
+			}
+			first = false
+			encodedKey, err := json.Marshal(key)
+			if err != nil {
+				return nil, fmt.Errorf("marshal json key %s: %w", key, err)
+			}
+			b.Write(encodedKey)
+			b.WriteByte(':')
+			b.Write(trimmed)
+		}
+		b.WriteByte('}')
+	case json.Delim('['):
+		b.WriteByte('[')
+		for first := true; dec.More(); first = false {
+			var elem json.RawMessage
+			if err := dec.Decode(&elem); err != nil {
+				return nil, fmt.Errorf("read json element: %w", err)
+			}
+			trimmed, err := omitJSON(elem, paths)
+			if err != nil {
+				return nil, err
+			}
+			if !first {
+				b.WriteByte(',')
+			}
+			b.Write(trimmed)
+		}
+		b.WriteByte(']')
+	default:
+		return data, nil
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsJSONField(t *testing.T) {
+	testCases := map[string]struct {
+		inPath string
+
+		wanted bool
+	}{
+		"top-level field": {
+			inPath: "deployments",
+			wanted: true,
+		},
+		"field of the elements of a list": {
+			inPath: "deployments.storage.fileSystemID",
+			wanted: true,
+		},
+		"field of a struct of the config store": {
+			inPath: "environments.region",
+			wanted: true,
+		},
+		"any key of a map": {
+			inPath: "environmentStatuses.test",
+			wanted: true,
+		},
+		"field named as in Go rather than in json": {
+			inPath: "Deployments",
+		},
+		"field that isn't in the json format": {
+			inPath: "indentJSON",
+		},
+		"field of a value": {
+			inPath: "name.first",
+		},
+		"unknown field": {
+			inPath: "deployments.rawOutputs",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, IsJSONField(tc.inPath))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	openMetricsTypeGauge = "gauge"
+	openMetricsEOF       = "# EOF\n"
+)
+
+// openMetricsLabelEscaper escapes the characters that can't appear as is in the value of an OpenMetrics label.
+var openMetricsLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// openMetricsLabel is a label of a sample.
+type openMetricsLabel struct {
+	name  string
+	value string
+}
+
+// openMetricsSample is a value of a metric with its labels.
+type openMetricsSample struct {
+	labels []openMetricsLabel
+	value  int
+}
+
+// openMetricsFamily is a metric with its metadata and samples.
+type openMetricsFamily struct {
+	name    string
+	help    string
+	samples []openMetricsSample
+}
+
+// OpenMetricsString returns the App struct as metrics in the OpenMetrics text format.
+// All the samples share the timestamp, so that the metrics of one description are ingested as a single scrape.
+// The deployments are labeled like the rows of CSVString: app, environment, service, type and status.
+func (a *App) OpenMetricsString(timestamp time.Time) string {
+	app := openMetricsLabel{name: "app", value: a.Name}
+	types := make(map[string]string)
+	for _, svc := range a.Services {
+		types[svc.Name] = svc.Type
+	}
+	deployments := openMetricsFamily{
+		name: "copilot_app_deployment_status",
+		help: "Deployment of a service in an environment, labeled with the status of its stack.",
+	}
+	for _, d := range a.Deployments {
+		deployments.samples = append(deployments.samples, openMetricsSample{
+			labels: []openMetricsLabel{
+				app,
+				{name: "environment", value: d.Environment},
+				{name: "service", value: d.Service},
+				{name: "type", value: types[d.Service]},
+				{name: "status", value: d.StackStatus},
+			},
+			value: 1,
+		})
+	}
+	warnings := openMetricsFamily{
+		name: "copilot_app_warnings",
+		help: "Number of warnings found while describing the application, by severity.",
+	}
+	for _, severity := range WarningSeverities {
+		count := 0
+		for _, w := range a.Warnings {
+			if w.Severity == severity {
+				count++
+			}
+		}
+		warnings.samples = append(warnings.samples, openMetricsSample{
+			labels: []openMetricsLabel{app, {name: "severity", value: severity}},
+			value:  count,
+		})
+	}
+	families := []openMetricsFamily{
+		{
+			name:    "copilot_app_environments",
+			help:    "Number of environments of the application.",
+			samples: []openMetricsSample{{labels: []openMetricsLabel{app}, value: a.EnvCount()}},
+		},
+		{
+			name:    "copilot_app_services",
+			help:    "Number of services of the application.",
+			samples: []openMetricsSample{{labels: []openMetricsLabel{app}, value: a.ServiceCount()}},
+		},
+		{
+			name:    "copilot_app_jobs",
+			help:    "Number of jobs of the application.",
+			samples: []openMetricsSample{{labels: []openMetricsLabel{app}, value: a.JobCount()}},
+		},
+		deployments,
+		warnings,
+	}
+	if !a.PipelinesSkipped {
+		families = append(families, openMetricsFamily{
+			name:    "copilot_app_pipelines",
+			help:    "Number of pipelines of the application.",
+			samples: []openMetricsSample{{labels: []openMetricsLabel{app}, value: len(a.Pipelines)}},
+		})
+	}
+	ts := fmt.Sprintf("%d.%03d", timestamp.Unix(), timestamp.Nanosecond()/int(time.Millisecond))
+	var b bytes.Buffer
+	for _, family := range families {
+		family.write(&b, ts)
+	}
+	b.WriteString(openMetricsEOF)
+	return b.String()
+}
+
+// write writes the metadata and the samples of the metric with the timestamp.
+func (f openMetricsFamily) write(b *bytes.Buffer, timestamp string) {
+	fmt.Fprintf(b, "# TYPE %s %s\n", f.name, openMetricsTypeGauge)
+	fmt.Fprintf(b, "# HELP %s %s\n", f.name, f.help)
+	for _, s := range f.samples {
+		labels := make([]string, len(s.labels))
+		for i, l := range s.labels {
+			labels[i] = fmt.Sprintf(`%s="%s"`, l.name, openMetricsLabelEscaper.Replace(l.value))
+		}
+		fmt.Fprintf(b, "%s{%s} %d %s\n", f.name, strings.Join(labels, ","), s.value, timestamp)
+	}
+}
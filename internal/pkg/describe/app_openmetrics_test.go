@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_OpenMetricsString(t *testing.T) {
+	timestamp := time.Unix(1700000000, 123456789)
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedContent string
+	}{
+		"metrics of an application with a shared timestamp": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test"}, {Name: "prod"},
+				},
+				Services: []*config.Workload{
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+					{Name: "report", Type: "Scheduled Job"},
+				},
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "pipeline-my-app"},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "frontend", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_COMPLETE"},
+				},
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityError, Message: "frontend rolled back in prod"},
+				},
+			},
+			wantedContent: `# TYPE copilot_app_environments gauge
+# HELP copilot_app_environments Number of environments of the application.
+copilot_app_environments{app="my-app"} 2 1700000000.123
+# TYPE copilot_app_services gauge
+# HELP copilot_app_services Number of services of the application.
+copilot_app_services{app="my-app"} 1 1700000000.123
+# TYPE copilot_app_jobs gauge
+# HELP copilot_app_jobs Number of jobs of the application.
+copilot_app_jobs{app="my-app"} 1 1700000000.123
+# TYPE copilot_app_deployment_status gauge
+# HELP copilot_app_deployment_status Deployment of a service in an environment, labeled with the status of its stack.
+copilot_app_deployment_status{app="my-app",environment="test",service="frontend",type="Load Balanced Web Service",status="UPDATE_COMPLETE"} 1 1700000000.123
+copilot_app_deployment_status{app="my-app",environment="prod",service="frontend",type="Load Balanced Web Service",status="UPDATE_ROLLBACK_COMPLETE"} 1 1700000000.123
+# TYPE copilot_app_warnings gauge
+# HELP copilot_app_warnings Number of warnings found while describing the application, by severity.
+copilot_app_warnings{app="my-app",severity="info"} 0 1700000000.123
+copilot_app_warnings{app="my-app",severity="warning"} 0 1700000000.123
+copilot_app_warnings{app="my-app",severity="error"} 1 1700000000.123
+# TYPE copilot_app_pipelines gauge
+# HELP copilot_app_pipelines Number of pipelines of the application.
+copilot_app_pipelines{app="my-app"} 1 1700000000.123
+# EOF
+`,
+		},
+		"omits the pipelines if they were skipped and escapes the label values": {
+			inApp: &App{
+				Name:             `my-"app"`,
+				PipelinesSkipped: true,
+			},
+			wantedContent: `# TYPE copilot_app_environments gauge
+# HELP copilot_app_environments Number of environments of the application.
+copilot_app_environments{app="my-\"app\""} 0 1700000000.123
+# TYPE copilot_app_services gauge
+# HELP copilot_app_services Number of services of the application.
+copilot_app_services{app="my-\"app\""} 0 1700000000.123
+# TYPE copilot_app_jobs gauge
+# HELP copilot_app_jobs Number of jobs of the application.
+copilot_app_jobs{app="my-\"app\""} 0 1700000000.123
+# TYPE copilot_app_deployment_status gauge
+# HELP copilot_app_deployment_status Deployment of a service in an environment, labeled with the status of its stack.
+# TYPE copilot_app_warnings gauge
+# HELP copilot_app_warnings Number of warnings found while describing the application, by severity.
+copilot_app_warnings{app="my-\"app\"",severity="info"} 0 1700000000.123
+copilot_app_warnings{app="my-\"app\"",severity="warning"} 0 1700000000.123
+copilot_app_warnings{app="my-\"app\"",severity="error"} 0 1700000000.123
+# EOF
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.OpenMetricsString(timestamp))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// artifactsUnencrypted is the encryption of the artifacts of a pipeline that neither the pipeline nor its bucket encrypt.
+	artifactsUnencrypted = "none"
+	// artifactsKeptForever is the retention of the artifacts of a bucket without an expiration rule.
+	artifactsKeptForever = "never expire"
+)
+
+type appPipelineArtifacts []*codepipeline.Pipeline
+
+// stored returns the pipelines whose artifact store was retrieved.
+func (p appPipelineArtifacts) stored() appPipelineArtifacts {
+	var stored appPipelineArtifacts
+	for _, pipeline := range p {
+		if pipeline.ArtifactStore != nil {
+			stored = append(stored, pipeline)
+		}
+	}
+	return stored
+}
+
+// humanString writes a row for each pipeline with the bucket of its artifacts, how they're encrypted and how long
+// they're kept. The key of the pipeline takes precedence over the default encryption of the bucket, and the artifacts
+// that neither encrypt are in red.
+func (p appPipelineArtifacts) humanString(w io.Writer, width int) {
+	headers := []string{"Pipeline", "Bucket", "Encryption", "Retention"}
+	rows := [][]string{headers, underline(headers)}
+	for _, pipeline := range p {
+		store := pipeline.ArtifactStore
+		encryption := store.EncryptionKey
+		switch {
+		case encryption != "":
+		case store.BucketEncryption != "":
+			encryption = store.BucketEncryption
+		case store.Encrypted != nil && !*store.Encrypted:
+			encryption = color.Red.Sprint(artifactsUnencrypted)
+		}
+		retention := artifactsKeptForever
+		if store.ExpirationDays != nil {
+			retention = fmt.Sprintf("%d days", *store.ExpirationDays)
+		}
+		rows = append(rows, []string{pipeline.Name, store.Bucket, valueOrDash(encryption), retention})
+	}
+	writeTable(w, rows, width)
+}
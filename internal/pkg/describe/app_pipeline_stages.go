@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AppPipelineStages is the stage structure of a pipeline of the application.
+type AppPipelineStages struct {
+	Pipeline string              `json:"pipeline"`
+	Stages   []*AppPipelineStage `json:"stages"`
+}
+
+// AppPipelineStage is a stage of a pipeline with the services it deploys.
+type AppPipelineStage struct {
+	Name     string               `json:"name"`
+	Category string               `json:"category,omitempty"`
+	Deploys  []*AppPipelineDeploy `json:"deploys,omitempty"` // Empty if the stage doesn't deploy any service of the application.
+}
+
+// AppPipelineDeploy is a service deployed to an environment by a stage of a pipeline.
+type AppPipelineDeploy struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+	// RunOrder is the order the service is deployed in within its stage. Services with the same order are deployed in parallel.
+	RunOrder int `json:"runOrder,omitempty"`
+}
+
+type appPipelineStages []*AppPipelineStages
+
+// humanString writes a row for each stage of the pipelines in their order, with the services each stage deploys.
+// Services deployed after others of the same stage are prefixed with their run order. Repeated pipeline names are dittoed.
+// It returns true if any pipeline name was dittoed.
+func (p appPipelineStages) humanString(w io.Writer, width int) (dittoed bool) {
+	headers := []string{"Pipeline", "Stage", "Category", "Services"}
+	rows := [][]string{headers, underline(headers)}
+	for _, pipeline := range p {
+		for i, stage := range pipeline.Stages {
+			name := pipeline.Pipeline
+			if i > 0 {
+				name = dittoSymbol
+				dittoed = true
+			}
+			rows = append(rows, []string{name, stage.Name, valueOrDash(stage.Category), valueOrDash(strings.Join(stage.services(), ", "))})
+		}
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+// services returns the services deployed by the stage like "api (test)", prefixed with their run order like
+// "2. api (test)" if the stage deploys them in more than one step.
+func (s *AppPipelineStage) services() []string {
+	steps := make(map[int]bool)
+	for _, deploy := range s.Deploys {
+		steps[deploy.RunOrder] = true
+	}
+	var services []string
+	for _, deploy := range s.Deploys {
+		service := fmt.Sprintf("%s (%s)", deploy.Service, deploy.Environment)
+		if len(steps) > 1 {
+			service = fmt.Sprintf("%d. %s", deploy.RunOrder, service)
+		}
+		services = append(services, service)
+	}
+	return services
+}
@@ -0,0 +1,102 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Checks of the readiness of the target environment of a promotion.
+const (
+	PromotionCheckExists          = "exists"           // The environment is in the application.
+	PromotionCheckHealthy         = "healthy"          // None of the stacks of the environment are in a failed state.
+	PromotionCheckTemplateVersion = "template version" // The environment template is at least as recent as the source's.
+	PromotionCheckNotDeploying    = "not deploying"    // None of the stacks of the environment are being deployed.
+)
+
+const (
+	promotionCheckPassed = "PASS"
+	promotionCheckFailed = "FAIL"
+
+	fmtPromotionReady    = "Environment %s is ready for the promotion of application %s from %s.\n\n"
+	fmtPromotionNotReady = "Environment %s is not ready for the promotion of application %s from %s: %d of %d checks failed.\n\n"
+)
+
+// PromotionCheckResult is the outcome of a check of the target environment of a promotion.
+type PromotionCheckResult struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Reason string `json:"reason"`
+}
+
+// AppPromotionCheck contains whether an environment of an application is ready for the services deployed in
+// another environment to be promoted to it.
+type AppPromotionCheck struct {
+	App    string                  `json:"app"`
+	From   string                  `json:"from"`
+	To     string                  `json:"to"`
+	Ready  bool                    `json:"ready"`
+	Checks []*PromotionCheckResult `json:"checks"`
+}
+
+// NewAppPromotionCheck returns the report of the checks of the promotion of the application from an environment to another.
+// The target environment is ready if all of the checks passed.
+func NewAppPromotionCheck(app, from, to string, checks []*PromotionCheckResult) *AppPromotionCheck {
+	return &AppPromotionCheck{
+		App:    app,
+		From:   from,
+		To:     to,
+		Ready:  countFailed(checks) == 0,
+		Checks: checks,
+	}
+}
+
+// Failed returns the number of checks that didn't pass.
+func (c *AppPromotionCheck) Failed() int {
+	return countFailed(c.Checks)
+}
+
+// JSONString returns the stringified AppPromotionCheck struct with json format.
+func (c *AppPromotionCheck) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal promotion check: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppPromotionCheck struct with human readable format.
+func (c *AppPromotionCheck) HumanString() string {
+	var b bytes.Buffer
+	if c.Ready {
+		fmt.Fprintf(&b, fmtPromotionReady, color.HighlightUserInput(c.To), color.HighlightUserInput(c.App), color.HighlightUserInput(c.From))
+	} else {
+		fmt.Fprintf(&b, fmtPromotionNotReady, color.HighlightUserInput(c.To), color.HighlightUserInput(c.App), color.HighlightUserInput(c.From), c.Failed(), len(c.Checks))
+	}
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	for _, check := range c.Checks {
+		result := color.Green.Sprint(promotionCheckPassed)
+		if !check.Passed {
+			result = color.Red.Sprint(promotionCheckFailed)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", result, check.Name, check.Reason)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func countFailed(checks []*PromotionCheckResult) int {
+	var count int
+	for _, check := range checks {
+		if !check.Passed {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppPromotionCheck_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inChecks []*PromotionCheckResult
+
+		wantedContent string
+	}{
+		"reports that the environment is ready if all the checks passed": {
+			inChecks: []*PromotionCheckResult{
+				{Name: PromotionCheckExists, Passed: true, Reason: "Environment prod is in the application."},
+				{Name: PromotionCheckNotDeploying, Passed: true, Reason: "No stack of environment prod is being deployed."},
+			},
+			wantedContent: `Environment prod is ready for the promotion of application my-app from staging.
+
+  PASS              exists              Environment prod is in the application.
+  PASS              not deploying       No stack of environment prod is being deployed.
+`,
+		},
+		"counts the failed checks": {
+			inChecks: []*PromotionCheckResult{
+				{Name: PromotionCheckExists, Passed: true, Reason: "Environment prod is in the application."},
+				{Name: PromotionCheckHealthy, Passed: false, Reason: "Stack my-app-prod-api is in UPDATE_ROLLBACK_COMPLETE."},
+			},
+			wantedContent: `Environment prod is not ready for the promotion of application my-app from staging: 1 of 2 checks failed.
+
+  PASS              exists              Environment prod is in the application.
+  FAIL              healthy             Stack my-app-prod-api is in UPDATE_ROLLBACK_COMPLETE.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			check := NewAppPromotionCheck("my-app", "staging", "prod", tc.inChecks)
+			require.Equal(t, tc.wantedContent, check.HumanString())
+		})
+	}
+}
+
+func TestAppPromotionCheck_JSONString(t *testing.T) {
+	check := NewAppPromotionCheck("my-app", "staging", "prod", []*PromotionCheckResult{
+		{Name: PromotionCheckExists, Passed: false, Reason: "Environment prod is not in the application."},
+	})
+
+	out, err := check.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"app":"my-app","from":"staging","to":"prod","ready":false,"checks":[{"name":"exists","passed":false,"reason":"Environment prod is not in the application."}]}
+`, out)
+}
@@ -0,0 +1,26 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+// AppSection is a section of the description of an application that can be rendered as soon as it's resolved.
+type AppSection string
+
+// Sections of the description of an application.
+const (
+	AppSectionEnvironments AppSection = "environments"
+	AppSectionServices     AppSection = "services"
+	AppSectionPipelines    AppSection = "pipelines"
+)
+
+// AppSections are all the sections of the description of an application.
+var AppSections = []AppSection{AppSectionEnvironments, AppSectionServices, AppSectionPipelines}
+
+// AppSectionHandler is called with each section of the description of an application as soon as it's resolved,
+// before the whole description is. The partial App only has its name and the fields of the section set:
+// Envs for AppSectionEnvironments, Services and NotDeployed for AppSectionServices, and Pipelines and
+// PipelinesSkipped for AppSectionPipelines. It shares them with the final description, so it must not modify them.
+//
+// Each section is delivered exactly once, but the sections may arrive in any order. If the description fails,
+// only the sections resolved before the failure are delivered.
+type AppSectionHandler func(section AppSection, partial *App)
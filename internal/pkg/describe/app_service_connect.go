@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"io"
+	"sort"
+	"strings"
+)
+
+// AppServiceConnect is the Service Connect configuration of a service in an environment.
+type AppServiceConnect struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment"`
+	// Namespace is the Cloud Map namespace of the service, empty if it's the default namespace of the cluster.
+	Namespace string                       `json:"namespace,omitempty"`
+	Endpoints []*AppServiceConnectEndpoint `json:"endpoints,omitempty"` // Empty if the service is only a client.
+}
+
+// AppServiceConnectEndpoint is a port of a service that the other services of the namespace can reach.
+type AppServiceConnectEndpoint struct {
+	PortName string   `json:"portName"`
+	Aliases  []string `json:"aliases,omitempty"` // The DNS names and ports the clients reach the port at, like "api:8080".
+}
+
+type appServiceConnect []*AppServiceConnect
+
+// humanString writes a row with the endpoints of each service and the services it can reach, the other services
+// of its namespace in the same environment that have endpoints. Repeated environments are dittoed.
+// It returns true if any environment was dittoed.
+func (c appServiceConnect) humanString(w io.Writer, width int) (dittoed bool) {
+	sorted := make(appServiceConnect, len(c))
+	copy(sorted, c)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Environment != sorted[j].Environment {
+			return sorted[i].Environment < sorted[j].Environment
+		}
+		if sorted[i].Namespace != sorted[j].Namespace {
+			return sorted[i].Namespace < sorted[j].Namespace
+		}
+		return sorted[i].Service < sorted[j].Service
+	})
+	headers := []string{"Environment", "Namespace", "Service", "Endpoints", "Reaches"}
+	rows := [][]string{headers, underline(headers)}
+	for i, svc := range sorted {
+		env := svc.Environment
+		if i > 0 && sorted[i-1].Environment == svc.Environment {
+			env = dittoSymbol
+			dittoed = true
+		}
+		var endpoints []string
+		for _, endpoint := range svc.Endpoints {
+			if len(endpoint.Aliases) == 0 {
+				endpoints = append(endpoints, endpoint.PortName)
+				continue
+			}
+			endpoints = append(endpoints, endpoint.Aliases...)
+		}
+		rows = append(rows, []string{env, valueOrDash(svc.Namespace), svc.Service,
+			valueOrDash(strings.Join(endpoints, ", ")), valueOrDash(strings.Join(sorted.reaches(svc), ", "))})
+	}
+	writeTable(w, rows, width)
+	return dittoed
+}
+
+// reaches returns the other services with endpoints in the namespace of the service in its environment, sorted by name.
+func (c appServiceConnect) reaches(svc *AppServiceConnect) []string {
+	var reached []string
+	for _, other := range c {
+		if other == svc || other.Service == svc.Service || len(other.Endpoints) == 0 {
+			continue
+		}
+		if other.Environment == svc.Environment && other.Namespace == svc.Namespace {
+			reached = append(reached, other.Service)
+		}
+	}
+	sort.Strings(reached)
+	return reached
+}
@@ -0,0 +1,2351 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	fatihcolor "github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretSource(t *testing.T) {
+	testCases := map[string]struct {
+		inValueFrom string
+
+		wantedSource string
+	}{
+		"parameter name": {
+			inValueFrom:  "/copilot/my-app/test/secrets/db-password",
+			wantedSource: SecretSourceSSM,
+		},
+		"parameter ARN": {
+			inValueFrom:  "arn:aws:ssm:us-west-2:123456789012:parameter/db-password",
+			wantedSource: SecretSourceSSM,
+		},
+		"secrets manager ARN": {
+			inValueFrom:  "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-AbCdEf",
+			wantedSource: SecretSourceSecretsManager,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedSource, SecretSource(tc.inValueFrom))
+		})
+	}
+}
+
+func TestAppWarning_AtLeast(t *testing.T) {
+	testCases := map[string]struct {
+		inWarning  *AppWarning
+		inSeverity string
+
+		wanted bool
+	}{
+		"more severe than the threshold": {
+			inWarning:  &AppWarning{Severity: WarningSeverityError},
+			inSeverity: WarningSeverityWarning,
+			wanted:     true,
+		},
+		"as severe as the threshold": {
+			inWarning:  &AppWarning{Severity: WarningSeverityWarning},
+			inSeverity: WarningSeverityWarning,
+			wanted:     true,
+		},
+		"less severe than the threshold": {
+			inWarning:  &AppWarning{Severity: WarningSeverityInfo},
+			inSeverity: WarningSeverityError,
+			wanted:     false,
+		},
+		"unknown threshold": {
+			inWarning:  &AppWarning{Severity: WarningSeverityError},
+			inSeverity: "critical",
+			wanted:     false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inWarning.AtLeast(tc.inSeverity))
+		})
+	}
+}
+
+func TestApp_Legend(t *testing.T) {
+	warnings := []*AppWarning{
+		{Severity: WarningSeverityInfo, Message: "App Runner service for api in environment test is not created yet"},
+		{Severity: WarningSeverityError, Message: "deployment of frontend in environment prod was rolled back"},
+	}
+	testCases := map[string]struct {
+		inApp     *App
+		inDittoed bool
+		inSourced bool
+		inColored bool
+
+		wanted func() []legendEntry
+	}{
+		"nothing to explain": {
+			inApp: &App{Name: "my-app"},
+
+			wanted: func() []legendEntry { return nil },
+		},
+		"explains the severities with text labels if colors are disabled": {
+			inApp: &App{Name: "my-app", Warnings: warnings},
+
+			wanted: func() []legendEntry {
+				return []legendEntry{
+					{symbol: "error", description: "A failure of the application's resources, like a rolled back deployment."},
+					{symbol: "info", description: "An expected transient state, like a service that is still being created."},
+				}
+			},
+		},
+		"explains the colors of the severities with glyphs": {
+			inApp:     &App{Name: "my-app", Warnings: warnings},
+			inColored: true,
+
+			wanted: func() []legendEntry {
+				return []legendEntry{
+					{symbol: color.Red.Sprint("●"), description: "error: A failure of the application's resources, like a rolled back deployment."},
+					{symbol: color.Faint.Sprint("●"), description: "info: An expected transient state, like a service that is still being created."},
+				}
+			},
+		},
+		"explains the ditto marks and annotations": {
+			inApp:     &App{Name: "my-app"},
+			inDittoed: true,
+			inSourced: true,
+
+			wanted: func() []legendEntry {
+				return []legendEntry{
+					{symbol: `"`, description: "The same value as in the row above."},
+					{symbol: "(from ...)", description: "The AWS resource that the value is retrieved from."},
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func(noColor bool) { fatihcolor.NoColor = noColor }(fatihcolor.NoColor)
+			fatihcolor.NoColor = !tc.inColored
+
+			require.Equal(t, tc.wanted(), tc.inApp.legend(tc.inDittoed, tc.inSourced))
+		})
+	}
+}
+
+func TestApp_Equal(t *testing.T) {
+	app := func() *App {
+		return &App{
+			Name: "my-app",
+			Envs: []*config.Environment{
+				{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+			},
+			Deployments: []*AppDeployment{
+				{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+			},
+			Warnings: []*AppWarning{
+				{Severity: WarningSeverityWarning, Message: "source connection of pipeline-my-app is pending"},
+				{Severity: WarningSeverityError, Message: "deployment of frontend in environment test was rolled back"},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inApp   *App
+		inOther *App
+
+		wanted bool
+	}{
+		"same description": {
+			inApp:   app(),
+			inOther: app(),
+
+			wanted: true,
+		},
+		"ignores the order of the warnings": {
+			inApp: app(),
+			inOther: func() *App {
+				a := app()
+				a.Warnings[0], a.Warnings[1] = a.Warnings[1], a.Warnings[0]
+				return a
+			}(),
+
+			wanted: true,
+		},
+		"different deployment status": {
+			inApp: app(),
+			inOther: func() *App {
+				a := app()
+				a.Deployments[0].StackStatus = "UPDATE_ROLLBACK_COMPLETE"
+				return a
+			}(),
+
+			wanted: false,
+		},
+		"different warnings": {
+			inApp: app(),
+			inOther: func() *App {
+				a := app()
+				a.Warnings = a.Warnings[:1]
+				return a
+			}(),
+
+			wanted: false,
+		},
+		"nil descriptions": {
+			wanted: true,
+		},
+		"nil and non-nil descriptions": {
+			inApp: app(),
+
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inApp.Equal(tc.inOther))
+			require.Equal(t, tc.wanted, tc.inOther.Equal(tc.inApp), "expected Equal to be symmetric")
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	testCases := map[string]struct {
+		inApps []*App
+
+		wanted      *AppAggregate
+		wantedError error
+	}{
+		"no applications": {
+			wanted: &AppAggregate{},
+		},
+		"sorts the applications by name and their warnings by severity": {
+			inApps: []*App{
+				{
+					Name: "payments",
+					Warnings: []*AppWarning{
+						{Severity: WarningSeverityInfo, Message: "App Runner service for api in environment test is not created yet"},
+						{Severity: WarningSeverityError, Message: "deployment of frontend in environment prod was rolled back"},
+						{Severity: WarningSeverityWarning, Message: "source connection of pipeline-payments is pending"},
+					},
+				},
+				{Name: "checkout"},
+			},
+
+			wanted: &AppAggregate{
+				Apps: []*App{
+					{Name: "checkout"},
+					{
+						Name: "payments",
+						Warnings: []*AppWarning{
+							{Severity: WarningSeverityError, Message: "deployment of frontend in environment prod was rolled back"},
+							{Severity: WarningSeverityWarning, Message: "source connection of pipeline-payments is pending"},
+							{Severity: WarningSeverityInfo, Message: "App Runner service for api in environment test is not created yet"},
+						},
+					},
+				},
+			},
+		},
+		"includes an application described more than once with the same description once": {
+			inApps: []*App{
+				{Name: "checkout", URI: "example.com"},
+				{Name: "checkout", URI: "example.com"},
+			},
+
+			wanted: &AppAggregate{
+				Apps: []*App{
+					{Name: "checkout", URI: "example.com"},
+				},
+			},
+		},
+		"errors if an application is described more than once with different descriptions": {
+			inApps: []*App{
+				{Name: "checkout", URI: "example.com"},
+				{Name: "checkout"},
+			},
+
+			wantedError: errors.New("application checkout is described more than once with different descriptions"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Merge(tc.inApps...)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestMerge_DoesNotModifyTheDescriptions(t *testing.T) {
+	app := &App{
+		Name: "my-app",
+		Warnings: []*AppWarning{
+			{Severity: WarningSeverityInfo, Message: "b"},
+			{Severity: WarningSeverityError, Message: "a"},
+		},
+	}
+
+	_, err := Merge(app)
+
+	require.NoError(t, err)
+	require.Equal(t, WarningSeverityInfo, app.Warnings[0].Severity, "expected the warnings of the description to keep their order")
+}
+
+func TestApp_Counts(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedEnvs           int
+		wantedServices       int
+		wantedJobs           int
+		wantedHealthyService int
+	}{
+		"empty app": {
+			inApp: &App{},
+		},
+		"app with services and jobs": {
+			inApp: &App{
+				Envs: []*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				},
+				Services: []*config.Workload{
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+					{Name: "backend", Type: "Backend Service"},
+					{Name: "api", Type: "Request-Driven Web Service"},
+					{Name: "report", Type: "Scheduled Job"},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "frontend", Environment: "prod", StackStatus: "CREATE_COMPLETE"},
+					{Service: "backend", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+					{Service: "backend", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_FAILED"},
+					{Service: "report", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+				},
+			},
+			wantedEnvs:           2,
+			wantedServices:       3,
+			wantedJobs:           1,
+			wantedHealthyService: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedEnvs, tc.inApp.EnvCount())
+			require.Equal(t, tc.wantedServices, tc.inApp.ServiceCount())
+			require.Equal(t, tc.wantedJobs, tc.inApp.JobCount())
+			require.Equal(t, tc.wantedHealthyService, tc.inApp.HealthyServiceCount())
+		})
+	}
+}
+
+func TestAppAllocation_String(t *testing.T) {
+	testCases := map[string]struct {
+		inAllocation *AppAllocation
+
+		wanted string
+	}{
+		"totals the known deployments": {
+			inAllocation: &AppAllocation{CPU: 1280, Memory: 2560},
+			wanted:       "1280/2560",
+		},
+		"counts the deployments left out of the totals": {
+			inAllocation: &AppAllocation{CPU: 256, Memory: 512, Unknown: 2},
+			wanted:       "256/512 (2 unknown)",
+		},
+		"is unknown if none of the deployments is known": {
+			inAllocation: &AppAllocation{Unknown: 1},
+			wanted:       "unknown",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inAllocation.String())
+		})
+	}
+}
+
+func TestApp_JSONString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"includes secrets": {
+			inApp: &App{
+				Name: "my-app",
+				Secrets: []*AppSecret{
+					{
+						Service:     "api",
+						Environment: "test",
+						Container:   "api",
+						Name:        "DB_PASSWORD",
+						ValueFrom:   "/my-app/db-password",
+						Source:      SecretSourceSSM,
+					},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"secrets":[{"service":"api","environment":"test","container":"api","name":"DB_PASSWORD","valueFrom":"/my-app/db-password","source":"SSM"}]}` + "\n",
+		},
+		"omits the URI of an app without a custom domain": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null}` + "\n",
+		},
+		"includes the services that reference resources in other accounts": {
+			inApp: &App{
+				Name:             "my-app",
+				CrossAccountRefs: []*ServiceCrossAccountRefs{{Service: "api", Accounts: []string{"210987654321"}}},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"crossAccountRefs":[{"service":"api","accounts":["210987654321"]}]}` + "\n",
+		},
+		"includes the domains claimed by several services": {
+			inApp: &App{
+				Name: "my-app",
+				DomainConflicts: []*AppDomainConflict{
+					{Domain: "www.example.com", Claims: []*AppDomainClaim{{Service: "frontend", Environment: "test"}, {Service: "web", Environment: "test"}}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"domainConflicts":[{"domain":"www.example.com","claims":[{"service":"frontend","environment":"test"},{"service":"web","environment":"test"}]}]}` + "\n",
+		},
+		"includes the environments that are stack set instances": {
+			inApp: &App{
+				Name:         "my-app",
+				Envs:         []*config.Environment{{Name: "210987654321/eu-west-1", AccountID: "210987654321", Region: "eu-west-1"}},
+				StackSetEnvs: []string{"210987654321/eu-west-1"},
+			},
+			wantedContent: `{"name":"my-app","environments":[{"app":"","name":"210987654321/eu-west-1","region":"eu-west-1","accountID":"210987654321","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":""}],"services":null,"pipelines":null,"stackSetEnvironments":["210987654321/eu-west-1"]}` + "\n",
+		},
+		"includes the services that are only in the workspace": {
+			inApp: &App{
+				Name:        "my-app",
+				Services:    []*config.Workload{{Name: "worker"}},
+				NotDeployed: []string{"worker"},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":[{"app":"","name":"worker","type":""}],"pipelines":null,"notDeployed":["worker"]}` + "\n",
+		},
+		"includes the services that no pipeline deploys": {
+			inApp: &App{
+				Name:             "my-app",
+				ManuallyDeployed: []string{"worker"},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"manuallyDeployed":["worker"]}` + "\n",
+		},
+		"includes the owner": {
+			inApp: &App{
+				Name:  "my-app",
+				Owner: AppUnowned,
+			},
+			wantedContent: `{"name":"my-app","owner":"unowned","environments":null,"services":null,"pipelines":null}` + "\n",
+		},
+		"includes the build projects of the pipelines": {
+			inApp: &App{
+				Name: "my-app",
+				Pipelines: []*codepipeline.Pipeline{
+					{
+						Name: "pipeline-my-app-repo",
+						Build: &codepipeline.PipelineBuild{
+							Project:    "pipeline-my-app-repo-BuildProject",
+							Last:       &codepipeline.BuildExecution{ID: "pipeline-my-app-repo-BuildProject:1f2e3d", Status: "Failed", URL: "https://example.com/builds/1f2e3d"},
+							StageName:  "Build",
+							ActionName: "Build",
+						},
+					},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":[{"name":"pipeline-my-app-repo","region":"","accountId":"","stages":null,"createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","build":{"project":"pipeline-my-app-repo-BuildProject","last":{"id":"pipeline-my-app-repo-BuildProject:1f2e3d","status":"Failed","url":"https://example.com/builds/1f2e3d"}}}]}` + "\n",
+		},
+		"includes the commit that the workspace was read at": {
+			inApp: &App{
+				Name:            "my-app",
+				WorkspaceCommit: "4c1b2e9f",
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"workspaceCommit":"4c1b2e9f"}` + "\n",
+		},
+		"marks an app derived from its stacks": {
+			inApp: &App{
+				Name:              "my-app",
+				DerivedFromStacks: true,
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"derivedFromStacks":true}` + "\n",
+		},
+		"marks the pipelines as skipped": {
+			inApp: &App{
+				Name:             "my-app",
+				PipelinesSkipped: true,
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"pipelinesSkipped":true}` + "\n",
+		},
+		"includes what the services depend on": {
+			inApp: &App{
+				Name: "my-app",
+				Dependencies: []*ServiceDependencies{
+					{Service: "api", DependsOn: []string{"db-addon", "users"}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"dependencies":[{"service":"api","dependsOn":["db-addon","users"]}]}` + "\n",
+		},
+		"includes the tags as maps": {
+			inApp: &App{
+				Name: "my-app",
+				Tags: map[string]string{"team": "platform"},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", Tags: map[string]string{"owner": "api-team"}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"tags":{"team":"platform"},"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE","tags":{"owner":"api-team"}}]}` + "\n",
+		},
+		"includes the storage of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "db", Environment: "test", StackStatus: "CREATE_COMPLETE", Storage: []*AppVolume{{Name: "data", FileSystemID: "fs-1234"}}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"deployments":[{"service":"db","environment":"test","stackStatus":"CREATE_COMPLETE","storage":[{"name":"data","fileSystemID":"fs-1234"}]}]}` + "\n",
+		},
+		"includes the deployment controllers of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", DeploymentController: "CODE_DEPLOY", DeploymentState: "STEADY_STATE"},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE","deploymentController":"CODE_DEPLOY","deploymentState":"STEADY_STATE"}]}` + "\n",
+		},
+		"includes the web ACLs of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", WebACL: "my-acl"},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE","wafAcl":"my-acl"}]}` + "\n",
+		},
+		"includes the drift of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", Drift: []*AppDrift{
+						{Field: "cpu", Manifest: "512", Deployed: "256"},
+						{Field: "variables.LOG_LEVEL", Deployed: "debug"},
+					}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE","drift":[{"field":"cpu","manifest":"512","deployed":"256"},{"field":"variables.LOG_LEVEL","deployed":"debug"}]}]}` + "\n",
+		},
+		"includes the insecure endpoints": {
+			inApp: &App{
+				Name: "my-app",
+				InsecureEndpoints: []*AppInsecureEndpoint{
+					{Service: "api", Environment: "test", URL: "http://my-lb.us-west-2.elb.amazonaws.com/api"},
+					{Service: "web", Environment: "prod"},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"insecureEndpoints":[{"service":"api","environment":"test","url":"http://my-lb.us-west-2.elb.amazonaws.com/api"},{"service":"web","environment":"prod"}]}` + "\n",
+		},
+		"includes the service discovery namespaces of the environments": {
+			inApp: &App{
+				Name: "my-app",
+				Namespaces: map[string]*AppEnvNamespace{
+					"test": {Name: "my-app.local", ID: "ns-1234"},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"serviceDiscoveryNamespaces":{"test":{"name":"my-app.local","id":"ns-1234"}}}` + "\n",
+		},
+		"includes the stages of the pipelines": {
+			inApp: &App{
+				Name: "my-app",
+				PipelineStages: []*AppPipelineStages{
+					{Pipeline: "my-pipeline", Stages: []*AppPipelineStage{
+						{Name: "Source", Category: "Source"},
+						{Name: "DeployTo-test", Category: "Deploy", Deploys: []*AppPipelineDeploy{
+							{Service: "api", Environment: "test", RunOrder: 1},
+						}},
+					}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"pipelineStages":[{"pipeline":"my-pipeline","stages":[{"name":"Source","category":"Source"},{"name":"DeployTo-test","category":"Deploy","deploys":[{"service":"api","environment":"test","runOrder":1}]}]}]}` + "\n",
+		},
+		"includes the termination protection of the stacks": {
+			inApp: &App{
+				Name: "my-app",
+				TerminationProtection: map[string]bool{
+					"my-app-infrastructure-roles": true,
+					"my-app-test":                 false,
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"terminationProtection":{"my-app-infrastructure-roles":true,"my-app-test":false}}` + "\n",
+		},
+		"includes the alarms of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", Alarms: []*AppAlarm{
+						{Name: "my-app-test-api-HighCPU", Metric: "CPUUtilization", Threshold: aws.Float64(80)},
+					}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE","alarms":[{"name":"my-app-test-api-HighCPU","metric":"CPUUtilization","threshold":80}]}]}` + "\n",
+		},
+		"includes the service connect configuration of the services": {
+			inApp: &App{
+				Name: "my-app",
+				ServiceConnect: []*AppServiceConnect{
+					{Service: "api", Environment: "test", Namespace: "test.my-app.local", Endpoints: []*AppServiceConnectEndpoint{
+						{PortName: "api-http", Aliases: []string{"api:8080"}},
+					}},
+					{Service: "web", Environment: "test"},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"serviceConnect":[{"service":"api","environment":"test","namespace":"test.my-app.local","endpoints":[{"portName":"api-http","aliases":["api:8080"]}]},{"service":"web","environment":"test"}]}` + "\n",
+		},
+		"includes the recent runs of the jobs": {
+			inApp: &App{
+				Name: "my-app",
+				Jobs: []*AppJob{
+					{Name: "report", Environment: "test", RecentRuns: []*AppJobRun{
+						{StartedAt: time.Date(2021, time.June, 7, 0, 0, 0, 0, time.UTC), Status: JobRunSucceeded},
+					}},
+					{Name: "report", Environment: "prod", RecentRuns: []*AppJobRun{}},
+				},
+			},
+			wantedContent: `{"name":"my-app","environments":null,"services":null,"pipelines":null,"jobs":[{"name":"report","environment":"test","recentRuns":[{"startedAt":"2021-06-07T00:00:00Z","status":"SUCCEEDED"}]},{"name":"report","environment":"prod","recentRuns":[]}]}` + "\n",
+		},
+		"indents the fields": {
+			inApp: &App{
+				Name: "my-app",
+				Tags: map[string]string{"team": "platform"},
+			},
+			inOpts: AppRenderOptions{
+				IndentJSON: true,
+			},
+			wantedContent: `{
+  "name": "my-app",
+  "environments": null,
+  "services": null,
+  "pipelines": null,
+  "tags": {
+    "team": "platform"
+  }
+}
+`,
+		},
+		"omits the top-level and dotted fields, in each element of a list": {
+			inApp: &App{
+				Name: "my-app",
+				Tags: map[string]string{"team": "platform", "cost-center": "1234"},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", Storage: []*AppVolume{{Name: "data", FileSystemID: "fs-1234"}}},
+					{Service: "web", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				OmitJSON: []string{"environments", "services", "deployments.storage", "tags.team"},
+			},
+			wantedContent: `{"name":"my-app","pipelines":null,"tags":{"cost-center":"1234"},"deployments":[{"service":"api","environment":"test","stackStatus":"CREATE_COMPLETE"},{"service":"web","environment":"test","stackStatus":"CREATE_COMPLETE"}]}` + "\n",
+		},
+		"indents the json without the omitted fields": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			inOpts: AppRenderOptions{
+				IndentJSON: true,
+				OmitJSON:   []string{"environments", "services", "pipelines"},
+			},
+			wantedContent: `{
+  "name": "my-app"
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out, err := tc.inApp.JSONString(tc.inOpts)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, out)
+		})
+	}
+}
+
+func TestApp_JSONString_Compact(t *testing.T) {
+	app := &App{
+		Name: "my-app",
+		Tags: map[string]string{"team": "platform"},
+		Deployments: []*AppDeployment{
+			{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+		},
+	}
+
+	out, err := app.JSONString(AppRenderOptions{})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(out, "\n"), "compact json must be a single line")
+	require.True(t, strings.HasSuffix(out, "}\n"))
+}
+
+func TestApp_JSONString_SortsMapKeys(t *testing.T) {
+	tags := make(map[string]string)
+	for _, key := range []string{"team", "cost-center", "owner", "environment", "app", "zone", "budget", "project"} {
+		tags[key] = key + "-value"
+	}
+	newApp := func() *App {
+		return &App{
+			Name:        "my-app",
+			Tags:        tags,
+			EnvStatuses: map[string]string{"test": "UPDATE_COMPLETE", "prod": "CREATE_COMPLETE", "staging": "UPDATE_IN_PROGRESS", "dev": "CREATE_COMPLETE"},
+			EnvTags: map[string]map[string]string{
+				"prod": {"environment": "prod", "compliance": "pci"},
+				"dev":  {"environment": "dev", "auto-stop": "true"},
+			},
+			Deployments: []*AppDeployment{
+				{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE", Tags: tags},
+			},
+			TerminationProtection: map[string]bool{"prod": true, "dev": false, "test": false, "staging": true},
+		}
+	}
+	testCases := map[string]AppRenderOptions{
+		"compact":  {},
+		"indented": {IndentJSON: true},
+		"omitted":  {OmitJSON: []string{"deployments.stackStatus"}},
+	}
+
+	for name, opts := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := newApp()
+			wanted, err := app.JSONString(opts)
+			require.NoError(t, err)
+
+			for i := 0; i < 50; i++ {
+				out, err := app.JSONString(opts)
+				require.NoError(t, err)
+				require.Equal(t, wanted, out, "expected each marshaling of the same application to be byte-identical")
+			}
+			compacted := new(bytes.Buffer)
+			require.NoError(t, json.Compact(compacted, []byte(wanted)))
+			require.Contains(t, compacted.String(), `"environmentStatuses":{"dev":"CREATE_COMPLETE","prod":"CREATE_COMPLETE","staging":"UPDATE_IN_PROGRESS","test":"UPDATE_COMPLETE"}`)
+			require.Contains(t, compacted.String(), `"environmentTags":{"dev":{"auto-stop":"true","environment":"dev"},"prod":{"compliance":"pci","environment":"prod"}}`)
+			require.Contains(t, compacted.String(), `"tags":{"app":"app-value","budget":"budget-value","cost-center":"cost-center-value","environment":"environment-value","owner":"owner-value","project":"project-value","team":"team-value","zone":"zone-value"}`)
+		})
+	}
+}
+
+func TestApp_CSVString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedContent string
+	}{
+		"only the header without deployments": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: "app,environment,service,type,endpoint,status\n",
+		},
+		"one row per service deployed in an environment": {
+			inApp: &App{
+				Name: "my-app",
+				Services: []*config.Workload{
+					{Name: "frontend", Type: "Load Balanced Web Service"},
+					{Name: "api", Type: "Request-Driven Web Service"},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "api", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+					{Service: "frontend", Environment: "prod", StackStatus: "UPDATE_ROLLBACK_COMPLETE"},
+				},
+				AppRunnerServices: []*AppRunnerService{
+					{Service: "api", Environment: "test", URL: "abc.us-west-2.awsapprunner.com"},
+				},
+			},
+			wantedContent: `app,environment,service,type,endpoint,status
+my-app,test,frontend,Load Balanced Web Service,,UPDATE_COMPLETE
+my-app,test,api,Request-Driven Web Service,abc.us-west-2.awsapprunner.com,CREATE_COMPLETE
+my-app,prod,frontend,Load Balanced Web Service,,UPDATE_ROLLBACK_COMPLETE
+`,
+		},
+		"escapes commas and quotes": {
+			inApp: &App{
+				Name: "my-app",
+				Services: []*config.Workload{
+					{Name: "frontend", Type: `Web Service, "classic"`},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", StackStatus: "CREATE_COMPLETE"},
+				},
+			},
+			wantedContent: `app,environment,service,type,endpoint,status
+my-app,test,frontend,"Web Service, ""classic""",,CREATE_COMPLETE
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out, err := tc.inApp.CSVString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, out)
+		})
+	}
+}
+
+func TestApp_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  *App
+		inOpts AppRenderOptions
+
+		wantedContent string
+	}{
+		"shows the URI of an app with a custom domain": {
+			inApp: &App{
+				Name: "my-app",
+				URI:  "example.com",
+			},
+			wantedContent: `About
+
+  Name              my-app
+  URI               example.com
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"marks the environments that are stack set instances": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "210987654321/eu-west-1", AccountID: "210987654321", Region: "eu-west-1"},
+				},
+				StackSetEnvs: []string{"210987654321/eu-west-1"},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name                    AccountID           Region
+  ----                    ---------           ------
+  test                    123456789012        us-west-2
+  210987654321/eu-west-1  210987654321        eu-west-1           stack set instance
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"marks the services that are only in the workspace as not deployed": {
+			inApp: &App{
+				Name: "my-app",
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "worker", Type: "Backend Service"},
+					{Name: "draft"},
+				},
+				NotDeployed: []string{"worker", "draft"},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+  api               Load Balanced Web Service
+  worker            Backend Service     not deployed
+  draft             -                   not deployed
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"marks the services that don't serve any traffic": {
+			inApp: &App{
+				Name: "my-app",
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "frontend", Type: "Request-Driven Web Service"},
+				},
+				Deployments: []*AppDeployment{
+					{Service: "api", Environment: "test", StackStatus: "UPDATE_COMPLETE", Offline: ServiceScaledToZero},
+					{Service: "api", Environment: "staging", StackStatus: "UPDATE_COMPLETE", Offline: ServiceScaledToZero},
+					{Service: "api", Environment: "prod", StackStatus: "UPDATE_COMPLETE"},
+					{Service: "frontend", Environment: "test", StackStatus: "UPDATE_COMPLETE", Offline: ServicePaused},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+  api               Load Balanced Web Service   scaled to zero in test, staging
+  frontend          Request-Driven Web Service  paused in test
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"sorts the environments by name": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "staging", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "us-prod", AccountID: "123456789012", Region: "us-east-1", Prod: true},
+				},
+			},
+			inOpts: AppRenderOptions{
+				EnvSort: EnvSortName,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  staging           123456789012        us-west-2
+  test              123456789012        us-west-2
+  us-prod           123456789012        us-east-1
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"sorts the environments by recency": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "staging", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "us-prod", AccountID: "123456789012", Region: "us-east-1", Prod: true},
+				},
+				EnvLastDeployedAt: map[string]time.Time{
+					"test":    time.Date(2021, time.June, 2, 0, 0, 0, 0, time.UTC),
+					"staging": time.Date(2021, time.June, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			inOpts: AppRenderOptions{
+				EnvSort: EnvSortRecency,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  staging           123456789012        us-west-2
+  test              123456789012        us-west-2
+  us-prod           123456789012        us-east-1
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"sorts the production environments first": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "staging", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "us-prod", AccountID: "123456789012", Region: "us-east-1", Prod: true},
+				},
+			},
+			inOpts: AppRenderOptions{
+				EnvSort: EnvSortProd,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  us-prod           123456789012        us-east-1
+  staging           123456789012        us-west-2
+  test              123456789012        us-west-2
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows the owner of the app": {
+			inApp: &App{
+				Name:  "my-app",
+				URI:   "example.com",
+				Owner: "platform-team",
+			},
+			wantedContent: `About
+
+  Name              my-app
+  URI               example.com
+  Owner             platform-team
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows that the app was derived from its stacks": {
+			inApp: &App{
+				Name:              "my-app",
+				DerivedFromStacks: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+  Source            derived from stacks (config store unavailable)
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows the commit that the workspace was read at": {
+			inApp: &App{
+				Name:            "my-app",
+				WorkspaceCommit: "4c1b2e9f0d3a5b6c7d8e9f0a1b2c3d4e5f6a7b8c",
+			},
+			wantedContent: `About
+
+  Name              my-app
+  Workspace         as of commit 4c1b2e9f0d3a5b6c7d8e9f0a1b2c3d4e5f6a7b8c
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"annotates the values with their sources": {
+			inApp: &App{
+				Name: "my-app",
+				URI:  "example.com",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+				},
+				Services: []*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+				},
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "pipeline-my-app"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				Sources: &AppSources{
+					Name: Sourced{Value: "my-app", Source: "SSM /copilot/applications/my-app"},
+					URI:  Sourced{Value: "example.com", Source: "SSM /copilot/applications/my-app"},
+					Environments: []Sourced{
+						{Value: "test", Source: "SSM /copilot/applications/my-app/environments/test"},
+					},
+					Services: []Sourced{
+						{Value: "api", Source: "SSM /copilot/applications/my-app/components/api"},
+					},
+					Pipelines: []Sourced{
+						{Value: "pipeline-my-app", Source: "CodePipeline pipeline-my-app"},
+					},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app              (from SSM /copilot/applications/my-app)
+  URI               example.com         (from SSM /copilot/applications/my-app)
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2           (from SSM /copilot/applications/my-app/environments/test)
+
+Services
+
+  Name              Type
+  ----              ----
+  api               Load Balanced Web Service  (from SSM /copilot/applications/my-app/components/api)
+
+Pipelines
+
+  Name
+  ----
+  pipeline-my-app   (from CodePipeline pipeline-my-app)
+
+Legend
+
+  (from ...)        The AWS resource that the value is retrieved from.
+`,
+		},
+		"shows the source connections of the pipelines": {
+			inApp: &App{
+				Name: "my-app",
+				Pipelines: []*codepipeline.Pipeline{
+					{
+						Name: "pipeline-my-app-github",
+						Connection: &codepipeline.SourceConnection{
+							ARN:          "arn:aws:codestar-connections:us-west-2:123456789012:connection/abc",
+							ProviderType: "GitHub",
+							Status:       "PENDING",
+						},
+					},
+					{Name: "pipeline-my-app-codecommit"},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name                        Provider            Connection Status   Connection ARN
+  ----                        --------            -----------------   --------------
+  pipeline-my-app-github      GitHub              PENDING             arn:aws:codestar-connections:us-west-2:123456789012:connection/abc
+  pipeline-my-app-codecommit  -                   -                   -
+`,
+		},
+		"shows the build project and the last build of the pipelines": {
+			inApp: &App{
+				Name: "my-app",
+				Pipelines: []*codepipeline.Pipeline{
+					{
+						Name: "pipeline-my-app-repo",
+						Build: &codepipeline.PipelineBuild{
+							Project: "pipeline-my-app-repo-BuildProject",
+							Last: &codepipeline.BuildExecution{
+								ID:     "pipeline-my-app-repo-BuildProject:1f2e3d",
+								Status: "Failed",
+								URL:    "https://example.com/builds/1f2e3d",
+							},
+						},
+					},
+					{
+						Name:  "pipeline-my-app-new",
+						Build: &codepipeline.PipelineBuild{Project: "pipeline-my-app-new-BuildProject"},
+					},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name                  Build Project                      Last Build          Build Link
+  ----                  -------------                      ----------          ----------
+  pipeline-my-app-repo  pipeline-my-app-repo-BuildProject  Failed              https://example.com/builds/1f2e3d
+  pipeline-my-app-new   pipeline-my-app-new-BuildProject   -                   -
+`,
+		},
+		"shows the task definitions of the deployments with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", TaskDefinition: "my-app-test-frontend:3"},
+					{Service: "api", Environment: "test", TaskDefinition: TaskDefinitionNotApplicable},
+					{Service: "frontend", Environment: "prod"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition
+  -------           -----------         ---------------
+  api               test                N/A
+  frontend          test                my-app-test-frontend:3
+    "               prod                -
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the alarms of the deployments with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", TaskDefinition: "my-app-test-frontend:3", Alarms: []*AppAlarm{
+						{Name: "my-app-test-frontend-5xx"},
+						{Name: "my-app-test-frontend-HighCPU", Metric: "CPUUtilization", Threshold: aws.Float64(80)},
+					}},
+					{Service: "api", Environment: "test", TaskDefinition: "my-app-test-api:1"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition
+  -------           -----------         ---------------
+  api               test                my-app-test-api:1
+  frontend          test                my-app-test-frontend:3
+
+Alarms
+
+  Service           Environment         Alarm                         Metric              Threshold
+  -------           -----------         -----                         ------              ---------
+  frontend          test                my-app-test-frontend-5xx      -                   -
+    "               test                my-app-test-frontend-HighCPU  CPUUtilization      80
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the persistent storage of the deployments with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "db", Environment: "test", TaskDefinition: "my-app-test-db:2", Storage: []*AppVolume{
+						{Name: "data", FileSystemID: "fs-1234", AccessPointID: "fsap-5678"},
+						{Name: "shared", FileSystemID: "fs-9012"},
+					}},
+					{Service: "api", Environment: "test", TaskDefinition: "my-app-test-api:1"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition
+  -------           -----------         ---------------
+  api               test                my-app-test-api:1
+  db                test                my-app-test-db:2
+
+Storage
+
+  Service           Environment         Volume              File System         Access Point
+  -------           -----------         ------              -----------         ------------
+  db                test                data                fs-1234             fsap-5678
+    "               test                shared              fs-9012             -
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the deployment controllers next to the task definitions with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "web", Environment: "test", TaskDefinition: "my-app-test-web:1", DeploymentController: "CODE_DEPLOY", DeploymentState: "IN_PROGRESS"},
+					{Service: "api", Environment: "test", TaskDefinition: "my-app-test-api:1", DeploymentController: "ECS"},
+					{Service: "frontend", Environment: "test", TaskDefinition: TaskDefinitionNotApplicable},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition     Deployment Controller
+  -------           -----------         ---------------     ---------------------
+  api               test                my-app-test-api:1   ECS
+  frontend          test                N/A                 -
+  web               test                my-app-test-web:1   CODE_DEPLOY (IN_PROGRESS)
+`,
+		},
+		"shows the domains claimed by several services": {
+			inApp: &App{
+				Name: "my-app",
+				DomainConflicts: []*AppDomainConflict{
+					{Domain: "www.example.com", Claims: []*AppDomainClaim{{Service: "frontend", Environment: "test"}, {Service: "web", Environment: "test"}}},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Domain Conflicts
+
+  Domain            Service             Environment
+  ------            -------             -----------
+  www.example.com   frontend            test
+    "               web                 test
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the web ACLs of the public-facing deployments with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "web", Environment: "test", TaskDefinition: "my-app-test-web:1", WebACL: WebACLNone},
+					{Service: "api", Environment: "test", TaskDefinition: "my-app-test-api:1", WebACL: "my-acl"},
+					{Service: "api", Environment: "prod", TaskDefinition: "my-app-prod-api:4", WebACL: WebACLUnknown},
+					{Service: "worker", Environment: "test", TaskDefinition: "my-app-test-worker:2"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Task Definitions
+
+  Service           Environment         Task Definition
+  -------           -----------         ---------------
+  api               test                my-app-test-api:1
+    "               prod                my-app-prod-api:4
+  web               test                my-app-test-web:1
+  worker            test                my-app-test-worker:2
+
+Web ACLs
+
+  Service           Environment         Web ACL
+  -------           -----------         -------
+  api               test                my-acl
+    "               prod                unknown
+  web               test                none
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the fields of the manifests that drifted from the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "web", Environment: "test", Drift: []*AppDrift{
+						{Field: "image.port", Manifest: "8080", Deployed: "80"},
+					}},
+					{Service: "api", Environment: "test", Drift: []*AppDrift{
+						{Field: "cpu", Manifest: "512", Deployed: "256"},
+						{Field: "variables.LOG_LEVEL", Deployed: "debug"},
+					}},
+					{Service: "api", Environment: "prod"},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Drift
+
+  Service           Environment         Field                Manifest            Deployed
+  -------           -----------         -----                --------            --------
+  api               test                cpu                  512                 256
+    "               test                variables.LOG_LEVEL  -                   debug
+  web               test                image.port           8080                80
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the fields changed by the last deployment of each service": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "web", Environment: "prod", TaskDefinition: "my-app-prod-web:4", PreviousTaskDefinition: "my-app-prod-web:3"},
+					{Service: "api", Environment: "prod", TaskDefinition: "my-app-prod-api:8", PreviousTaskDefinition: "my-app-prod-api:7", Changes: []*AppChange{
+						{Field: "image.location", Previous: "api:1.2.0", Current: "api:1.3.0"},
+						{Field: "variables.FEATURE_X", Current: "on"},
+					}},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Since Last Deploy
+
+  Service           Environment         Field                Previous            Current
+  -------           -----------         -----                --------            -------
+  api               prod                image.location       api:1.2.0           api:1.3.0
+    "               prod                variables.FEATURE_X  -                   on
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the insecure endpoints": {
+			inApp: &App{
+				Name: "my-app",
+				InsecureEndpoints: []*AppInsecureEndpoint{
+					{Service: "web", Environment: "prod"},
+					{Service: "api", Environment: "test", URL: "http://my-lb.us-west-2.elb.amazonaws.com/api"},
+					{Service: "api", Environment: "prod", URL: "http://my-lb.us-east-1.elb.amazonaws.com/api"},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Insecure Endpoints
+
+  Service           Environment         URL
+  -------           -----------         ---
+  api               prod                http://my-lb.us-east-1.elb.amazonaws.com/api
+    "               test                http://my-lb.us-west-2.elb.amazonaws.com/api
+  web               prod                -
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the service discovery namespace of each environment": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				Namespaces: map[string]*AppEnvNamespace{
+					"test": {Name: "my-app.local", ID: "ns-1234"},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region              Namespace
+  ----              ---------           ------              ---------
+  test              123456789012        us-west-2           my-app.local
+  prod              123456789012        us-east-1           -
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows the stages of the pipelines if asked for": {
+			inApp: &App{
+				Name: "my-app",
+				PipelineStages: []*AppPipelineStages{
+					{Pipeline: "my-pipeline", Stages: []*AppPipelineStage{
+						{Name: "Source", Category: "Source"},
+						{Name: "Build", Category: "Build"},
+						{Name: "DeployTo-test", Category: "Deploy", Deploys: []*AppPipelineDeploy{
+							{Service: "db", Environment: "test", RunOrder: 1},
+							{Service: "api", Environment: "test", RunOrder: 2},
+						}},
+						{Name: "DeployTo-prod", Category: "Deploy", Deploys: []*AppPipelineDeploy{
+							{Service: "api", Environment: "prod", RunOrder: 1},
+							{Service: "db", Environment: "prod", RunOrder: 1},
+						}},
+					}},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowPipelineStages: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Pipeline Stages
+
+  Pipeline          Stage               Category            Services
+  --------          -----               --------            --------
+  my-pipeline       Source              Source              -
+    "               Build               Build               -
+    "               DeployTo-test       Deploy              1. db (test), 2. api (test)
+    "               DeployTo-prod       Deploy              api (prod), db (prod)
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"hides the stages of the pipelines by default": {
+			inApp: &App{
+				Name: "my-app",
+				PipelineStages: []*AppPipelineStages{
+					{Pipeline: "my-pipeline", Stages: []*AppPipelineStage{{Name: "Source", Category: "Source"}}},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows the termination protection of the stacks with resources": {
+			inApp: &App{
+				Name: "my-app",
+				TerminationProtection: map[string]bool{
+					"my-app-test":                 false,
+					"my-app-infrastructure-roles": true,
+					"my-app-prod":                 true,
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Termination Protection
+
+  Stack                        Termination Protection
+  -----                        ----------------------
+  my-app-infrastructure-roles  enabled
+  my-app-prod                  enabled
+  my-app-test                  disabled
+`,
+		},
+		"shows the service connect topology with resources": {
+			inApp: &App{
+				Name: "my-app",
+				ServiceConnect: []*AppServiceConnect{
+					{Service: "web", Environment: "test", Namespace: "test.my-app.local"},
+					{Service: "db", Environment: "test", Namespace: "test.my-app.local", Endpoints: []*AppServiceConnectEndpoint{
+						{PortName: "db", Aliases: []string{"db.internal:5432"}},
+					}},
+					{Service: "api", Environment: "test", Namespace: "test.my-app.local", Endpoints: []*AppServiceConnectEndpoint{
+						{PortName: "api-http", Aliases: []string{"api:8080"}},
+					}},
+					{Service: "api", Environment: "prod", Endpoints: []*AppServiceConnectEndpoint{
+						{PortName: "api-http"},
+					}},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Service Connect
+
+  Environment       Namespace           Service             Endpoints           Reaches
+  -----------       ---------           -------             ---------           -------
+  prod              -                   api                 api-http            -
+  test              test.my-app.local   api                 api:8080            db
+    "               test.my-app.local   db                  db.internal:5432    api
+    "               test.my-app.local   web                 -                   api, db
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the artifact buckets of the environments with resources": {
+			inApp: &App{
+				Name: "my-app",
+				ArtifactBuckets: []*AppArtifactBucket{
+					{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "test", ApproximateObjectCount: aws.Int64(42)},
+					{Region: "us-east-1", Bucket: "my-app-us-east-1-bucket"},
+					{Region: "us-west-2", Bucket: "my-app-us-west-2-bucket", Environment: "prod", ApproximateObjectCount: aws.Int64(42)},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Artifact Buckets
+
+  Region            Bucket                   Environment         Objects
+  ------            ------                   -----------         -------
+  us-east-1         my-app-us-east-1-bucket  -                   -
+  us-west-2         my-app-us-west-2-bucket  test                ~42
+    "                 "                      prod                ~42
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the artifact stores of the pipelines with resources": {
+			inApp: &App{
+				Name: "my-app",
+				Pipelines: []*codepipeline.Pipeline{
+					{Name: "release", ArtifactStore: &codepipeline.ArtifactStore{Bucket: "my-app-bucket", EncryptionKey: "alias/release", Encrypted: aws.Bool(true), ExpirationDays: aws.Int64(30)}},
+					{Name: "staging", ArtifactStore: &codepipeline.ArtifactStore{Bucket: "my-app-bucket", BucketEncryption: "AES256", Encrypted: aws.Bool(true)}},
+					{Name: "legacy", ArtifactStore: &codepipeline.ArtifactStore{Bucket: "legacy-bucket", Encrypted: aws.Bool(false)}},
+					{Name: "unknown"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowResources: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+  release
+  staging
+  legacy
+  unknown
+
+Pipeline Artifacts
+
+  Pipeline          Bucket              Encryption          Retention
+  --------          ------              ----------          ---------
+  release           my-app-bucket       alias/release       30 days
+  staging           my-app-bucket       AES256              never expire
+  legacy            legacy-bucket       none                never expire
+`,
+		},
+		"shows the recent runs of the jobs": {
+			inApp: &App{
+				Name: "my-app",
+				Jobs: []*AppJob{
+					{Name: "report", Environment: "test", RecentRuns: []*AppJobRun{
+						{StartedAt: time.Date(2021, time.June, 7, 12, 0, 0, 0, time.UTC), Status: JobRunRunning},
+						{StartedAt: time.Date(2021, time.June, 6, 12, 0, 0, 0, time.UTC), Status: JobRunTimedOut},
+						{StartedAt: time.Date(2021, time.June, 5, 12, 0, 0, 0, time.UTC), Status: JobRunSucceeded},
+					}},
+					{Name: "cleanup", Environment: "test", RecentRuns: []*AppJobRun{}},
+					{Name: "report", Environment: "prod", RecentRuns: []*AppJobRun{
+						{StartedAt: time.Date(2021, time.June, 7, 12, 0, 0, 0, time.UTC), Status: JobRunSucceeded},
+					}},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Job Runs
+
+  Job               Environment         Recent Runs         Last Run
+  ---               -----------         -----------         --------
+  cleanup           test                -                   -
+  report            test                ✔ ✘ ●               2021-06-07T12:00:00Z
+    "               prod                ✔                   2021-06-07T12:00:00Z
+
+Legend
+
+  ✔                 A run of the job that succeeded.
+  ✘                 A run of the job that failed, timed out or was aborted.
+  ●                 A run of the job that is still running.
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the logging configuration of the deployments": {
+			inApp: &App{
+				Name: "my-app",
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", Logging: LoggingEnabled, LogGroup: "/copilot/my-app-test-frontend", LogRetention: "30 days"},
+					{Service: "frontend", Environment: "prod", Logging: LoggingDisabled},
+					{Service: "api", Environment: "test"},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Logging
+
+  Service           Environment         Logging             Log Group                      Retention
+  -------           -----------         -------             ---------                      ---------
+  frontend          test                enabled             /copilot/my-app-test-frontend  30 days
+    "               prod                disabled            -                              -
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the tags of the app and of the deployments with tags": {
+			inApp: &App{
+				Name: "my-app",
+				Tags: map[string]string{"team": "platform", "cost-center": "1234"},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", Tags: map[string]string{"owner": "web", "tier": "public"}},
+					{Service: "api", Environment: "test"},
+					{Service: "frontend", Environment: "prod", Tags: map[string]string{"owner": "web"}},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowTags: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+  Tags              cost-center=1234, team=platform
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Service Tags
+
+  Service           Environment         Tags
+  -------           -----------         ----
+  frontend          test                owner=web, tier=public
+    "               prod                owner=web
+
+Legend
+
+  "                 The same value as in the row above.
+`,
+		},
+		"shows the tags of the environments with tags": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				EnvTags: map[string]map[string]string{
+					"prod": {"environment": "prod", "cost-center": "1234"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				ShowTags: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2
+  prod              123456789012        us-east-1
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Environment Tags
+
+  Environment       Tags
+  -----------       ----
+  prod              cost-center=1234, environment=prod
+`,
+		},
+		"shows the pipelines as skipped": {
+			inApp: &App{
+				Name:             "my-app",
+				PipelinesSkipped: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  (skipped)
+`,
+		},
+		"does not show the tags without show tags": {
+			inApp: &App{
+				Name: "my-app",
+				Tags: map[string]string{"team": "platform"},
+				Deployments: []*AppDeployment{
+					{Service: "frontend", Environment: "test", Tags: map[string]string{"owner": "web"}},
+				},
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"annotates the environments that are not provisioned successfully": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "staging", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				EnvStatuses: map[string]string{
+					"test":    "UPDATE_COMPLETE",
+					"staging": "CREATE_IN_PROGRESS",
+					"prod":    EnvStatusUnknown,
+				},
+			},
+			inOpts: AppRenderOptions{
+				HideLegend: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789012        us-west-2
+  staging           123456789012        us-west-2           CREATE_IN_PROGRESS
+  prod              123456789012        us-east-1           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"shows who last deployed to each environment": {
+			inApp: &App{
+				Name: "my-app",
+				Envs: []*config.Environment{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				},
+				LastDeployedBy: map[string]string{
+					"test": "arn:aws:iam::123456789012:user/alice",
+					"prod": LastDeployedByUnknown,
+				},
+			},
+			inOpts: AppRenderOptions{
+				HideLegend: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region              Last Deployed By
+  ----              ---------           ------              ----------------
+  test              123456789012        us-west-2           arn:aws:iam::123456789012:user/alice
+  prod              123456789012        us-east-1           unknown
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+		"omits the legend if it's hidden": {
+			inApp: &App{
+				Name: "my-app",
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityWarning, Message: "certificate of frontend in environment prod expires in 10 days"},
+				},
+			},
+			inOpts: AppRenderOptions{
+				HideLegend: true,
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+
+Warnings
+
+  warning           certificate of frontend in environment prod expires in 10 days
+`,
+		},
+		"omits the URI of an app without a custom domain": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: `About
+
+  Name              my-app
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+
+Services
+
+  Name              Type
+  ----              ----
+
+Pipelines
+
+  Name
+  ----
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.HumanString(tc.inOpts))
+		})
+	}
+}
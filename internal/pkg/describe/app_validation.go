@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	fmtValidationNoProblems = "No problems found in application %s.\n"
+	fmtValidationProblems   = "Found %d %s in application %s: %s.\n\n"
+)
+
+// ValidationString returns the warnings of the App struct as a report of the problems of the application,
+// from the most to the least severe, without the rest of its description.
+func (a *App) ValidationString() string {
+	if len(a.Warnings) == 0 {
+		return fmt.Sprintf(fmtValidationNoProblems, a.Name)
+	}
+	counts := make(map[string]int)
+	for _, w := range a.Warnings {
+		counts[w.Severity]++
+	}
+	var bySeverity []string
+	for i := len(WarningSeverities) - 1; i >= 0; i-- {
+		severity := WarningSeverities[i]
+		bySeverity = append(bySeverity, fmt.Sprintf("%s: %d", severity, counts[severity]))
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, fmtValidationProblems, len(a.Warnings), plural(len(a.Warnings), "problem"), color.HighlightUserInput(a.Name), strings.Join(bySeverity, ", "))
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	for _, warning := range a.withSortedWarnings().Warnings {
+		fmt.Fprintf(writer, "  %s\t%s\n", warning.Severity, warning.colored())
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// plural returns the noun followed by an "s" unless there is exactly one of it.
+func plural(count int, noun string) string {
+	if count == 1 {
+		return noun
+	}
+	return noun + "s"
+}
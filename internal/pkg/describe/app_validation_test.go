@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_ValidationString(t *testing.T) {
+	testCases := map[string]struct {
+		inApp *App
+
+		wantedContent string
+	}{
+		"reports that there are no problems without warnings": {
+			inApp: &App{
+				Name: "my-app",
+			},
+			wantedContent: "No problems found in application my-app.\n",
+		},
+		"lists the problems from the most to the least severe": {
+			inApp: &App{
+				Name: "my-app",
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityWarning, Message: "Pipeline pipeline-my-app deploys to environment staging, which isn't in the application"},
+					{Severity: WarningSeverityError, Message: "Environment prod is in a failed state: stack my-app-prod is in UPDATE_ROLLBACK_FAILED"},
+				},
+			},
+			wantedContent: `Found 2 problems in application my-app: error: 1, warning: 1, info: 0.
+
+  error             Environment prod is in a failed state: stack my-app-prod is in UPDATE_ROLLBACK_FAILED
+  warning           Pipeline pipeline-my-app deploys to environment staging, which isn't in the application
+`,
+		},
+		"counts a single problem": {
+			inApp: &App{
+				Name: "my-app",
+				Warnings: []*AppWarning{
+					{Severity: WarningSeverityInfo, Message: "App Runner service for api in environment test is not created yet"},
+				},
+			},
+			wantedContent: `Found 1 problem in application my-app: error: 0, warning: 0, info: 1.
+
+  info              App Runner service for api in environment test is not created yet
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inApp.ValidationString())
+		})
+	}
+}
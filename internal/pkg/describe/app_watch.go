@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const fmtRefreshUnchanged = "\nNo changes since the previous refresh.\n"
+
+// AppRefreshChanges contains the fields of the json description of an application that changed between two refreshes
+// of a watch, as found by App.Diff.
+type AppRefreshChanges struct {
+	Differences []*AppDifference
+
+	// Width is the number of characters that the table of the human readable format is truncated to fit in.
+	// The table is not truncated if it's zero.
+	Width int
+}
+
+// HumanString returns the stringified AppRefreshChanges struct with human readable format, to follow the description
+// of the application.
+func (c *AppRefreshChanges) HumanString() string {
+	if len(c.Differences) == 0 {
+		return fmtRefreshUnchanged
+	}
+	var b bytes.Buffer
+	fmt.Fprint(&b, color.Bold.Sprint("\nChanges Since Previous Refresh\n\n"))
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Field", "Previous", "Current"}
+	rows := [][]string{headers, underline(headers)}
+	for _, diff := range c.Differences {
+		rows = append(rows, []string{diff.Path, valueOrDash(diff.Baseline), valueOrDash(diff.Live)})
+	}
+	writeTable(writer, rows, c.Width)
+	writer.Flush()
+	return b.String()
+}
+
+// HighlightChangedLines returns out with the lines that aren't in previous highlighted, so that the values that
+// changed since the previous refresh of a watch stand out. The blank lines are never highlighted.
+func HighlightChangedLines(out, previous string) string {
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(previous, "\n") {
+		seen[line] = true
+	}
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		if seen[line] || strings.TrimSpace(color.Strip(line)) == "" {
+			continue
+		}
+		lines[i] = color.BoldFgYellow.Sprint(color.Strip(line))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	fatihcolor "github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppRefreshChanges_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		inChanges *AppRefreshChanges
+
+		wantedContent string
+	}{
+		"notes that nothing changed": {
+			inChanges:     &AppRefreshChanges{},
+			wantedContent: "\nNo changes since the previous refresh.\n",
+		},
+		"lists the previous and current values of the changed fields": {
+			inChanges: &AppRefreshChanges{
+				Differences: []*AppDifference{
+					{Path: "deployments[0].stackStatus", Baseline: `"UPDATE_IN_PROGRESS"`, Live: `"UPDATE_COMPLETE"`},
+					{Path: "services[worker]", Live: `{"name":"worker"}`},
+				},
+			},
+			wantedContent: `
+Changes Since Previous Refresh
+
+  Field                       Previous              Current
+  -----                       --------              -------
+  deployments[0].stackStatus  "UPDATE_IN_PROGRESS"  "UPDATE_COMPLETE"
+  services[worker]            -                     {"name":"worker"}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedContent, tc.inChanges.HumanString())
+		})
+	}
+}
+
+func TestHighlightChangedLines(t *testing.T) {
+	defer func(noColor bool) { fatihcolor.NoColor = noColor }(fatihcolor.NoColor)
+	fatihcolor.NoColor = false
+	previous := "Services\n\n  api  UPDATE_IN_PROGRESS\n  web  UPDATE_COMPLETE\n"
+	out := "Services\n\n  api  UPDATE_COMPLETE\n  web  UPDATE_COMPLETE\n  worker  " + color.Green.Sprint("CREATE_COMPLETE") + "\n"
+
+	require.Equal(t, "Services\n\n"+
+		color.BoldFgYellow.Sprint("  api  UPDATE_COMPLETE")+"\n"+
+		"  web  UPDATE_COMPLETE\n"+
+		color.BoldFgYellow.Sprint("  worker  CREATE_COMPLETE")+"\n", HighlightChangedLines(out, previous))
+}
@@ -223,3 +223,31 @@ func (e *EnvDescription) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+// EnvList contains the descriptions of the environments in an application.
+type EnvList struct {
+	Environments []*EnvDescription `json:"environments"`
+}
+
+// JSONString returns the stringified EnvList struct with json format.
+func (l *EnvList) JSONString() (string, error) {
+	b, err := json.Marshal(l)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment descriptions: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvList struct as a table with a row per environment.
+func (l *EnvList) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Production", "Region", "Account ID", "Services"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, env := range l.Environments {
+		fmt.Fprintf(writer, "%s\t%t\t%s\t%s\t%d\n", env.Environment.Name, env.Environment.Prod, env.Environment.Region, env.Environment.AccountID, len(env.Services))
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -411,3 +411,40 @@ Resources
 	// THEN
 	require.Equal(t, wantedContent, actual)
 }
+
+func TestEnvList_HumanString(t *testing.T) {
+	// GIVEN
+	l := &EnvList{
+		Environments: []*EnvDescription{
+			{
+				Environment: &config.Environment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+				Services: []*config.Workload{
+					{Name: "frontend"},
+					{Name: "backend"},
+				},
+			},
+			{
+				Environment: &config.Environment{
+					Name:      "prod",
+					Region:    "us-east-1",
+					AccountID: "210987654321",
+					Prod:      true,
+				},
+			},
+		},
+	}
+
+	// WHEN
+	actual := l.HumanString()
+
+	// THEN
+	require.Equal(t, `Name                Production          Region              Account ID          Services
+----                ----------          ------              ----------          --------
+test                false               us-west-2           123456789012        2
+prod                true                us-east-1           210987654321        0
+`, actual)
+}
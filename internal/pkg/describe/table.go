@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	tableIndent       = "  " // indentation of the rows of a table.
+	ellipsis          = "..."
+	minTruncatedWidth = minCellWidth - cellPaddingWidth // columns are never truncated below the width of an empty cell.
+	typeHeader        = "Type"
+)
+
+// writeTable writes the rows of a table to a tabwriter, indented and with their cells separated by tabs.
+// The first row is the header of the table. If width is positive, the cells are truncated so that the
+// table fits within width characters; the name and type columns are truncated last.
+func writeTable(w io.Writer, rows [][]string, width int) {
+	for _, row := range fitTable(rows, width) {
+		fmt.Fprintf(w, "%s%s\n", tableIndent, strings.Join(row, "\t"))
+	}
+}
+
+// fitTable returns the rows with their cells ellipsized so that the table fits within width characters
+// once aligned by a tabwriter. The rows are returned unchanged if width isn't positive or if they already fit.
+func fitTable(rows [][]string, width int) [][]string {
+	if width <= 0 || len(rows) == 0 {
+		return rows
+	}
+	widths := columnWidths(rows)
+	excess := tableWidth(widths) - width
+	if excess <= 0 {
+		return rows
+	}
+	for _, col := range truncationOrder(rows[0], len(widths)) {
+		if excess <= 0 {
+			break
+		}
+		if widths[col] <= minTruncatedWidth {
+			continue
+		}
+		cut := widths[col] - minTruncatedWidth
+		if cut > excess {
+			cut = excess
+		}
+		widths[col] -= cut
+		excess -= cut
+	}
+	fitted := make([][]string, len(rows))
+	for i, row := range rows {
+		fitted[i] = make([]string, len(row))
+		for col, cell := range row {
+			fitted[i][col] = truncate(cell, widths[col])
+		}
+	}
+	return fitted
+}
+
+// columnWidths returns the number of characters of the widest cell of each column, ignoring colors.
+func columnWidths(rows [][]string) []int {
+	var widths []int
+	for _, row := range rows {
+		for col, cell := range row {
+			if col == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(color.Strip(cell)); n > widths[col] {
+				widths[col] = n
+			}
+		}
+	}
+	return widths
+}
+
+// tableWidth returns the number of characters of the widest row once the columns are aligned.
+// Every column but the last is padded, and is at least minCellWidth characters wide.
+func tableWidth(widths []int) int {
+	total := len(tableIndent)
+	for col, w := range widths {
+		if col == len(widths)-1 {
+			total += w
+			continue
+		}
+		if w+cellPaddingWidth < minCellWidth {
+			total += minCellWidth
+			continue
+		}
+		total += w + cellPaddingWidth
+	}
+	return total
+}
+
+// truncationOrder returns the columns in the order they should be truncated: from right to left,
+// leaving the name and type columns for last.
+func truncationOrder(header []string, numCols int) []int {
+	var order, prioritized []int
+	for col := numCols - 1; col >= 0; col-- {
+		if col == 0 || (col < len(header) && header[col] == typeHeader) {
+			prioritized = append(prioritized, col)
+			continue
+		}
+		order = append(order, col)
+	}
+	return append(order, prioritized...)
+}
+
+// truncate returns the cell ellipsized to at most width characters.
+// The colors of a truncated cell are dropped so that no escape sequence is cut in half.
+func truncate(cell string, width int) string {
+	plain := color.Strip(cell)
+	if utf8.RuneCountInString(plain) <= width {
+		return cell
+	}
+	runes := []rune(plain)
+	return strings.TrimRight(string(runes[:width-len(ellipsis)]), " ") + ellipsis
+}
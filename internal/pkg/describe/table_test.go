@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitTable(t *testing.T) {
+	services := [][]string{
+		{"Name", "Type", "URL"},
+		{"----", "----", "---"},
+		{"frontend-with-a-long-name", "Load Balanced Web Service", "http://my-app-test-frontend.us-west-2.elb.amazonaws.com"},
+	}
+	testCases := map[string]struct {
+		inRows  [][]string
+		inWidth int
+
+		wanted [][]string
+	}{
+		"does not truncate without a width": {
+			inRows:  services,
+			inWidth: 0,
+
+			wanted: services,
+		},
+		"does not truncate a table that fits": {
+			inRows:  services,
+			inWidth: 200,
+
+			wanted: services,
+		},
+		"truncates the other columns before the name and type": {
+			inRows:  services,
+			inWidth: 80,
+
+			wanted: [][]string{
+				{"Name", "Type", "URL"},
+				{"----", "----", "---"},
+				{"frontend-with-a-long-name", "Load Balanced Web Service", "http://my-app-test-fr..."},
+			},
+		},
+		"truncates the type before the name": {
+			inRows:  services,
+			inWidth: 70,
+
+			wanted: [][]string{
+				{"Name", "Type", "URL"},
+				{"----", "----", "---"},
+				{"frontend-with-a-long-name", "Load Balanced Web...", "http://my-app-t..."},
+			},
+		},
+		"never truncates a column below the width of an empty cell": {
+			inRows:  services,
+			inWidth: 20,
+
+			wanted: [][]string{
+				{"Name", "Type", "URL"},
+				{"----", "----", "---"},
+				{"frontend-with-a...", "Load Balanced W...", "http://my-app-t..."},
+			},
+		},
+		"drops the colors of a truncated cell": {
+			inRows: [][]string{
+				{"Name"},
+				{color.Faint.Sprint("pipeline-my-app-with-a-very-long-repository-name")},
+			},
+			inWidth: 30,
+
+			wanted: [][]string{
+				{"Name"},
+				{"pipeline-my-app-with-a-ve..."},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, fitTable(tc.inRows, tc.inWidth))
+		})
+	}
+}
@@ -18,6 +18,9 @@ const (
 	LoadBalancedWebServiceType = "Load Balanced Web Service"
 	// BackendServiceType is a service that cannot be accessed from the internet but can be reached from other services.
 	BackendServiceType = "Backend Service"
+	// RequestDrivenWebServiceType is a web service with AWS App Runner as compute.
+	// It can't be initialized with this version of the CLI, but it can be present in the application's config store.
+	RequestDrivenWebServiceType = "Request-Driven Web Service"
 )
 
 // ServiceTypes are the supported service manifest types.
@@ -0,0 +1,55 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clipboard provides functionality to copy text to the system clipboard.
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+// ErrUnavailable is returned when none of the clipboard utilities are installed, for example on headless hosts.
+var ErrUnavailable = errors.New("no clipboard utility found")
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// utility is a command that copies its standard input to the system clipboard.
+type utility struct {
+	name string
+	args []string
+}
+
+// Clipboard copies text through the first clipboard utility installed on the system.
+type Clipboard struct {
+	runner   runner
+	lookPath func(file string) (string, error)
+}
+
+// New returns a Clipboard that uses the clipboard utilities of the current platform.
+func New() *Clipboard {
+	return &Clipboard{
+		runner:   command.New(),
+		lookPath: exec.LookPath,
+	}
+}
+
+// Copy writes text to the system clipboard.
+func (c *Clipboard) Copy(text string) error {
+	for _, u := range utilities {
+		if _, err := c.lookPath(u.name); err != nil {
+			continue
+		}
+		if err := c.runner.Run(u.name, u.args, command.Stdin(strings.NewReader(text))); err != nil {
+			return fmt.Errorf("copy to clipboard with %s: %w", u.name, err)
+		}
+		return nil
+	}
+	return ErrUnavailable
+}
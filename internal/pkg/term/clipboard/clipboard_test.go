@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunner struct {
+	err error
+
+	name  string
+	args  []string
+	stdin string
+}
+
+func (r *fakeRunner) Run(name string, args []string, options ...command.Option) error {
+	cmd := &exec.Cmd{}
+	for _, opt := range options {
+		opt(cmd)
+	}
+	stdin, err := ioutil.ReadAll(cmd.Stdin)
+	if err != nil {
+		return err
+	}
+	r.name, r.args, r.stdin = name, args, string(stdin)
+	return r.err
+}
+
+func TestClipboard_Copy(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inInstalled []string
+		inRunErr    error
+
+		wantedName  string
+		wantedArgs  []string
+		wantedStdin string
+		wantedErr   error
+	}{
+		"errors if no utility is installed": {
+			wantedErr: ErrUnavailable,
+		},
+		"copies with the first installed utility": {
+			inInstalled: []string{utilities[len(utilities)-1].name},
+
+			wantedName:  utilities[len(utilities)-1].name,
+			wantedArgs:  utilities[len(utilities)-1].args,
+			wantedStdin: "my-app",
+		},
+		"wraps the error of the utility": {
+			inInstalled: []string{utilities[0].name},
+			inRunErr:    testErr,
+
+			wantedErr: fmt.Errorf("copy to clipboard with %s: %w", utilities[0].name, testErr),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			runner := &fakeRunner{err: tc.inRunErr}
+			c := &Clipboard{
+				runner: runner,
+				lookPath: func(file string) (string, error) {
+					for _, installed := range tc.inInstalled {
+						if installed == file {
+							return "/usr/bin/" + file, nil
+						}
+					}
+					return "", exec.ErrNotFound
+				},
+			}
+
+			// WHEN
+			err := c.Copy("my-app")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, runner.name)
+			require.Equal(t, tc.wantedArgs, runner.args)
+			require.Equal(t, tc.wantedStdin, runner.stdin)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// +build !windows
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package clipboard
+
+// utilities are tried in order, from the macOS utility to the Wayland and X11 ones.
+var utilities = []utility{
+	{name: "pbcopy"},
+	{name: "wl-copy"},
+	{name: "xclip", args: []string{"-selection", "clipboard"}},
+	{name: "xsel", args: []string{"--clipboard", "--input"}},
+}
@@ -6,6 +6,7 @@ package color
 
 import (
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2/core"
@@ -31,6 +32,8 @@ var (
 
 const colorEnvVar = "COLOR"
 
+var escapeSequence = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
 var lookupEnv = os.LookupEnv
 
 // DisableColorBasedOnEnvVar determines whether the CLI will produce color
@@ -47,14 +50,24 @@ func DisableColorBasedOnEnvVar() {
 	}
 
 	if strings.ToLower(value) == "false" {
-		core.DisableColor = true
-		color.NoColor = true
+		Disable()
 	} else if strings.ToLower(value) == "true" {
 		core.DisableColor = false
 		color.NoColor = false
 	}
 }
 
+// Disable turns off colored output, including in prompts.
+func Disable() {
+	core.DisableColor = true
+	color.NoColor = true
+}
+
+// Enabled returns true if the output is colored.
+func Enabled() bool {
+	return !color.NoColor
+}
+
 // Help colors the string to denote that it's auxiliary helpful information, and returns it.
 func Help(s string) string {
 	return Faint.Sprint(s)
@@ -84,3 +97,8 @@ func HighlightCode(s string) string {
 func Prod(s string) string {
 	return BoldFgYellow.Sprint(s)
 }
+
+// Strip removes the color escape sequences from the string, and returns it.
+func Strip(s string) string {
+	return escapeSequence.ReplaceAllString(s, "")
+}
@@ -54,3 +54,19 @@ func TestColorEnvVarNotSet(t *testing.T) {
 
 	require.Equal(t, core.DisableColor, color.NoColor, "expected to be the same as color.NoColor")
 }
+
+func TestEnabled(t *testing.T) {
+	defer func(noColor, disableColor bool) {
+		color.NoColor = noColor
+		core.DisableColor = disableColor
+	}(color.NoColor, core.DisableColor)
+
+	color.NoColor = false
+	require.True(t, Enabled(), "expected to be true when the output is colored")
+	Disable()
+	require.False(t, Enabled(), "expected to be false once colors are disabled")
+}
+
+func TestStrip(t *testing.T) {
+	require.Equal(t, "About my-app", Strip("\x1b[1mAbout\x1b[0m \x1b[93;1mmy-app\x1b[0m"))
+}
@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pager provides functionality to display long text one screen at a time.
+package pager
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/google/shlex"
+	"golang.org/x/term"
+)
+
+const (
+	pagerEnvVar = "PAGER"
+	linesEnvVar = "LINES"
+
+	defaultScreenLines   = 24
+	defaultScreenColumns = 80
+	morePrompt           = "-- More -- (press Enter to continue or q to quit)"
+)
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// Pager displays text through the user's $PAGER, or a minimal built-in pager if it's not set.
+type Pager struct {
+	runner runner
+	getenv func(key string) string
+	in     io.Reader
+	out    io.Writer
+}
+
+// New returns a Pager that reads keystrokes from stdin and writes to stdout.
+func New() *Pager {
+	return &Pager{
+		runner: command.New(),
+		getenv: os.Getenv,
+		in:     os.Stdin,
+		out:    os.Stdout,
+	}
+}
+
+// IsTerminal returns true if the file is a terminal rather than a pipe or a regular file.
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// ScreenWidth returns the number of columns of the terminal, or 80 if the file isn't a terminal.
+func ScreenWidth(f *os.File) int {
+	if !IsTerminal(f) {
+		return defaultScreenColumns
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil || width <= 0 {
+		return defaultScreenColumns
+	}
+	return width
+}
+
+// Page displays the text one screen at a time.
+func (p *Pager) Page(text string) error {
+	pager := strings.TrimSpace(p.getenv(pagerEnvVar))
+	if pager == "" {
+		return p.builtin(text)
+	}
+	args, err := shlex.Split(pager)
+	if err != nil {
+		return fmt.Errorf("parse $%s %q: %w", pagerEnvVar, pager, err)
+	}
+	if err := p.runner.Run(args[0], args[1:], command.Stdin(strings.NewReader(text)), command.Stdout(p.out)); err != nil {
+		return fmt.Errorf("run pager %s: %w", args[0], err)
+	}
+	return nil
+}
+
+// builtin writes a screen of lines at a time and waits for the user to press Enter between screens.
+func (p *Pager) builtin(text string) error {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	pageSize := p.screenLines() - 1 // Leave a line for the prompt.
+	keys := bufio.NewReader(p.in)
+	for start := 0; start < len(lines); start += pageSize {
+		end := start + pageSize
+		if end > len(lines) {
+			end = len(lines)
+		}
+		if _, err := io.WriteString(p.out, strings.Join(lines[start:end], "")); err != nil {
+			return fmt.Errorf("write page: %w", err)
+		}
+		if end == len(lines) {
+			return nil
+		}
+		fmt.Fprint(p.out, color.Faint.Sprint(morePrompt))
+		key, err := keys.ReadString('\n')
+		if err == io.EOF {
+			fmt.Fprintln(p.out)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read key: %w", err)
+		}
+		if strings.TrimSpace(key) == "q" {
+			return nil
+		}
+	}
+	return nil
+}
+
+// screenLines returns the height of the terminal from $LINES, or a default if it's not set.
+func (p *Pager) screenLines() int {
+	lines, err := strconv.Atoi(p.getenv(linesEnvVar))
+	if err != nil || lines < 2 {
+		return defaultScreenLines
+	}
+	return lines
+}
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunner struct {
+	err error
+
+	name  string
+	args  []string
+	stdin string
+}
+
+func (r *fakeRunner) Run(name string, args []string, options ...command.Option) error {
+	cmd := &exec.Cmd{}
+	for _, opt := range options {
+		opt(cmd)
+	}
+	stdin, err := ioutil.ReadAll(cmd.Stdin)
+	if err != nil {
+		return err
+	}
+	r.name, r.args, r.stdin = name, args, string(stdin)
+	return r.err
+}
+
+func TestPager_Page(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inEnv    map[string]string
+		inText   string
+		inKeys   string
+		inRunErr error
+
+		wantedName   string
+		wantedArgs   []string
+		wantedStdin  string
+		wantedOutput string
+		wantedErr    error
+	}{
+		"pipes the text to $PAGER": {
+			inEnv: map[string]string{
+				"PAGER": "less -R",
+			},
+			inText: "About\n",
+
+			wantedName:  "less",
+			wantedArgs:  []string{"-R"},
+			wantedStdin: "About\n",
+		},
+		"returns the error of $PAGER": {
+			inEnv: map[string]string{
+				"PAGER": "less",
+			},
+			inRunErr: testErr,
+
+			wantedErr: fmt.Errorf("run pager less: %w", testErr),
+		},
+		"writes short text at once with the built-in pager": {
+			inText: "a\nb\n",
+
+			wantedOutput: "a\nb\n",
+		},
+		"waits for Enter between screens with the built-in pager": {
+			inEnv: map[string]string{
+				"LINES": "3",
+			},
+			inText: "a\nb\nc\nd\ne\n",
+			inKeys: "\n\n",
+
+			wantedOutput: "a\nb\n" + morePrompt + "c\nd\n" + morePrompt + "e\n",
+		},
+		"stops when the user quits the built-in pager": {
+			inEnv: map[string]string{
+				"LINES": "3",
+			},
+			inText: "a\nb\nc\nd\ne\n",
+			inKeys: "q\n",
+
+			wantedOutput: "a\nb\n" + morePrompt,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			runner := &fakeRunner{err: tc.inRunErr}
+			out := &bytes.Buffer{}
+			p := &Pager{
+				runner: runner,
+				getenv: func(key string) string {
+					return tc.inEnv[key]
+				},
+				in:  strings.NewReader(tc.inKeys),
+				out: out,
+			}
+
+			// WHEN
+			err := p.Page(tc.inText)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, runner.name)
+			require.Equal(t, tc.wantedArgs, runner.args)
+			require.Equal(t, tc.wantedStdin, runner.stdin)
+			require.Equal(t, tc.wantedOutput, out.String())
+		})
+	}
+}
+
+func TestScreenWidth(t *testing.T) {
+	f, err := ioutil.TempFile("", "out")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	require.Equal(t, 80, ScreenWidth(f))
+}
@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+// appCacheFile is the content of the file of an AppCache.
+type appCacheFile struct {
+	Key      string    `json:"key"`
+	CachedAt time.Time `json:"cachedAt"`
+	Apps     []string  `json:"apps"`
+}
+
+// AppCache is a short-lived on-disk cache of the names of the applications in the config store.
+// The names are only read back if they were cached with the same key, like the account and region of the store,
+// and less than the TTL ago.
+type AppCache struct {
+	fs      afero.Fs
+	path    string
+	key     string
+	ttl     time.Duration
+	refresh bool
+
+	now func() time.Time
+}
+
+// NewAppCache returns a cache of the application names in the file at path, for the store identified by key.
+func NewAppCache(fs afero.Fs, path, key string, ttl time.Duration) *AppCache {
+	return &AppCache{
+		fs:   fs,
+		path: path,
+		key:  key,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Refresh makes the cache miss until the names are cached again, so that they're listed from the store.
+func (c *AppCache) Refresh() {
+	c.refresh = true
+}
+
+// AppNames returns the cached application names, and false if they're missing, stale or cached for another key.
+func (c *AppCache) AppNames() ([]string, bool) {
+	if c.refresh {
+		return nil, false
+	}
+	content, err := afero.ReadFile(c.fs, c.path)
+	if err != nil {
+		return nil, false
+	}
+	var file appCacheFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, false
+	}
+	if file.Key != c.key || c.now().Sub(file.CachedAt) >= c.ttl {
+		return nil, false
+	}
+	return file.Apps, true
+}
+
+// SetAppNames caches the application names.
+func (c *AppCache) SetAppNames(names []string) error {
+	content, err := json.Marshal(appCacheFile{
+		Key:      c.key,
+		CachedAt: c.now(),
+		Apps:     names,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal application cache: %w", err)
+	}
+	if err := c.fs.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("create directory of application cache %s: %w", c.path, err)
+	}
+	if err := afero.WriteFile(c.fs, c.path, content, 0644); err != nil {
+		return fmt.Errorf("write application cache %s: %w", c.path, err)
+	}
+	c.refresh = false
+	return nil
+}
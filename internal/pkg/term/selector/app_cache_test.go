@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppCache_AppNames(t *testing.T) {
+	mockNow := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inContent string
+		inRefresh bool
+
+		wantedNames []string
+		wantedOK    bool
+	}{
+		"misses if the file doesn't exist": {},
+		"misses if the file is malformed": {
+			inContent: "apps",
+		},
+		"misses if the names were cached for another store": {
+			inContent: `{"key":"210987654321/us-east-1","cachedAt":"2021-06-01T11:59:00Z","apps":["my-app"]}`,
+		},
+		"misses if the names are stale": {
+			inContent: `{"key":"123456789012/us-west-2","cachedAt":"2021-06-01T11:55:00Z","apps":["my-app"]}`,
+		},
+		"misses if the cache is refreshed": {
+			inContent: `{"key":"123456789012/us-west-2","cachedAt":"2021-06-01T11:59:00Z","apps":["my-app"]}`,
+			inRefresh: true,
+		},
+		"returns the names cached within the TTL": {
+			inContent:   `{"key":"123456789012/us-west-2","cachedAt":"2021-06-01T11:59:00Z","apps":["my-app","other-app"]}`,
+			wantedNames: []string{"my-app", "other-app"},
+			wantedOK:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.inContent != "" {
+				require.NoError(t, afero.WriteFile(fs, "/cache/apps.json", []byte(tc.inContent), 0644))
+			}
+			cache := NewAppCache(fs, "/cache/apps.json", "123456789012/us-west-2", 5*time.Minute)
+			cache.now = func() time.Time { return mockNow }
+			if tc.inRefresh {
+				cache.Refresh()
+			}
+
+			// WHEN
+			names, ok := cache.AppNames()
+
+			// THEN
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestAppCache_SetAppNames(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	cache := NewAppCache(fs, "/cache/copilot/apps.json", "123456789012/us-west-2", 5*time.Minute)
+	cache.now = func() time.Time { return time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC) }
+	cache.Refresh()
+
+	require.NoError(t, cache.SetAppNames([]string{"my-app"}))
+
+	content, err := afero.ReadFile(fs, "/cache/copilot/apps.json")
+	require.NoError(t, err)
+	require.Equal(t, `{"key":"123456789012/us-west-2","cachedAt":"2021-06-01T12:00:00Z","apps":["my-app"]}`, string(content))
+	names, ok := cache.AppNames()
+	require.True(t, ok)
+	require.Equal(t, []string{"my-app"}, names)
+}
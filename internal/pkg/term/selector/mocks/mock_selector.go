@@ -661,3 +661,55 @@ func (mr *MockTaskListerMockRecorder) ListActiveDefaultClusterTasks(filter inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveDefaultClusterTasks", reflect.TypeOf((*MockTaskLister)(nil).ListActiveDefaultClusterTasks), filter)
 }
+
+// MockAppNameCacher is a mock of AppNameCacher interface
+type MockAppNameCacher struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppNameCacherMockRecorder
+}
+
+// MockAppNameCacherMockRecorder is the mock recorder for MockAppNameCacher
+type MockAppNameCacherMockRecorder struct {
+	mock *MockAppNameCacher
+}
+
+// NewMockAppNameCacher creates a new mock instance
+func NewMockAppNameCacher(ctrl *gomock.Controller) *MockAppNameCacher {
+	mock := &MockAppNameCacher{ctrl: ctrl}
+	mock.recorder = &MockAppNameCacherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAppNameCacher) EXPECT() *MockAppNameCacherMockRecorder {
+	return m.recorder
+}
+
+// AppNames mocks base method
+func (m *MockAppNameCacher) AppNames() ([]string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// AppNames indicates an expected call of AppNames
+func (mr *MockAppNameCacherMockRecorder) AppNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppNames", reflect.TypeOf((*MockAppNameCacher)(nil).AppNames))
+}
+
+// SetAppNames mocks base method
+func (m *MockAppNameCacher) SetAppNames(names []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppNames", names)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAppNames indicates an expected call of SetAppNames
+func (mr *MockAppNameCacherMockRecorder) SetAppNames(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppNames", reflect.TypeOf((*MockAppNameCacher)(nil).SetAppNames), names)
+}
@@ -131,10 +131,17 @@ type TaskLister interface {
 	ListActiveDefaultClusterTasks(filter ecs.ListTasksFilter) ([]*awsecs.Task, error)
 }
 
+// AppNameCacher wraps the methods to cache the names of the applications in the config store.
+type AppNameCacher interface {
+	AppNames() ([]string, bool)
+	SetAppNames(names []string) error
+}
+
 // Select prompts users to select the name of an application or environment.
 type Select struct {
-	prompt Prompter
-	config ConfigLister
+	prompt   Prompter
+	config   ConfigLister
+	appCache AppNameCacher
 }
 
 // ConfigSelect is an application and environment selector, but can also choose a service from the config store.
@@ -676,12 +683,11 @@ func (s *Select) Environments(prompt, help, app string, finalMsgFunc func(int) p
 
 // Application fetches all the apps in an account/region and prompts the user to select one.
 func (s *Select) Application(prompt, help string, additionalOpts ...string) (string, error) {
-	appNames, err := s.retrieveApps()
+	appNames, err := s.ApplicationChoices(additionalOpts...)
 	if err != nil {
 		return "", err
 	}
 
-	appNames = append(appNames, additionalOpts...)
 	if len(appNames) == 0 {
 		log.Infof("Couldn't find any applications in this region and account. Try initializing one with %s\n",
 			color.HighlightCode("copilot app init"))
@@ -700,7 +706,36 @@ func (s *Select) Application(prompt, help string, additionalOpts ...string) (str
 	return app, nil
 }
 
+// ApplicationFrom prompts the user to select one of the given applications, like the ones matching a partial name.
+func (s *Select) ApplicationFrom(prompt, help string, appNames []string) (string, error) {
+	app, err := s.prompt.SelectOne(prompt, help, appNames)
+	if err != nil {
+		return "", fmt.Errorf("select application: %w", err)
+	}
+	return app, nil
+}
+
+// ApplicationChoices returns the options, in order, that Application would prompt the user to select from.
+func (s *Select) ApplicationChoices(additionalOpts ...string) ([]string, error) {
+	appNames, err := s.retrieveApps()
+	if err != nil {
+		return nil, err
+	}
+	return append(appNames, additionalOpts...), nil
+}
+
+// WithAppCache makes the selector consult the cache of application names before listing them from the config store.
+func (s *Select) WithAppCache(cache AppNameCacher) *Select {
+	s.appCache = cache
+	return s
+}
+
 func (s *Select) retrieveApps() ([]string, error) {
+	if s.appCache != nil {
+		if appNames, ok := s.appCache.AppNames(); ok {
+			return appNames, nil
+		}
+	}
 	apps, err := s.config.ListApplications()
 	if err != nil {
 		return nil, fmt.Errorf("list applications: %w", err)
@@ -709,6 +744,10 @@ func (s *Select) retrieveApps() ([]string, error) {
 	for ind, app := range apps {
 		appNames[ind] = app.Name
 	}
+	if s.appCache != nil {
+		// The applications were listed, so failing to cache them only slows down the next selection.
+		_ = s.appCache.SetAppNames(appNames)
+	}
 	return appNames, nil
 }
 
@@ -1304,6 +1304,67 @@ type applicationMocks struct {
 	prompt    *mocks.MockPrompter
 }
 
+func TestSelect_ApplicationWithAppCache(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(lister *mocks.MockConfigLister, cache *mocks.MockAppNameCacher)
+
+		want    []string
+		wantErr error
+	}{
+		"returns the cached names without listing the applications": {
+			setupMocks: func(lister *mocks.MockConfigLister, cache *mocks.MockAppNameCacher) {
+				cache.EXPECT().AppNames().Return([]string{"app1", "app2"}, true)
+				lister.EXPECT().ListApplications().Times(0)
+			},
+			want: []string{"app1", "app2"},
+		},
+		"lists and caches the applications on a cache miss": {
+			setupMocks: func(lister *mocks.MockConfigLister, cache *mocks.MockAppNameCacher) {
+				cache.EXPECT().AppNames().Return(nil, false)
+				lister.EXPECT().ListApplications().Return([]*config.Application{{Name: "app1"}}, nil)
+				cache.EXPECT().SetAppNames([]string{"app1"}).Return(nil)
+			},
+			want: []string{"app1"},
+		},
+		"ignores a failure to cache the applications": {
+			setupMocks: func(lister *mocks.MockConfigLister, cache *mocks.MockAppNameCacher) {
+				cache.EXPECT().AppNames().Return(nil, false)
+				lister.EXPECT().ListApplications().Return([]*config.Application{{Name: "app1"}}, nil)
+				cache.EXPECT().SetAppNames([]string{"app1"}).Return(errors.New("some error"))
+			},
+			want: []string{"app1"},
+		},
+		"does not cache the applications if they can't be listed": {
+			setupMocks: func(lister *mocks.MockConfigLister, cache *mocks.MockAppNameCacher) {
+				cache.EXPECT().AppNames().Return(nil, false)
+				lister.EXPECT().ListApplications().Return(nil, errors.New("some error"))
+				cache.EXPECT().SetAppNames(gomock.Any()).Times(0)
+			},
+			wantErr: errors.New("list applications: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := mocks.NewMockConfigLister(ctrl)
+			mockCache := mocks.NewMockAppNameCacher(ctrl)
+			tc.setupMocks(mockLister, mockCache)
+			sel := NewSelect(mocks.NewMockPrompter(ctrl), mockLister).WithAppCache(mockCache)
+
+			got, err := sel.ApplicationChoices()
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestSelect_Application(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(m applicationMocks)
@@ -1421,6 +1482,124 @@ func TestSelect_Application(t *testing.T) {
 	}
 }
 
+func TestSelect_ApplicationFrom(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m applicationMocks)
+		wantErr    error
+		want       string
+	}{
+		"prompts among the given apps": {
+			setupMocks: func(m applicationMocks) {
+				m.appLister.EXPECT().ListApplications().Times(0)
+				m.prompt.
+					EXPECT().
+					SelectOne(
+						gomock.Eq("Select an app"),
+						gomock.Eq("Help text"),
+						gomock.Eq([]string{"payments", "payroll"})).
+					Return("payroll", nil)
+			},
+			want: "payroll",
+		},
+		"with error selecting an app": {
+			setupMocks: func(m applicationMocks) {
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", fmt.Errorf("some error"))
+			},
+			wantErr: fmt.Errorf("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockappLister := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := applicationMocks{
+				appLister: mockappLister,
+				prompt:    mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := Select{
+				prompt: mockprompt,
+				config: mockappLister,
+			}
+
+			got, err := sel.ApplicationFrom("Select an app", "Help text", []string{"payments", "payroll"})
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSelect_ApplicationChoices(t *testing.T) {
+	testCases := map[string]struct {
+		inAdditionalOpts []string
+		setupMocks       func(m applicationMocks)
+
+		wantErr error
+		want    []string
+	}{
+		"returns the app names followed by the additional options": {
+			inAdditionalOpts: []string{"None"},
+			setupMocks: func(m applicationMocks) {
+				m.appLister.EXPECT().ListApplications().Return([]*config.Application{
+					{
+						Name: "app1",
+					},
+					{
+						Name: "app2",
+					},
+				}, nil)
+			},
+			want: []string{"app1", "app2", "None"},
+		},
+		"with error listing apps": {
+			setupMocks: func(m applicationMocks) {
+				m.appLister.EXPECT().ListApplications().Return(nil, fmt.Errorf("some error"))
+			},
+			wantErr: fmt.Errorf("list applications: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockappLister := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := applicationMocks{
+				appLister: mockappLister,
+				prompt:    mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := Select{
+				prompt: mockprompt,
+				config: mockappLister,
+			}
+
+			got, err := sel.ApplicationChoices(tc.inAdditionalOpts...)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 	dockerfiles := []string{
 		"./Dockerfile",